@@ -0,0 +1,14 @@
+# Read the extension attribute of a title
+data "jamfautoupdate_extension_attribute" "chrome" {
+  title_name = "GoogleChrome"
+}
+
+# Create the extension attribute in Jamf Pro
+resource "jamfpro_computer_extension_attribute" "chrome_version" {
+  name                   = coalesce(data.jamfautoupdate_extension_attribute.chrome.display_name, "Google Chrome Version")
+  enabled                = true
+  data_type              = coalesce(data.jamfautoupdate_extension_attribute.chrome.data_type, "STRING")
+  input_type             = "SCRIPT"
+  inventory_display_type = "EXTENSION_ATTRIBUTES"
+  script_contents        = data.jamfautoupdate_extension_attribute.chrome.script
+}
@@ -0,0 +1,17 @@
+# Read the complete patch definition of a title
+data "jamfautoupdate_patch_definition" "chrome" {
+  title_name = "GoogleChrome"
+}
+
+# Applications to quit before Google Chrome is updated
+output "chrome_kill_apps" {
+  value = [for app in data.jamfautoupdate_patch_definition.chrome.kill_apps : app.app_name]
+}
+
+# Criteria identifying the installed components, for patch management configuration
+output "chrome_component_criteria" {
+  value = {
+    for component in data.jamfautoupdate_patch_definition.chrome.components :
+    component.name => component.criteria
+  }
+}
@@ -0,0 +1,10 @@
+# Check that the definitions source is reachable before relying on it
+data "jamfautoupdate_service_status" "catalog" {}
+
+output "catalog_healthy" {
+  value = data.jamfautoupdate_service_status.catalog.healthy
+}
+
+output "catalog_latency_ms" {
+  value = data.jamfautoupdate_service_status.catalog.latency_ms
+}
@@ -0,0 +1,18 @@
+# Read a single title
+data "jamfautoupdate_title" "chrome" {
+  title_name = "GoogleChrome"
+}
+
+output "chrome_bundle_id" {
+  value = data.jamfautoupdate_title.chrome.app_bundle_id
+}
+
+# Read one title per module instance
+data "jamfautoupdate_title" "managed" {
+  for_each   = toset(["GoogleChrome", "Firefox", "Zoom"])
+  title_name = each.key
+}
+
+output "versions" {
+  value = { for name, title in data.jamfautoupdate_title.managed : name => title.title_version }
+}
@@ -0,0 +1,10 @@
+# Render a title's icon at each standard size
+data "jamfautoupdate_title_icon" "chrome" {
+  title_name = "GoogleChrome"
+}
+
+# Write the 256px rendering out for a dashboard
+resource "local_file" "chrome_icon_256" {
+  filename       = "${path.module}/icons/GoogleChrome-256.png"
+  content_base64 = data.jamfautoupdate_title_icon.chrome.icons["256"]
+}
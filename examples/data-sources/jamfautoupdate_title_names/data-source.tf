@@ -0,0 +1,15 @@
+# List every Microsoft title in the catalog
+data "jamfautoupdate_title_names" "microsoft" {
+  prefix = "Microsoft"
+}
+
+# Read the details of each title separately, keyed by name
+data "jamfautoupdate_titles" "microsoft" {
+  for_each    = toset(data.jamfautoupdate_title_names.microsoft.names)
+  title_names = [each.key]
+}
+
+# Match names with a regular expression
+data "jamfautoupdate_title_names" "browsers" {
+  pattern = "^(GoogleChrome|Firefox|MicrosoftEdge)$"
+}
@@ -0,0 +1,13 @@
+# List the profiles a title ships
+data "jamfautoupdate_title_profiles" "zoom" {
+  title_name     = "Zoom"
+  profile_format = "xml"
+}
+
+# Write one file per profile the title actually provides
+resource "local_file" "zoom_profiles" {
+  for_each = data.jamfautoupdate_title_profiles.zoom.profiles
+
+  filename = "${path.module}/profiles/Zoom-${each.key}.mobileconfig"
+  content  = each.value
+}
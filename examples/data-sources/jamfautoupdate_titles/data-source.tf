@@ -69,3 +69,102 @@ output "available_profiles" {
     type => content != null
   }
 }
+
+# Badge icons for titles deployed to a pilot ring
+data "jamfautoupdate_titles" "pilot" {
+  title_names = ["GoogleChrome"]
+
+  icon_badge = {
+    text     = "PILOT"
+    color    = "#007AFF"
+    position = "top_right"
+  }
+}
+
+resource "local_file" "pilot_icons" {
+  for_each = {
+    for title in data.jamfautoupdate_titles.pilot.titles :
+    title.title_name => title
+    if title.badged_icon_base64 != null
+  }
+  content_base64 = each.value.badged_icon_base64
+  filename       = "${path.module}/icons/pilot/${each.value.title_name}.png"
+}
+
+# Apply the macOS rounded-corner mask to processed icons
+data "jamfautoupdate_titles" "rounded" {
+  title_names = ["GoogleChrome"]
+  icon_mask   = "squircle"
+}
+
+# Add a transparent margin so edge-to-edge artwork clears the uninstall overlay
+data "jamfautoupdate_titles" "padded" {
+  title_names  = ["GoogleChrome"]
+  icon_padding = 10
+}
+
+# Tolerate corrupt catalog icons instead of failing the whole read
+data "jamfautoupdate_titles" "tolerant" {
+  icon_processing = "best_effort"
+}
+
+# Skip titles that cannot be processed and report them separately
+data "jamfautoupdate_titles" "resilient" {
+  skip_errored_titles = true
+}
+
+output "errored_titles" {
+  value = data.jamfautoupdate_titles.resilient.errored_titles
+}
+
+# Flatten icons onto white for destinations that cannot render transparency
+data "jamfautoupdate_titles" "flattened" {
+  title_names           = ["GoogleChrome"]
+  icon_mask             = "squircle"
+  icon_background_color = "#FFFFFF"
+}
+
+# Record where and when the definitions came from
+output "definitions_provenance" {
+  value = {
+    source_type     = data.jamfautoupdate_titles.specific.source_type
+    source_location = data.jamfautoupdate_titles.specific.source_location
+    fetched_at      = data.jamfautoupdate_titles.specific.fetched_at
+  }
+}
+
+# Expose profiles as readable property list XML
+data "jamfautoupdate_titles" "readable_profiles" {
+  title_names    = ["GoogleChrome"]
+  profile_format = "xml"
+}
+
+output "chrome_pppc_profile" {
+  value = data.jamfautoupdate_titles.readable_profiles.titles[0].pppcp_profile
+}
+
+# Select titles by the bundle IDs reported by device inventory
+data "jamfautoupdate_titles" "by_bundle_id" {
+  bundle_ids = ["com.google.Chrome", "us.zoom.xos"]
+}
+
+# Every catalog title that needs privacy permissions pre-approved by MDM
+data "jamfautoupdate_titles" "privacy_approval" {
+  requires_privacy_approval = true
+}
+
+# Every catalog title that supports the macOS release a fleet is pinned to
+data "jamfautoupdate_titles" "ventura" {
+  os_version = "13.6"
+}
+
+# Titles shipping both PPPC and system extension payloads, for security review
+data "jamfautoupdate_titles" "security_payloads" {
+  requires = ["pppcp_profile", "system_extension_profile"]
+}
+
+# Pin critical titles to a curated file while the provider reads everything else from the live API
+data "jamfautoupdate_titles" "pinned" {
+  title_names      = ["GoogleChrome"]
+  definitions_file = "${path.module}/pinned-definitions.json"
+}
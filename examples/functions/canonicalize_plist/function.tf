@@ -0,0 +1,9 @@
+data "jamfautoupdate_titles" "chrome" {
+  title_names    = ["GoogleChrome"]
+  profile_format = "xml"
+}
+
+# Detect drift between the catalog PPPC profile and the copy exported from Jamf Pro
+output "pppc_profile_matches_jamf_pro" {
+  value = provider::jamfautoupdate::canonicalize_plist(file("${path.module}/exports/chrome_pppc.mobileconfig")) == data.jamfautoupdate_titles.chrome.titles[0].pppcp_profile
+}
@@ -0,0 +1,4 @@
+# Pick the newest release from a list of versions
+locals {
+  newest_release = provider::jamfautoupdate::latest_version(["1.9.10", "1.10.0", "1.10.0b1", "1.9.2"])
+}
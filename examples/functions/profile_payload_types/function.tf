@@ -0,0 +1,16 @@
+data "jamfautoupdate_titles" "chrome" {
+  title_names = ["GoogleChrome"]
+}
+
+# Fail the plan if the PPPC profile does not contain a TCC payload
+output "pppc_payload_types" {
+  value = provider::jamfautoupdate::profile_payload_types(data.jamfautoupdate_titles.chrome.titles[0].pppcp_profile)
+
+  precondition {
+    condition = contains(
+      provider::jamfautoupdate::profile_payload_types(data.jamfautoupdate_titles.chrome.titles[0].pppcp_profile),
+      "com.apple.TCC.configuration-profile-policy",
+    )
+    error_message = "The PPPC profile does not contain a TCC payload."
+  }
+}
@@ -0,0 +1,5 @@
+# Pick the release before the newest from a list of versions
+locals {
+  sorted_versions  = provider::jamfautoupdate::semver_sort(["1.10.0", "1.9.2", "1.10.0b1", "1.9.10"])
+  previous_release = local.sorted_versions[length(local.sorted_versions) - 2]
+}
@@ -0,0 +1,9 @@
+variable "policy_description" {
+  type    = string
+  default = "Installs **Google Chrome** for the <em>pilot</em> ring."
+}
+
+# Clean a user-supplied description before passing it to a field that renders raw text
+output "policy_description_plain" {
+  value = provider::jamfautoupdate::strip_markup(var.policy_description)
+}
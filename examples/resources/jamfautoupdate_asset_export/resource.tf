@@ -0,0 +1,12 @@
+# Hand processed icons, profiles and scripts to packaging tooling outside Terraform
+resource "jamfautoupdate_asset_export" "packaging" {
+  directory = "${path.module}/export"
+  title_names = [
+    "GoogleChrome",
+    "MicrosoftOutlook"
+  ]
+}
+
+output "export_manifest_sha256" {
+  value = jamfautoupdate_asset_export.packaging.manifest_sha256
+}
@@ -0,0 +1,13 @@
+# Record exactly which definitions were deployed for change management
+resource "jamfautoupdate_audit_manifest" "deployed" {
+  path   = "${path.module}/manifests/deployed-titles.csv"
+  format = "csv"
+  title_names = [
+    "GoogleChrome",
+    "MicrosoftOutlook"
+  ]
+}
+
+output "deployed_manifest_sha256" {
+  value = jamfautoupdate_audit_manifest.deployed.manifest_sha256
+}
@@ -0,0 +1,14 @@
+# Keep a local copy of the catalog on shared storage for offline runners
+resource "jamfautoupdate_definitions_mirror" "shared" {
+  path = "/srv/jamfautoupdate/catalog.json"
+}
+
+# Offline runners then read titles from the mirror:
+#
+# provider "jamfautoupdate" {
+#   definitions_file = "/srv/jamfautoupdate/catalog.json"
+# }
+
+output "mirrored_catalog_sha256" {
+  value = jamfautoupdate_definitions_mirror.shared.catalog_sha256
+}
@@ -0,0 +1,34 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// apiMediaTypeFormat formats the media type of a Definitions API version.
+const apiMediaTypeFormat = "application/vnd.jamf.autoupdate.v%d+json"
+
+// SetAPIVersion requests titles in the given Definitions API version by sending an Accept header
+// naming its media type, with application/json as a fallback so servers without versioned
+// responses keep working. Responses are decoded according to the schema version the catalog
+// declares, so a server answering with an older version is still read correctly, and versions the
+// client cannot parse fail with an UnsupportedSchemaVersionError rather than being misread. Only
+// versions the client can parse may be requested.
+func (c *Client) SetAPIVersion(version int) error {
+	if _, ok := titleDecoders[version]; !ok {
+		return fmt.Errorf("API version %d is not supported (supported versions: %s)", version, joinVersions(SupportedSchemaVersions()))
+	}
+	c.apiVersion = version
+	return nil
+}
+
+// setAcceptHeader adds the Accept header negotiating the configured API version to req.
+func (c *Client) setAcceptHeader(req *http.Request) {
+	if c.apiVersion == 0 {
+		return
+	}
+	req.Header.Set("Accept", fmt.Sprintf(apiMediaTypeFormat, c.apiVersion)+", application/json;q=0.9")
+}
@@ -0,0 +1,85 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSetAPIVersion_AcceptHeader(t *testing.T) {
+	var accept string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		accept = r.Header.Get("Accept")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	if _, err := c.GetTitles(context.Background(), "Firefox"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if accept != "" {
+		t.Errorf("expected no Accept header by default, got %q", accept)
+	}
+
+	if err := c.SetAPIVersion(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetTitles(context.Background(), "GoogleChrome"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "application/vnd.jamf.autoupdate.v2+json, application/json;q=0.9"; accept != want {
+		t.Errorf("expected Accept header %q, got %q", want, accept)
+	}
+}
+
+func TestSetAPIVersion_DecodesDeclaredSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		body    string
+		wantErr bool
+	}{
+		{"undeclared version", testMultipleTitlesJSON, false},
+		{"unsupported version", `{"schema_version":99,"titles":[]}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/vnd.jamf.autoupdate.v2+json")
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "")
+			if err := c.SetAPIVersion(2); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			_, err := c.GetTitles(context.Background())
+			if _, ok := errors.AsType[*UnsupportedSchemaVersionError](err); ok != tt.wantErr {
+				t.Errorf("expected UnsupportedSchemaVersionError: %v, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestSetAPIVersion_Invalid(t *testing.T) {
+	c := NewClient("", "")
+	for _, version := range []int{-1, 0, 1, 3} {
+		err := c.SetAPIVersion(version)
+		if err == nil {
+			t.Errorf("expected error for API version %d", version)
+			continue
+		}
+		if !strings.Contains(err.Error(), "supported versions: 2") {
+			t.Errorf("expected the supported versions in the error, got %v", err)
+		}
+	}
+}
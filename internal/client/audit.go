@@ -0,0 +1,84 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// auditRecord is a single line of the audit log, describing one catalog read.
+type auditRecord struct {
+	Timestamp       string            `json:"timestamp"`
+	Source          string            `json:"source"`
+	TitlesRequested []string          `json:"titles_requested"`
+	Versions        map[string]string `json:"versions"`
+	ContentSHA256   string            `json:"content_sha256"`
+}
+
+// SetAuditLog appends a JSON line describing every successful catalog read to the file at path:
+// when it happened, the source that served it, the titles requested, the versions returned and a
+// SHA-256 hash of the returned titles. The file is created if it does not exist.
+func (c *Client) SetAuditLog(path string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("error opening audit log: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error opening audit log: %w", err)
+	}
+
+	c.auditLogPath = path
+	return nil
+}
+
+// writeAuditRecord appends a record of a catalog read to the audit log.
+func (c *Client) writeAuditRecord(source Source, titleNames []string, titles []Title) error {
+	content, err := json.Marshal(titles)
+	if err != nil {
+		return fmt.Errorf("error writing audit log: %w", err)
+	}
+	hash := sha256.Sum256(content)
+
+	record := auditRecord{
+		Timestamp:       source.FetchedAt.UTC().Format(time.RFC3339Nano),
+		Source:          source.Location,
+		TitlesRequested: titleNames,
+		Versions:        make(map[string]string, len(titles)),
+		ContentSHA256:   hex.EncodeToString(hash[:]),
+	}
+	if record.TitlesRequested == nil {
+		record.TitlesRequested = []string{}
+	}
+	for _, title := range titles {
+		if title.TitleName != nil && title.TitleVersion != nil {
+			record.Versions[*title.TitleName] = *title.TitleVersion
+		}
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		return fmt.Errorf("error writing audit log: %w", err)
+	}
+
+	c.auditMu.Lock()
+	defer c.auditMu.Unlock()
+
+	file, err := os.OpenFile(c.auditLogPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("error writing audit log: %w", err)
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("error writing audit log: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error writing audit log: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,132 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// readAuditRecords returns the records in the audit log at path.
+func readAuditRecords(t *testing.T, path string) []auditRecord {
+	t.Helper()
+
+	file, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() { _ = file.Close() }()
+
+	var records []auditRecord
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var record auditRecord
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("invalid audit line %q: %v", scanner.Text(), err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestAuditLog_RecordsEachRead(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	c := NewClient(server.URL, "")
+	if err := c.SetAuditLog(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for range 2 {
+		if _, err := c.GetTitles(context.Background(), "GoogleChrome"); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	records := readAuditRecords(t, path)
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+
+	record := records[0]
+	if record.Source != server.URL+"/GoogleChrome" {
+		t.Errorf("unexpected source %q", record.Source)
+	}
+	if !slices.Equal(record.TitlesRequested, []string{"GoogleChrome"}) {
+		t.Errorf("unexpected titles requested %v", record.TitlesRequested)
+	}
+	if record.Versions["GoogleChrome"] != "1.0" {
+		t.Errorf("unexpected versions %v", record.Versions)
+	}
+	if len(record.ContentSHA256) != 64 || record.ContentSHA256 != records[1].ContentSHA256 {
+		t.Errorf("expected stable content hash, got %q and %q", record.ContentSHA256, records[1].ContentSHA256)
+	}
+	if record.Timestamp == "" {
+		t.Error("expected timestamp")
+	}
+}
+
+func TestAuditLog_FileSource(t *testing.T) {
+	dir := t.TempDir()
+	definitions := filepath.Join(dir, "definitions.json")
+	if err := os.WriteFile(definitions, []byte(testTitleJSON), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	path := filepath.Join(dir, "audit.jsonl")
+	c := NewClient("", definitions)
+	if err := c.SetAuditLog(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records := readAuditRecords(t, path)
+	if len(records) != 1 || records[0].Source != definitions {
+		t.Fatalf("unexpected records %+v", records)
+	}
+	if records[0].TitlesRequested == nil {
+		t.Error("expected an empty list of requested titles, not null")
+	}
+}
+
+func TestAuditLog_NotWrittenOnFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	c := NewClient(server.URL, "")
+	if err := c.SetAuditLog(path); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetTitles(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+
+	if records := readAuditRecords(t, path); len(records) != 0 {
+		t.Errorf("expected no records, got %d", len(records))
+	}
+}
+
+func TestSetAuditLog_InvalidPath(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	if err := c.SetAuditLog(filepath.Join(t.TempDir(), "missing", "audit.jsonl")); err == nil {
+		t.Fatal("expected error for unwritable path")
+	}
+}
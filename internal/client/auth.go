@@ -0,0 +1,58 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// SetAuthToken sets the token sent as a bearer token in the Authorization header of every request
+// to the definitions server. An empty token sends no credentials.
+func (c *Client) SetAuthToken(token string) {
+	c.authToken = token
+}
+
+// SetBasicAuth sets the username and password sent with HTTP Basic authentication in the
+// Authorization header of every request to the definitions server, for mirrors protected with
+// basic auth. An empty username sends no credentials.
+func (c *Client) SetBasicAuth(username, password string) {
+	c.basicUsername, c.basicPassword = username, password
+}
+
+// authMechanism reports the authentication mechanism the client uses for requests.
+func (c *Client) authMechanism() string {
+	if c.oauth != nil {
+		return AuthMechanismOAuth2
+	}
+	if c.basicUsername != "" {
+		return AuthMechanismBasic
+	}
+	if c.authToken != "" {
+		return AuthMechanismBearer
+	}
+	return AuthMechanismNone
+}
+
+// setAuthHeader adds the Authorization header for the configured credentials to req, obtaining an
+// OAuth2 access token first when client credentials are configured.
+func (c *Client) setAuthHeader(ctx context.Context, req *http.Request) error {
+	if c.basicUsername != "" {
+		req.SetBasicAuth(c.basicUsername, c.basicPassword)
+		return nil
+	}
+	token := c.authToken
+	if c.oauth != nil {
+		var err error
+		token, err = c.oauth.accessToken(ctx, c.logger)
+		if err != nil {
+			return fmt.Errorf("error obtaining OAuth2 access token: %w", err)
+		}
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return nil
+}
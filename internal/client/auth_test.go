@@ -0,0 +1,131 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestSetAuthToken_Bearer(t *testing.T) {
+	var mu sync.Mutex
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Get("Authorization"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetAuthToken("s3cr3t")
+
+	if _, err := c.GetTitles(context.Background(), "GoogleChrome"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c.CheckHealth(context.Background())
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(headers) < 2 {
+		t.Fatalf("expected a catalog and a health request, got %d requests", len(headers))
+	}
+	for i, header := range headers {
+		if header != "Bearer s3cr3t" {
+			t.Errorf("request %d: expected bearer Authorization header, got %q", i, header)
+		}
+	}
+}
+
+func TestSetBasicAuth(t *testing.T) {
+	var mu sync.Mutex
+	var credentials []string
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		mu.Lock()
+		if ok {
+			credentials = append(credentials, username+":"+password)
+		} else {
+			credentials = append(credentials, "")
+		}
+		mu.Unlock()
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+	primary := httptest.NewServer(handler)
+	defer primary.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "terraform" || password != "s3cr3t" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer mirror.Close()
+
+	c := NewClient(primary.URL, "")
+	c.maxRetries = 0
+	c.SetMirrors(mirror.URL)
+	c.SetBasicAuth("terraform", "s3cr3t")
+
+	if _, err := c.GetTitles(context.Background(), "GoogleChrome"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(credentials) == 0 {
+		t.Fatal("expected a request to the primary server")
+	}
+	for i, got := range credentials {
+		if got != "terraform:s3cr3t" {
+			t.Errorf("request %d: expected basic credentials, got %q", i, got)
+		}
+	}
+	if mechanism := c.authMechanism(); mechanism != AuthMechanismBasic {
+		t.Errorf("expected basic mechanism, got %q", mechanism)
+	}
+}
+
+func TestSetAuthToken_Unset(t *testing.T) {
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(server.URL, "").GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if header != "" {
+		t.Errorf("expected no Authorization header, got %q", header)
+	}
+}
+
+func TestSetAuthToken_Rejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetAuthToken("expired")
+
+	_, err := c.GetTitles(context.Background())
+	authErr, ok := errors.AsType[*AuthenticationError](err)
+	if !ok {
+		t.Fatalf("expected AuthenticationError, got %v", err)
+	}
+	if authErr.Mechanism != AuthMechanismBearer {
+		t.Errorf("expected bearer mechanism, got %q", authErr.Mechanism)
+	}
+}
@@ -0,0 +1,595 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bufio"
+	"cmp"
+	"context"
+	"crypto/sha1" //nolint:gosec // the AWS CLI names SSO cache files after the SHA-1 of the session
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultAWSProfile is the shared configuration profile used unless AWS_PROFILE names another.
+const defaultAWSProfile = "default"
+
+// Endpoints and limits of the AWS credential sources.
+const (
+	// defaultECSCredentialsEndpoint serves task role credentials to containers on Amazon ECS.
+	defaultECSCredentialsEndpoint = "http://169.254.170.2"
+	// defaultIMDSEndpoint is the EC2 instance metadata service.
+	defaultIMDSEndpoint = "http://169.254.169.254"
+	// imdsProbeTimeout bounds the first request to an instance metadata service, of EC2, Google
+	// Cloud or Azure, so resolving credentials outside that cloud does not wait for the full
+	// request timeout.
+	imdsProbeTimeout = time.Second
+	// imdsTokenTTL is the lifetime, in seconds, of the IMDSv2 session token requested.
+	imdsTokenTTL = "21600"
+	// maxSourceProfileDepth is the longest chain of source_profile references followed.
+	maxSourceProfileDepth = 5
+	// stsAPIVersion is the AWS Security Token Service API version requests are made with.
+	stsAPIVersion = "2011-06-15"
+	// defaultRoleSessionName names assumed role sessions unless the profile names one.
+	defaultRoleSessionName = "terraform-provider-jamfautoupdate"
+)
+
+// awsCredentials are the AWS credentials requests to S3 are signed with.
+type awsCredentials struct {
+	accessKeyID     string
+	secretAccessKey string
+	sessionToken    string
+	// expires is when temporary credentials expire, and zero for long-term credentials.
+	expires time.Time
+}
+
+// awsCredentialCache resolves AWS credentials once per client and caches them until shortly
+// before they expire, so credential files and endpoints are not read for every request.
+type awsCredentialCache struct {
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	resolved bool
+	creds    *awsCredentials
+}
+
+// newAWSCredentialCache returns a credential cache that sends requests to credential endpoints
+// through transport.
+func newAWSCredentialCache(transport http.RoundTripper) *awsCredentialCache {
+	return &awsCredentialCache{httpClient: &http.Client{Timeout: defaultHTTPTimeout, Transport: transport}}
+}
+
+// credentials returns the cached AWS credentials, resolving them again when none were resolved yet
+// or the cached ones are about to expire. It returns nil when no credentials are configured.
+// Failures are not cached, so the next request tries again.
+func (c *awsCredentialCache) credentials(ctx context.Context) (*awsCredentials, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.resolved && (c.creds == nil || c.creds.expires.IsZero() || time.Now().Add(tokenRefreshMargin).Before(c.creds.expires)) {
+		return c.creds, nil
+	}
+
+	creds, err := resolveAWSCredentials(ctx, c.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	c.creds, c.resolved = creds, true
+	return creds, nil
+}
+
+// resolveAWSCredentials resolves AWS credentials in the order of the standard AWS credential chain:
+//   - the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and AWS_SESSION_TOKEN environment variables
+//   - the role in AWS_ROLE_ARN assumed with the web identity token in AWS_WEB_IDENTITY_TOKEN_FILE,
+//     as set up by IAM roles for service accounts on EKS
+//   - the profile named by AWS_PROFILE, or the default profile, in the shared credentials file at
+//     AWS_SHARED_CREDENTIALS_FILE or ~/.aws/credentials and the shared config file at
+//     AWS_CONFIG_FILE or ~/.aws/config, including roles assumed with role_arn and IAM Identity
+//     Center (SSO) sessions signed in with `aws sso login`
+//   - the container credentials endpoint of Amazon ECS and EKS Pod Identity
+//   - the EC2 instance metadata service, unless AWS_EC2_METADATA_DISABLED is true
+//
+// It returns nil when no credentials are configured.
+func resolveAWSCredentials(ctx context.Context, httpClient *http.Client) (*awsCredentials, error) {
+	if creds := environmentAWSCredentials(); creds != nil {
+		return creds, nil
+	}
+
+	if tokenFile, roleARN := os.Getenv("AWS_WEB_IDENTITY_TOKEN_FILE"), os.Getenv("AWS_ROLE_ARN"); tokenFile != "" && roleARN != "" {
+		return assumeRoleWithWebIdentity(ctx, httpClient, awsRegion(nil), roleARN, os.Getenv("AWS_ROLE_SESSION_NAME"), tokenFile)
+	}
+
+	profiles, err := loadAWSProfiles()
+	if err != nil {
+		return nil, err
+	}
+	profile := os.Getenv("AWS_PROFILE")
+	explicitProfile := profile != ""
+	if !explicitProfile {
+		profile = defaultAWSProfile
+	}
+	if _, ok := profiles[profile]; ok {
+		creds, err := resolveAWSProfile(ctx, httpClient, profiles, profile, 0)
+		if err != nil || creds != nil {
+			return creds, err
+		}
+	} else if explicitProfile {
+		return nil, fmt.Errorf("AWS profile %q not found in the shared credentials or config file", profile)
+	}
+
+	if containerCredentialsConfigured() {
+		return containerAWSCredentials(ctx, httpClient)
+	}
+
+	if strings.EqualFold(os.Getenv("AWS_EC2_METADATA_DISABLED"), "true") {
+		return nil, nil
+	}
+	// Off EC2 the metadata service is unreachable, which only means no credentials are configured.
+	creds, err := instanceAWSCredentials(ctx, httpClient)
+	if err != nil {
+		return nil, nil
+	}
+	return creds, nil
+}
+
+// environmentAWSCredentials returns the credentials in the AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY
+// and AWS_SESSION_TOKEN environment variables, or nil when they are not set.
+func environmentAWSCredentials() *awsCredentials {
+	id, secret := os.Getenv("AWS_ACCESS_KEY_ID"), os.Getenv("AWS_SECRET_ACCESS_KEY")
+	if id == "" || secret == "" {
+		return nil
+	}
+	return &awsCredentials{accessKeyID: id, secretAccessKey: secret, sessionToken: os.Getenv("AWS_SESSION_TOKEN")}
+}
+
+// awsRegion returns the region credential endpoints are called in: AWS_REGION, AWS_DEFAULT_REGION,
+// the region of profile or defaultS3Region.
+func awsRegion(profile map[string]string) string {
+	return cmp.Or(firstEnv("AWS_REGION", "AWS_DEFAULT_REGION"), profile["region"], defaultS3Region)
+}
+
+// loadAWSProfiles reads the profiles of the shared config and credentials files, keyed by profile
+// name. Settings in the credentials file take precedence. sso-session sections of the config file
+// are keyed as "sso-session <name>". Files that do not exist are ignored.
+func loadAWSProfiles() (map[string]map[string]string, error) {
+	home, _ := os.UserHomeDir()
+	configPath := cmp.Or(os.Getenv("AWS_CONFIG_FILE"), filepath.Join(home, ".aws", "config"))
+	credentialsPath := cmp.Or(os.Getenv("AWS_SHARED_CREDENTIALS_FILE"), filepath.Join(home, ".aws", "credentials"))
+
+	config, err := readINIFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading shared config file: %w", err)
+	}
+	credentials, err := readINIFile(credentialsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading shared credentials file: %w", err)
+	}
+
+	profiles := make(map[string]map[string]string)
+	merge := func(name string, settings map[string]string) {
+		if profiles[name] == nil {
+			profiles[name] = make(map[string]string)
+		}
+		maps.Copy(profiles[name], settings)
+	}
+	for section, settings := range config {
+		// The config file names profiles "profile <name>", except for the default profile.
+		if name, ok := strings.CutPrefix(section, "profile "); ok {
+			merge(strings.TrimSpace(name), settings)
+		} else if section == defaultAWSProfile || strings.HasPrefix(section, "sso-session ") {
+			merge(section, settings)
+		}
+	}
+	for section, settings := range credentials {
+		merge(section, settings)
+	}
+	return profiles, nil
+}
+
+// readINIFile reads the sections of the INI file at path, as used by the shared AWS config and
+// credentials files. It returns no sections when the file does not exist.
+func readINIFile(path string) (map[string]map[string]string, error) {
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	sections := make(map[string]map[string]string)
+	var current map[string]string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if section, ok := strings.CutPrefix(line, "["); ok {
+			name := strings.TrimSpace(strings.TrimSuffix(section, "]"))
+			if sections[name] == nil {
+				sections[name] = make(map[string]string)
+			}
+			current = sections[name]
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || current == nil {
+			continue
+		}
+		current[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return sections, nil
+}
+
+// resolveAWSProfile returns the credentials of the named profile: a role assumed with role_arn, an
+// IAM Identity Center role or static access keys. It returns nil when the profile configures none
+// of them. depth counts the source_profile references followed to reach the profile.
+func resolveAWSProfile(ctx context.Context, httpClient *http.Client, profiles map[string]map[string]string, name string, depth int) (*awsCredentials, error) {
+	profile, ok := profiles[name]
+	if !ok {
+		return nil, fmt.Errorf("AWS profile %q not found in the shared credentials or config file", name)
+	}
+	if depth > maxSourceProfileDepth {
+		return nil, fmt.Errorf("AWS profile %q: source_profile chain is longer than %d profiles", name, maxSourceProfileDepth)
+	}
+
+	static := staticProfileCredentials(profile)
+	roleARN := profile["role_arn"]
+	switch {
+	case roleARN != "" && profile["web_identity_token_file"] != "":
+		return assumeRoleWithWebIdentity(ctx, httpClient, awsRegion(profile), roleARN, profile["role_session_name"], profile["web_identity_token_file"])
+	case roleARN != "":
+		source, err := roleSourceCredentials(ctx, httpClient, profiles, name, depth)
+		if err != nil {
+			return nil, err
+		}
+		return assumeRole(ctx, httpClient, *source, awsRegion(profile), roleARN, profile["role_session_name"], profile["external_id"])
+	case profile["sso_account_id"] != "" && profile["sso_role_name"] != "":
+		return ssoAWSCredentials(ctx, httpClient, profiles, name)
+	default:
+		return static, nil
+	}
+}
+
+// roleSourceCredentials returns the credentials the role of the named profile is assumed with,
+// from its source_profile or credential_source.
+func roleSourceCredentials(ctx context.Context, httpClient *http.Client, profiles map[string]map[string]string, name string, depth int) (*awsCredentials, error) {
+	profile := profiles[name]
+	var source *awsCredentials
+	var err error
+	switch sourceProfile, credentialSource := profile["source_profile"], profile["credential_source"]; {
+	case sourceProfile == name:
+		// A profile may name itself as the source of its role to assume it with its own access keys.
+		source = staticProfileCredentials(profile)
+	case sourceProfile != "":
+		source, err = resolveAWSProfile(ctx, httpClient, profiles, sourceProfile, depth+1)
+	case credentialSource == "Environment":
+		source = environmentAWSCredentials()
+	case credentialSource == "EcsContainer":
+		source, err = containerAWSCredentials(ctx, httpClient)
+	case credentialSource == "Ec2InstanceMetadata":
+		source, err = instanceAWSCredentials(ctx, httpClient)
+	case credentialSource != "":
+		return nil, fmt.Errorf("AWS profile %q: unsupported credential_source %q", name, credentialSource)
+	default:
+		return nil, fmt.Errorf("AWS profile %q sets role_arn without source_profile, credential_source or web_identity_token_file", name)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("AWS profile %q: %w", name, err)
+	}
+	if source == nil {
+		return nil, fmt.Errorf("AWS profile %q: no credentials to assume role_arn with", name)
+	}
+	return source, nil
+}
+
+// staticProfileCredentials returns the access keys of profile, or nil when it has none.
+func staticProfileCredentials(profile map[string]string) *awsCredentials {
+	if profile["aws_access_key_id"] == "" || profile["aws_secret_access_key"] == "" {
+		return nil
+	}
+	return &awsCredentials{
+		accessKeyID:     profile["aws_access_key_id"],
+		secretAccessKey: profile["aws_secret_access_key"],
+		sessionToken:    profile["aws_session_token"],
+	}
+}
+
+// stsResponse is the response of the AssumeRole and AssumeRoleWithWebIdentity STS actions.
+type stsResponse struct {
+	AssumeRole      stsCredentials `xml:"AssumeRoleResult>Credentials"`
+	WebIdentityRole stsCredentials `xml:"AssumeRoleWithWebIdentityResult>Credentials"`
+}
+
+// stsCredentials are the temporary credentials of an assumed role.
+type stsCredentials struct {
+	AccessKeyID     string    `xml:"AccessKeyId"`
+	SecretAccessKey string    `xml:"SecretAccessKey"`
+	SessionToken    string    `xml:"SessionToken"`
+	Expiration      time.Time `xml:"Expiration"`
+}
+
+// stsEndpoint returns the STS endpoint of region. AWS_ENDPOINT_URL_STS or AWS_ENDPOINT_URL
+// override it.
+func stsEndpoint(region string) string {
+	return cmp.Or(strings.TrimSuffix(firstEnv("AWS_ENDPOINT_URL_STS", "AWS_ENDPOINT_URL"), "/"), fmt.Sprintf("https://sts.%s.amazonaws.com", region))
+}
+
+// assumeRole assumes roleARN with source credentials using the STS AssumeRole action.
+func assumeRole(ctx context.Context, httpClient *http.Client, source awsCredentials, region, roleARN, sessionName, externalID string) (*awsCredentials, error) {
+	query := url.Values{
+		"Action":          {"AssumeRole"},
+		"Version":         {stsAPIVersion},
+		"RoleArn":         {roleARN},
+		"RoleSessionName": {cmp.Or(sessionName, defaultRoleSessionName)},
+	}
+	if externalID != "" {
+		query.Set("ExternalId", externalID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, stsEndpoint(region)+"/?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating AssumeRole request: %w", err)
+	}
+	signV4(req, source, region, "sts", time.Now())
+
+	var resp stsResponse
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return xml.Unmarshal(body, &resp) }); err != nil {
+		return nil, fmt.Errorf("error assuming AWS role %s: %w", roleARN, err)
+	}
+	return resp.AssumeRole.credentials()
+}
+
+// assumeRoleWithWebIdentity assumes roleARN with the OIDC token in tokenFile using the STS
+// AssumeRoleWithWebIdentity action, which is not signed.
+func assumeRoleWithWebIdentity(ctx context.Context, httpClient *http.Client, region, roleARN, sessionName, tokenFile string) (*awsCredentials, error) {
+	token, err := os.ReadFile(tokenFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading web identity token file: %w", err)
+	}
+
+	form := url.Values{
+		"Action":           {"AssumeRoleWithWebIdentity"},
+		"Version":          {stsAPIVersion},
+		"RoleArn":          {roleARN},
+		"RoleSessionName":  {cmp.Or(sessionName, defaultRoleSessionName)},
+		"WebIdentityToken": {strings.TrimSpace(string(token))},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, stsEndpoint(region)+"/", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating AssumeRoleWithWebIdentity request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var resp stsResponse
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return xml.Unmarshal(body, &resp) }); err != nil {
+		return nil, fmt.Errorf("error assuming AWS role %s with web identity: %w", roleARN, err)
+	}
+	return resp.WebIdentityRole.credentials()
+}
+
+// credentials converts the credentials of an assumed role.
+func (c stsCredentials) credentials() (*awsCredentials, error) {
+	if c.AccessKeyID == "" || c.SecretAccessKey == "" {
+		return nil, errors.New("STS response has no credentials")
+	}
+	return &awsCredentials{accessKeyID: c.AccessKeyID, secretAccessKey: c.SecretAccessKey, sessionToken: c.SessionToken, expires: c.Expiration}, nil
+}
+
+// ssoCachedToken is an IAM Identity Center access token cached by `aws sso login`.
+type ssoCachedToken struct {
+	AccessToken string    `json:"accessToken"`
+	ExpiresAt   time.Time `json:"expiresAt"`
+}
+
+// ssoRoleCredentialsResponse is the response of the IAM Identity Center GetRoleCredentials action.
+type ssoRoleCredentialsResponse struct {
+	RoleCredentials struct {
+		AccessKeyID     string `json:"accessKeyId"`
+		SecretAccessKey string `json:"secretAccessKey"`
+		SessionToken    string `json:"sessionToken"`
+		// Expiration is in milliseconds since the epoch.
+		Expiration int64 `json:"expiration"`
+	} `json:"roleCredentials"`
+}
+
+// ssoAWSCredentials returns the credentials of the IAM Identity Center role of the named profile,
+// using the access token `aws sso login` cached for its SSO session.
+func ssoAWSCredentials(ctx context.Context, httpClient *http.Client, profiles map[string]map[string]string, name string) (*awsCredentials, error) {
+	profile := profiles[name]
+	// Legacy profiles configure the start URL directly; newer ones reference an sso-session section.
+	cacheKey, region := profile["sso_start_url"], profile["sso_region"]
+	if session := profile["sso_session"]; session != "" {
+		settings, ok := profiles["sso-session "+session]
+		if !ok {
+			return nil, fmt.Errorf("AWS profile %q: sso-session %q not found in the shared config file", name, session)
+		}
+		cacheKey, region = session, cmp.Or(settings["sso_region"], region)
+	}
+	if cacheKey == "" || region == "" {
+		return nil, fmt.Errorf("AWS profile %q must set sso_session or sso_start_url and sso_region", name)
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return nil, fmt.Errorf("error locating the SSO token cache: %w", err)
+	}
+	sum := sha1.Sum([]byte(cacheKey)) //nolint:gosec // cache file naming, not a security control
+	data, err := os.ReadFile(filepath.Join(home, ".aws", "sso", "cache", hex.EncodeToString(sum[:])+".json"))
+	if err != nil {
+		return nil, fmt.Errorf("AWS profile %q: no cached SSO token, run `aws sso login --profile %s`: %w", name, name, err)
+	}
+	var token ssoCachedToken
+	if err := json.Unmarshal(data, &token); err != nil {
+		return nil, fmt.Errorf("error decoding cached SSO token: %w", err)
+	}
+	if token.AccessToken == "" || time.Now().After(token.ExpiresAt) {
+		return nil, fmt.Errorf("AWS profile %q: the SSO session has expired, run `aws sso login --profile %s`", name, name)
+	}
+
+	endpoint := cmp.Or(strings.TrimSuffix(os.Getenv("AWS_ENDPOINT_URL_SSO"), "/"), fmt.Sprintf("https://portal.sso.%s.amazonaws.com", region))
+	query := url.Values{"account_id": {profile["sso_account_id"]}, "role_name": {profile["sso_role_name"]}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"/federation/credentials?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating SSO credentials request: %w", err)
+	}
+	req.Header.Set("X-Amz-Sso_bearer_token", token.AccessToken)
+
+	var resp ssoRoleCredentialsResponse
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return json.Unmarshal(body, &resp) }); err != nil {
+		return nil, fmt.Errorf("error getting SSO role credentials: %w", err)
+	}
+	role := resp.RoleCredentials
+	if role.AccessKeyID == "" || role.SecretAccessKey == "" {
+		return nil, errors.New("SSO response has no credentials")
+	}
+	return &awsCredentials{
+		accessKeyID:     role.AccessKeyID,
+		secretAccessKey: role.SecretAccessKey,
+		sessionToken:    role.SessionToken,
+		expires:         time.UnixMilli(role.Expiration),
+	}, nil
+}
+
+// metadataCredentials are the credentials served by the ECS container credentials endpoint and
+// the EC2 instance metadata service.
+type metadataCredentials struct {
+	AccessKeyID     string    `json:"AccessKeyId"`
+	SecretAccessKey string    `json:"SecretAccessKey"`
+	Token           string    `json:"Token"`
+	Expiration      time.Time `json:"Expiration"`
+}
+
+// credentials converts metadata credentials.
+func (c metadataCredentials) credentials() (*awsCredentials, error) {
+	if c.AccessKeyID == "" || c.SecretAccessKey == "" {
+		return nil, errors.New("metadata response has no credentials")
+	}
+	return &awsCredentials{accessKeyID: c.AccessKeyID, secretAccessKey: c.SecretAccessKey, sessionToken: c.Token, expires: c.Expiration}, nil
+}
+
+// containerCredentialsConfigured reports whether the container credentials endpoint is configured.
+func containerCredentialsConfigured() bool {
+	return firstEnv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "AWS_CONTAINER_CREDENTIALS_FULL_URI") != ""
+}
+
+// containerAWSCredentials returns the credentials served by the container credentials endpoint at
+// AWS_CONTAINER_CREDENTIALS_RELATIVE_URI or AWS_CONTAINER_CREDENTIALS_FULL_URI, authorized with
+// the token in AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE or AWS_CONTAINER_AUTHORIZATION_TOKEN.
+func containerAWSCredentials(ctx context.Context, httpClient *http.Client) (*awsCredentials, error) {
+	endpoint := os.Getenv("AWS_CONTAINER_CREDENTIALS_FULL_URI")
+	if relative := os.Getenv("AWS_CONTAINER_CREDENTIALS_RELATIVE_URI"); relative != "" {
+		endpoint = defaultECSCredentialsEndpoint + relative
+	}
+	if endpoint == "" {
+		return nil, errors.New("the container credentials endpoint is not configured")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating container credentials request: %w", err)
+	}
+	authorization := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN")
+	if tokenFile := os.Getenv("AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE"); tokenFile != "" {
+		token, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading container authorization token file: %w", err)
+		}
+		authorization = strings.TrimSpace(string(token))
+	}
+	if authorization != "" {
+		req.Header.Set("Authorization", authorization)
+	}
+
+	var creds metadataCredentials
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return json.Unmarshal(body, &creds) }); err != nil {
+		return nil, fmt.Errorf("error getting container credentials: %w", err)
+	}
+	return creds.credentials()
+}
+
+// instanceAWSCredentials returns the credentials of the instance profile role from the EC2
+// instance metadata service at AWS_EC2_METADATA_SERVICE_ENDPOINT or its default address, using
+// an IMDSv2 session token.
+func instanceAWSCredentials(ctx context.Context, httpClient *http.Client) (*awsCredentials, error) {
+	endpoint := cmp.Or(strings.TrimSuffix(os.Getenv("AWS_EC2_METADATA_SERVICE_ENDPOINT"), "/"), defaultIMDSEndpoint)
+
+	probeCtx, cancel := context.WithTimeout(ctx, imdsProbeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(probeCtx, http.MethodPut, endpoint+"/latest/api/token", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating instance metadata token request: %w", err)
+	}
+	req.Header.Set("X-Aws-Ec2-Metadata-Token-Ttl-Seconds", imdsTokenTTL)
+	var token string
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { token = string(body); return nil }); err != nil {
+		return nil, fmt.Errorf("error getting instance metadata token: %w", err)
+	}
+
+	get := func(path string, decode func([]byte) error) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+path, nil)
+		if err != nil {
+			return fmt.Errorf("error creating instance metadata request: %w", err)
+		}
+		req.Header.Set("X-Aws-Ec2-Metadata-Token", token)
+		return doCredentialRequest(httpClient, req, decode)
+	}
+
+	const credentialsPath = "/latest/meta-data/iam/security-credentials/"
+	var role string
+	if err := get(credentialsPath, func(body []byte) error {
+		role, _, _ = strings.Cut(strings.TrimSpace(string(body)), "\n")
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("error getting instance profile role: %w", err)
+	}
+	if role == "" {
+		return nil, errors.New("the instance has no instance profile role")
+	}
+
+	var creds metadataCredentials
+	if err := get(credentialsPath+url.PathEscape(role), func(body []byte) error { return json.Unmarshal(body, &creds) }); err != nil {
+		return nil, fmt.Errorf("error getting instance profile credentials: %w", err)
+	}
+	return creds.credentials()
+}
+
+// doCredentialRequest sends a request to a credential endpoint and passes the body of a
+// successful response to decode.
+func doCredentialRequest(httpClient *http.Client, req *http.Request, decode func([]byte) error) error {
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponseSize))
+	if err != nil {
+		return fmt.Errorf("error reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := decode(body); err != nil {
+		return fmt.Errorf("error decoding response: %w", err)
+	}
+	return nil
+}
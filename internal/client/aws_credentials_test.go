@@ -0,0 +1,374 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/sha1" //nolint:gosec // matches the AWS CLI SSO cache file naming
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// clearAWSEnv unsets the AWS environment variables that affect credential resolution, points the
+// shared credentials and config files at paths that do not exist and disables the instance
+// metadata service.
+func clearAWSEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{"AWS_ACCESS_KEY_ID", "AWS_SECRET_ACCESS_KEY", "AWS_SESSION_TOKEN", "AWS_PROFILE",
+		"AWS_REGION", "AWS_DEFAULT_REGION", "AWS_ENDPOINT_URL", "AWS_ENDPOINT_URL_S3", "AWS_ENDPOINT_URL_STS",
+		"AWS_ENDPOINT_URL_SSO", "AWS_WEB_IDENTITY_TOKEN_FILE", "AWS_ROLE_ARN", "AWS_ROLE_SESSION_NAME",
+		"AWS_CONTAINER_CREDENTIALS_RELATIVE_URI", "AWS_CONTAINER_CREDENTIALS_FULL_URI",
+		"AWS_CONTAINER_AUTHORIZATION_TOKEN", "AWS_CONTAINER_AUTHORIZATION_TOKEN_FILE",
+		"AWS_EC2_METADATA_SERVICE_ENDPOINT"} {
+		t.Setenv(name, "")
+	}
+	dir := t.TempDir()
+	t.Setenv("HOME", dir)
+	t.Setenv("AWS_SHARED_CREDENTIALS_FILE", filepath.Join(dir, "credentials"))
+	t.Setenv("AWS_CONFIG_FILE", filepath.Join(dir, "config"))
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "true")
+}
+
+// writeAWSFile writes content to the file named by the environment variable name.
+func writeAWSFile(t *testing.T, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(os.Getenv(name), []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write %s: %v", name, err)
+	}
+}
+
+// resolveTestAWSCredentials resolves credentials with the default HTTP client.
+func resolveTestAWSCredentials() (*awsCredentials, error) {
+	return resolveAWSCredentials(context.Background(), http.DefaultClient)
+}
+
+const testSharedCredentials = `
+# shared credentials
+[default]
+aws_access_key_id = AKIDDEFAULT
+aws_secret_access_key = default-secret
+
+[ci]
+aws_access_key_id=AKIDCI
+aws_secret_access_key=ci-secret
+aws_session_token=ci-session
+`
+
+// testSTSResponse is an AssumeRole response issuing AKIDROLE.
+const testSTSResponse = `<AssumeRoleResponse><AssumeRoleResult><Credentials>
+<AccessKeyId>AKIDROLE</AccessKeyId><SecretAccessKey>role-secret</SecretAccessKey>
+<SessionToken>role-session</SessionToken><Expiration>2099-01-01T00:00:00Z</Expiration>
+</Credentials></AssumeRoleResult></AssumeRoleResponse>`
+
+func TestResolveAWSCredentials_Environment(t *testing.T) {
+	clearAWSEnv(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDENV")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "env-secret")
+	t.Setenv("AWS_SESSION_TOKEN", "env-session")
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDENV" || creds.sessionToken != "env-session" {
+		t.Errorf("expected credentials from the environment, got %+v", creds)
+	}
+}
+
+func TestResolveAWSCredentials_SharedFile(t *testing.T) {
+	clearAWSEnv(t)
+	writeAWSFile(t, "AWS_SHARED_CREDENTIALS_FILE", testSharedCredentials)
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDDEFAULT" {
+		t.Errorf("expected the default profile, got %+v", creds)
+	}
+
+	t.Setenv("AWS_PROFILE", "ci")
+	creds, err = resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDCI" || creds.secretAccessKey != "ci-secret" || creds.sessionToken != "ci-session" {
+		t.Errorf("expected the ci profile, got %+v", creds)
+	}
+
+	t.Setenv("AWS_PROFILE", "missing")
+	if _, err := resolveTestAWSCredentials(); err == nil {
+		t.Error("expected error for a missing profile, got nil")
+	}
+}
+
+func TestResolveAWSCredentials_ConfigFile(t *testing.T) {
+	clearAWSEnv(t)
+	writeAWSFile(t, "AWS_CONFIG_FILE", "[profile build]\naws_access_key_id = AKIDCONFIG\naws_secret_access_key = config-secret\n")
+	t.Setenv("AWS_PROFILE", "build")
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDCONFIG" {
+		t.Errorf("expected the build profile of the config file, got %+v", creds)
+	}
+}
+
+func TestResolveAWSCredentials_AssumeRole(t *testing.T) {
+	clearAWSEnv(t)
+
+	var query, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query, authorization = r.URL.RawQuery, r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(testSTSResponse))
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_STS", server.URL)
+
+	writeAWSFile(t, "AWS_SHARED_CREDENTIALS_FILE", testSharedCredentials)
+	writeAWSFile(t, "AWS_CONFIG_FILE", "[profile deploy]\nrole_arn = arn:aws:iam::123456789012:role/catalog-reader\nsource_profile = ci\nregion = eu-west-1\n")
+	t.Setenv("AWS_PROFILE", "deploy")
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDROLE" || creds.sessionToken != "role-session" || creds.expires.Year() != 2099 {
+		t.Errorf("expected the assumed role credentials, got %+v", creds)
+	}
+	if !strings.Contains(query, "Action=AssumeRole") || !strings.Contains(query, "catalog-reader") {
+		t.Errorf("expected an AssumeRole request for the role, got %q", query)
+	}
+	if !strings.Contains(authorization, "Credential=AKIDCI/") || !strings.Contains(authorization, "/eu-west-1/sts/") {
+		t.Errorf("expected the request to be signed with the source profile in its region, got %q", authorization)
+	}
+}
+
+func TestResolveAWSCredentials_AssumeRoleWithoutSource(t *testing.T) {
+	clearAWSEnv(t)
+	writeAWSFile(t, "AWS_CONFIG_FILE", "[default]\nrole_arn = arn:aws:iam::123456789012:role/catalog-reader\n")
+
+	if _, err := resolveTestAWSCredentials(); err == nil || !strings.Contains(err.Error(), "source_profile") {
+		t.Errorf("expected an error for a role without source credentials, got %v", err)
+	}
+}
+
+func TestResolveAWSCredentials_WebIdentity(t *testing.T) {
+	clearAWSEnv(t)
+
+	var action, token string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse form: %v", err)
+		}
+		action, token = r.PostForm.Get("Action"), r.PostForm.Get("WebIdentityToken")
+		_, _ = w.Write([]byte(`<AssumeRoleWithWebIdentityResponse><AssumeRoleWithWebIdentityResult><Credentials>` +
+			`<AccessKeyId>AKIDIRSA</AccessKeyId><SecretAccessKey>irsa-secret</SecretAccessKey><SessionToken>irsa-session</SessionToken>` +
+			`<Expiration>2099-01-01T00:00:00Z</Expiration></Credentials></AssumeRoleWithWebIdentityResult></AssumeRoleWithWebIdentityResponse>`))
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_STS", server.URL)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("oidc-token\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AWS_WEB_IDENTITY_TOKEN_FILE", tokenFile)
+	t.Setenv("AWS_ROLE_ARN", "arn:aws:iam::123456789012:role/irsa")
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDIRSA" {
+		t.Errorf("expected the web identity role credentials, got %+v", creds)
+	}
+	if action != "AssumeRoleWithWebIdentity" || token != "oidc-token" {
+		t.Errorf("expected an AssumeRoleWithWebIdentity request with the token, got %q %q", action, token)
+	}
+}
+
+func TestResolveAWSCredentials_SSO(t *testing.T) {
+	clearAWSEnv(t)
+
+	var token, query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, query = r.Header.Get("X-Amz-Sso_bearer_token"), r.URL.RawQuery
+		fmt.Fprintf(w, `{"roleCredentials":{"accessKeyId":"AKIDSSO","secretAccessKey":"sso-secret","sessionToken":"sso-session","expiration":%d}}`,
+			time.Now().Add(time.Hour).UnixMilli())
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_SSO", server.URL)
+
+	writeAWSFile(t, "AWS_CONFIG_FILE", "[profile sso]\nsso_session = corp\nsso_account_id = 123456789012\nsso_role_name = CatalogReader\n\n"+
+		"[sso-session corp]\nsso_start_url = https://corp.awsapps.com/start\nsso_region = eu-west-1\n")
+	t.Setenv("AWS_PROFILE", "sso")
+
+	cacheDir := filepath.Join(os.Getenv("HOME"), ".aws", "sso", "cache")
+	if err := os.MkdirAll(cacheDir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	sum := sha1.Sum([]byte("corp")) //nolint:gosec // matches the AWS CLI SSO cache file naming
+	cached := fmt.Sprintf(`{"accessToken":"sso-access-token","expiresAt":%q}`, time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	if err := os.WriteFile(filepath.Join(cacheDir, hex.EncodeToString(sum[:])+".json"), []byte(cached), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDSSO" || creds.expires.IsZero() {
+		t.Errorf("expected the SSO role credentials, got %+v", creds)
+	}
+	if token != "sso-access-token" || !strings.Contains(query, "role_name=CatalogReader") {
+		t.Errorf("expected a GetRoleCredentials request with the cached token, got %q %q", token, query)
+	}
+}
+
+// testMetadataCredentials are credentials in the format of the container and instance metadata endpoints.
+const testMetadataCredentials = `{"AccessKeyId":"AKIDMETA","SecretAccessKey":"meta-secret","Token":"meta-session","Expiration":"2099-01-01T00:00:00Z"}`
+
+func TestResolveAWSCredentials_Container(t *testing.T) {
+	clearAWSEnv(t)
+
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		_, _ = w.Write([]byte(testMetadataCredentials))
+	}))
+	defer server.Close()
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL+"/v2/credentials")
+	t.Setenv("AWS_CONTAINER_AUTHORIZATION_TOKEN", "pod-identity-token")
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDMETA" || creds.sessionToken != "meta-session" {
+		t.Errorf("expected the container credentials, got %+v", creds)
+	}
+	if authorization != "pod-identity-token" {
+		t.Errorf("expected the authorization token, got %q", authorization)
+	}
+}
+
+func TestResolveAWSCredentials_InstanceMetadata(t *testing.T) {
+	clearAWSEnv(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/latest/api/token":
+			_, _ = w.Write([]byte("imds-token"))
+		case r.Header.Get("X-Aws-Ec2-Metadata-Token") != "imds-token":
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/latest/meta-data/iam/security-credentials/":
+			_, _ = w.Write([]byte("catalog-reader"))
+		case r.URL.Path == "/latest/meta-data/iam/security-credentials/catalog-reader":
+			_, _ = w.Write([]byte(testMetadataCredentials))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	t.Setenv("AWS_EC2_METADATA_DISABLED", "")
+	t.Setenv("AWS_EC2_METADATA_SERVICE_ENDPOINT", server.URL)
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds == nil || creds.accessKeyID != "AKIDMETA" {
+		t.Errorf("expected the instance profile credentials, got %+v", creds)
+	}
+}
+
+func TestResolveAWSCredentials_None(t *testing.T) {
+	clearAWSEnv(t)
+
+	creds, err := resolveTestAWSCredentials()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creds != nil {
+		t.Errorf("expected no credentials, got %+v", creds)
+	}
+}
+
+func TestAWSCredentialCache(t *testing.T) {
+	clearAWSEnv(t)
+
+	var requests atomic.Int32
+	expiration := time.Now().Add(time.Hour)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		fmt.Fprintf(w, `{"AccessKeyId":"AKIDMETA","SecretAccessKey":"meta-secret","Expiration":%q}`, expiration.UTC().Format(time.RFC3339))
+	}))
+	defer server.Close()
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", server.URL)
+
+	cache := newAWSCredentialCache(http.DefaultTransport)
+	for range 3 {
+		if _, err := cache.credentials(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected credentials to be resolved once, got %d requests", got)
+	}
+
+	// Credentials about to expire are resolved again.
+	expiration = time.Now().Add(time.Second)
+	cache.creds.expires = expiration
+	if _, err := cache.credentials(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected expiring credentials to be refreshed, got %d requests", got)
+	}
+}
+
+func TestGetTitles_S3ResolvesCredentialsOnce(t *testing.T) {
+	clearAWSEnv(t)
+
+	var credentialRequests atomic.Int32
+	credentialServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		credentialRequests.Add(1)
+		_, _ = w.Write([]byte(testMetadataCredentials))
+	}))
+	defer credentialServer.Close()
+	t.Setenv("AWS_CONTAINER_CREDENTIALS_FULL_URI", credentialServer.URL)
+
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_S3", server.URL)
+
+	c := NewClient("s3://catalogs/definitions.json", "")
+	for range 2 {
+		if _, err := c.GetTitles(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := credentialRequests.Load(); got != 1 {
+		t.Errorf("expected credentials to be resolved once per client, got %d requests", got)
+	}
+	if !strings.Contains(authorization, "Credential=AKIDMETA/") {
+		t.Errorf("expected requests to be signed with the container credentials, got %q", authorization)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Azure Blob Storage defaults, matching the environment variables of the Go CDK azureblob driver.
+const (
+	defaultAzureStorageDomain   = "blob.core.windows.net"
+	defaultAzureStorageProtocol = "https"
+	// azureStorageVersion is the Blob service REST API version requests are made with.
+	azureStorageVersion = "2021-08-06"
+)
+
+// azblobStore reads a catalog blob from Azure Blob Storage, addressed as azblob://container/blob
+// in the storage account named by AZURE_STORAGE_ACCOUNT. Requests are authorized with the SAS
+// token in AZURE_STORAGE_SAS_TOKEN, signed with the account key in AZURE_STORAGE_KEY or carry a
+// Microsoft Entra ID token resolved by resolveAzureToken, such as that of a managed identity, and
+// are sent anonymously, for public containers, when no credentials are configured. AZURE_STORAGE_DOMAIN, AZURE_STORAGE_PROTOCOL and
+// AZURE_STORAGE_IS_LOCAL_EMULATOR point requests at sovereign clouds or a storage emulator.
+type azblobStore struct {
+	account   string
+	container string
+	blob      string
+	key       []byte
+	sasToken  string
+	baseURL   string
+	tokens    *cloudTokenCache
+	// entraID records whether the last request carried a Microsoft Entra ID token, for authMechanism.
+	entraID bool
+}
+
+// newAzblobStore returns the object store for an azblob:// URL.
+func newAzblobStore(c *Client, u *url.URL) (objectStore, error) {
+	blob := strings.TrimPrefix(u.Path, "/")
+	if u.Host == "" || blob == "" {
+		return nil, fmt.Errorf("Azure Blob Storage definitions URL must have the form azblob://container/blob, got %q", u.Redacted())
+	}
+	account := os.Getenv("AZURE_STORAGE_ACCOUNT")
+	if account == "" {
+		return nil, fmt.Errorf("AZURE_STORAGE_ACCOUNT must name the storage account of %s", u.Redacted())
+	}
+
+	store := &azblobStore{
+		account:   account,
+		container: u.Host,
+		blob:      blob,
+		sasToken:  strings.TrimPrefix(os.Getenv("AZURE_STORAGE_SAS_TOKEN"), "?"),
+		tokens:    c.azureTokens,
+	}
+	if key := os.Getenv("AZURE_STORAGE_KEY"); key != "" && store.sasToken == "" {
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return nil, fmt.Errorf("AZURE_STORAGE_KEY is not a base64 encoded account key: %w", err)
+		}
+		store.key = decoded
+	}
+
+	domain := os.Getenv("AZURE_STORAGE_DOMAIN")
+	if domain == "" {
+		domain = defaultAzureStorageDomain
+	}
+	protocol := os.Getenv("AZURE_STORAGE_PROTOCOL")
+	if protocol == "" {
+		protocol = defaultAzureStorageProtocol
+	}
+	// Emulators serve every account from one host and take the account as the first path segment.
+	store.baseURL = fmt.Sprintf("%s://%s.%s", protocol, account, domain)
+	if os.Getenv("AZURE_STORAGE_IS_LOCAL_EMULATOR") == "true" {
+		store.baseURL = fmt.Sprintf("%s://%s/%s", protocol, domain, account)
+	}
+	return store, nil
+}
+
+// newRequest returns a request for the blob, authorized with the configured SAS token or account
+// key, or otherwise with a Microsoft Entra ID token when Azure credentials are configured.
+func (s *azblobStore) newRequest(ctx context.Context, method string) (*http.Request, error) {
+	u, err := url.Parse(s.baseURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid Azure Blob Storage endpoint URL: %w", err)
+	}
+	u.Path += "/" + s.container + "/" + s.blob
+	u.RawPath = sigV4EscapePath(u.Path)
+	u.RawQuery = s.sasToken
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("X-Ms-Version", azureStorageVersion)
+	if s.key != nil {
+		req.Header.Set("X-Ms-Date", time.Now().UTC().Format(http.TimeFormat))
+		req.Header.Set("Authorization", fmt.Sprintf("SharedKey %s:%s", s.account, s.sign(req)))
+		return req, nil
+	}
+	if s.sasToken != "" {
+		return req, nil
+	}
+
+	token, err := s.tokens.accessToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving Azure credentials: %w", err)
+	}
+	s.entraID = token != nil
+	if token != nil {
+		req.Header.Set("Authorization", "Bearer "+token.accessToken)
+	}
+	return req, nil
+}
+
+// sign returns the Shared Key signature of req, which must not have a body.
+func (s *azblobStore) sign(req *http.Request) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(azureStringToSign(req, s.account)))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// azureStringToSign returns the string a Shared Key signature of req is computed over.
+func azureStringToSign(req *http.Request, account string) string {
+	standardHeaders := []string{
+		"Content-Encoding", "Content-Language", "Content-Length", "Content-MD5", "Content-Type", "Date",
+		"If-Modified-Since", "If-Match", "If-None-Match", "If-Unmodified-Since", "Range",
+	}
+	lines := []string{req.Method}
+	for _, name := range standardHeaders {
+		lines = append(lines, req.Header.Get(name))
+	}
+
+	msHeaders := make(map[string]string)
+	for name := range req.Header {
+		if lower := strings.ToLower(name); strings.HasPrefix(lower, "x-ms-") {
+			msHeaders[lower] = strings.TrimSpace(req.Header.Get(name))
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(msHeaders)) {
+		lines = append(lines, name+":"+msHeaders[name])
+	}
+
+	resource := "/" + account + req.URL.EscapedPath()
+	query := req.URL.Query()
+	for _, name := range slices.Sorted(maps.Keys(query)) {
+		values := slices.Clone(query[name])
+		slices.Sort(values)
+		resource += "\n" + strings.ToLower(name) + ":" + strings.Join(values, ",")
+	}
+	lines = append(lines, resource)
+
+	return strings.Join(lines, "\n")
+}
+
+// authMechanism reports how requests for the blob are authorized.
+func (s *azblobStore) authMechanism() string {
+	switch {
+	case s.sasToken != "":
+		return AuthMechanismAzureSAS
+	case s.key != nil:
+		return AuthMechanismAzureSharedKey
+	case s.entraID:
+		return AuthMechanismAzureEntraID
+	default:
+		return AuthMechanismNone
+	}
+}
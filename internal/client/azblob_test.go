@@ -0,0 +1,117 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// setAzureEmulatorEnv points Azure Blob Storage requests at server as a local emulator.
+func setAzureEmulatorEnv(t *testing.T, server *httptest.Server) {
+	t.Helper()
+	t.Setenv("AZURE_STORAGE_ACCOUNT", "devstoreaccount1")
+	t.Setenv("AZURE_STORAGE_DOMAIN", server.Listener.Addr().String())
+	t.Setenv("AZURE_STORAGE_PROTOCOL", "http")
+	t.Setenv("AZURE_STORAGE_IS_LOCAL_EMULATOR", "true")
+	t.Setenv("AZURE_STORAGE_SAS_TOKEN", "")
+	t.Setenv("AZURE_STORAGE_KEY", "")
+}
+
+func TestGetTitles_AzblobSharedKey(t *testing.T) {
+	key := []byte("account-key")
+	var path, authorization string
+	var stringToSign string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		authorization = r.Header.Get("Authorization")
+		stringToSign = azureStringToSign(r, "devstoreaccount1")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+	setAzureEmulatorEnv(t, server)
+	t.Setenv("AZURE_STORAGE_KEY", base64.StdEncoding.EncodeToString(key))
+
+	titles, err := NewClient("azblob://catalogs/definitions.json", "").GetTitles(context.Background(), "GoogleChrome")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 {
+		t.Errorf("expected the requested title to be filtered from the blob, got %d titles", len(titles))
+	}
+	if path != "/devstoreaccount1/catalogs/definitions.json" {
+		t.Errorf("expected an emulator request for the blob, got %q", path)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(stringToSign))
+	want := "SharedKey devstoreaccount1:" + base64.StdEncoding.EncodeToString(mac.Sum(nil))
+	if authorization != want {
+		t.Errorf("expected Authorization %q, got %q", want, authorization)
+	}
+}
+
+func TestGetTitles_AzblobSAS(t *testing.T) {
+	var query, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+	setAzureEmulatorEnv(t, server)
+	t.Setenv("AZURE_STORAGE_SAS_TOKEN", "?sv=2021-08-06&sp=r&sig=abc")
+
+	if _, err := NewClient("azblob://catalogs/definitions.json", "").GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if query != "sv=2021-08-06&sp=r&sig=abc" {
+		t.Errorf("expected the SAS token in the query, got %q", query)
+	}
+	if authorization != "" {
+		t.Errorf("expected no Authorization header with a SAS token, got %q", authorization)
+	}
+}
+
+func TestAzureStringToSign(t *testing.T) {
+	req, err := http.NewRequest(http.MethodGet, "https://account.blob.core.windows.net/catalogs/my%20definitions.json?comp=metadata", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	req.Header.Set("X-Ms-Version", "2021-08-06")
+	req.Header.Set("X-Ms-Date", "Fri, 26 Jun 2015 23:39:12 GMT")
+
+	want := "GET\n\n\n\n\n\n\n\n\n\n\n\n" +
+		"x-ms-date:Fri, 26 Jun 2015 23:39:12 GMT\n" +
+		"x-ms-version:2021-08-06\n" +
+		"/account/catalogs/my%20definitions.json\ncomp:metadata"
+	if got := azureStringToSign(req, "account"); got != want {
+		t.Errorf("unexpected string to sign:\n got: %q\nwant: %q", got, want)
+	}
+}
+
+func TestNewAzblobStore_Invalid(t *testing.T) {
+	t.Setenv("AZURE_STORAGE_ACCOUNT", "")
+	if _, err := NewClient("", "").openObjectStore("azblob://catalogs/definitions.json"); err == nil || !strings.Contains(err.Error(), "AZURE_STORAGE_ACCOUNT") {
+		t.Errorf("expected a missing account error, got %v", err)
+	}
+
+	t.Setenv("AZURE_STORAGE_ACCOUNT", "account")
+	t.Setenv("AZURE_STORAGE_SAS_TOKEN", "")
+	t.Setenv("AZURE_STORAGE_KEY", "not base64!")
+	if _, err := NewClient("", "").openObjectStore("azblob://catalogs/definitions.json"); err == nil {
+		t.Error("expected an invalid key error, got nil")
+	}
+	if _, err := NewClient("", "").openObjectStore("azblob://catalogs"); err == nil {
+		t.Error("expected a missing blob error, got nil")
+	}
+}
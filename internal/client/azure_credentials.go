@@ -0,0 +1,158 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Endpoints and resources of the Azure credential sources.
+const (
+	// azureStorageResource is the resource tokens for Azure Storage are requested for.
+	azureStorageResource = "https://storage.azure.com/"
+	// defaultAzureAuthorityHost is the Microsoft Entra ID endpoint of the public Azure cloud.
+	defaultAzureAuthorityHost = "https://login.microsoftonline.com/"
+	// azureIMDSAPIVersion is the managed identity API version of the Azure instance metadata service.
+	azureIMDSAPIVersion = "2018-02-01"
+	// azureAppServiceAPIVersion is the managed identity API version of App Service and Azure Functions.
+	azureAppServiceAPIVersion = "2019-08-01"
+)
+
+// resolveAzureToken resolves a Microsoft Entra ID token for Azure Storage in the order of the
+// Azure SDK's default credential chain:
+//   - the service principal secret in AZURE_TENANT_ID, AZURE_CLIENT_ID and AZURE_CLIENT_SECRET
+//   - the federated token in AZURE_FEDERATED_TOKEN_FILE, as set up by workload identity on AKS
+//   - the managed identity endpoint at IDENTITY_ENDPOINT, on App Service and Azure Functions
+//   - the managed identity of the virtual machine from the instance metadata service
+//
+// AZURE_CLIENT_ID selects a user-assigned managed identity, and AZURE_AUTHORITY_HOST points
+// token requests at a sovereign cloud. It returns nil when no credentials are configured.
+func resolveAzureToken(ctx context.Context, httpClient *http.Client) (*cloudToken, error) {
+	tenantID, clientID := os.Getenv("AZURE_TENANT_ID"), os.Getenv("AZURE_CLIENT_ID")
+	if secret := os.Getenv("AZURE_CLIENT_SECRET"); tenantID != "" && clientID != "" && secret != "" {
+		return entraClientCredentialsToken(ctx, httpClient, tenantID, clientID, url.Values{"client_secret": {secret}})
+	}
+	if tokenFile := os.Getenv("AZURE_FEDERATED_TOKEN_FILE"); tenantID != "" && clientID != "" && tokenFile != "" {
+		assertion, err := os.ReadFile(tokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading Azure federated token file: %w", err)
+		}
+		return entraClientCredentialsToken(ctx, httpClient, tenantID, clientID, url.Values{
+			"client_assertion_type": {"urn:ietf:params:oauth:client-assertion-type:jwt-bearer"},
+			"client_assertion":      {strings.TrimSpace(string(assertion))},
+		})
+	}
+
+	if endpoint, header := os.Getenv("IDENTITY_ENDPOINT"), os.Getenv("IDENTITY_HEADER"); endpoint != "" && header != "" {
+		return appServiceManagedIdentityToken(ctx, httpClient, endpoint, header, clientID)
+	}
+
+	// Outside Azure the metadata service is unreachable, which only means no credentials are configured.
+	token, err := azureIMDSToken(ctx, httpClient, clientID)
+	if err != nil {
+		return nil, nil
+	}
+	return token, nil
+}
+
+// entraClientCredentialsToken requests a token for clientID in tenantID from Microsoft Entra ID
+// with the client credentials grant, authenticating with the client secret or assertion in auth.
+func entraClientCredentialsToken(ctx context.Context, httpClient *http.Client, tenantID, clientID string, auth url.Values) (*cloudToken, error) {
+	authority := strings.TrimSuffix(cmp.Or(os.Getenv("AZURE_AUTHORITY_HOST"), defaultAzureAuthorityHost), "/")
+	form := url.Values{
+		"grant_type": {"client_credentials"},
+		"client_id":  {clientID},
+		"scope":      {azureStorageResource + ".default"},
+	}
+	for name, values := range auth {
+		form[name] = values
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, authority+"/"+url.PathEscape(tenantID)+"/oauth2/v2.0/token", strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating Microsoft Entra ID token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var token tokenResponse
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return json.Unmarshal(body, &token) }); err != nil {
+		return nil, fmt.Errorf("error getting Microsoft Entra ID token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("Microsoft Entra ID token response contains no access_token")
+	}
+	return &cloudToken{accessToken: token.AccessToken, expires: expiresIn(token.ExpiresIn)}, nil
+}
+
+// managedIdentityToken is the token response of the Azure managed identity endpoints, which report
+// the expiry as Unix time, as a number or a string depending on the endpoint.
+type managedIdentityToken struct {
+	AccessToken string        `json:"access_token"`
+	ExpiresOn   flexibleInt64 `json:"expires_on"`
+}
+
+// token converts a managed identity token response.
+func (t managedIdentityToken) token() (*cloudToken, error) {
+	if t.AccessToken == "" {
+		return nil, errors.New("managed identity token response contains no access_token")
+	}
+	token := &cloudToken{accessToken: t.AccessToken}
+	if t.ExpiresOn > 0 {
+		token.expires = time.Unix(int64(t.ExpiresOn), 0)
+	}
+	return token, nil
+}
+
+// appServiceManagedIdentityToken requests a token of the managed identity of an App Service or
+// Azure Functions app from its identity endpoint.
+func appServiceManagedIdentityToken(ctx context.Context, httpClient *http.Client, endpoint, header, clientID string) (*cloudToken, error) {
+	query := url.Values{"api-version": {azureAppServiceAPIVersion}, "resource": {azureStorageResource}}
+	if clientID != "" {
+		query.Set("client_id", clientID)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating managed identity request: %w", err)
+	}
+	req.Header.Set("X-Identity-Header", header)
+
+	var token managedIdentityToken
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return json.Unmarshal(body, &token) }); err != nil {
+		return nil, fmt.Errorf("error getting managed identity token: %w", err)
+	}
+	return token.token()
+}
+
+// azureIMDSToken requests a token of the virtual machine's managed identity from the instance
+// metadata service at AZURE_POD_IDENTITY_AUTHORITY_HOST or its default address.
+func azureIMDSToken(ctx context.Context, httpClient *http.Client, clientID string) (*cloudToken, error) {
+	endpoint := strings.TrimSuffix(cmp.Or(os.Getenv("AZURE_POD_IDENTITY_AUTHORITY_HOST"), defaultIMDSEndpoint), "/")
+	query := url.Values{"api-version": {azureIMDSAPIVersion}, "resource": {azureStorageResource}}
+	if clientID != "" {
+		query.Set("client_id", clientID)
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, imdsProbeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(probeCtx, http.MethodGet, endpoint+"/metadata/identity/oauth2/token?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating instance metadata request: %w", err)
+	}
+	req.Header.Set("Metadata", "true")
+
+	var token managedIdentityToken
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return json.Unmarshal(body, &token) }); err != nil {
+		return nil, fmt.Errorf("error getting managed identity token: %w", err)
+	}
+	return token.token()
+}
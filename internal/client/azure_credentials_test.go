@@ -0,0 +1,188 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+// clearAzureEnv unsets the environment variables that affect Azure credential resolution and points
+// the instance metadata service at a closed port.
+func clearAzureEnv(t *testing.T) {
+	t.Helper()
+	for _, name := range []string{"AZURE_TENANT_ID", "AZURE_CLIENT_ID", "AZURE_CLIENT_SECRET", "AZURE_FEDERATED_TOKEN_FILE",
+		"AZURE_AUTHORITY_HOST", "IDENTITY_ENDPOINT", "IDENTITY_HEADER"} {
+		t.Setenv(name, "")
+	}
+	t.Setenv("AZURE_POD_IDENTITY_AUTHORITY_HOST", "http://127.0.0.1:1")
+}
+
+// newEntraServer returns a Microsoft Entra ID token endpoint that records the path and form of the
+// last request.
+func newEntraServer(t *testing.T, path *string, form *map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse token request: %v", err)
+		}
+		*path = r.URL.Path
+		*form = make(map[string]string)
+		for name := range r.PostForm {
+			(*form)[name] = r.PostForm.Get(name)
+		}
+		_, _ = w.Write([]byte(`{"token_type":"Bearer","expires_in":3599,"access_token":"entra-token"}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestResolveAzureToken_ClientSecret(t *testing.T) {
+	clearAzureEnv(t)
+	var path string
+	var form map[string]string
+	server := newEntraServer(t, &path, &form)
+	t.Setenv("AZURE_AUTHORITY_HOST", server.URL)
+	t.Setenv("AZURE_TENANT_ID", "tenant")
+	t.Setenv("AZURE_CLIENT_ID", "client")
+	t.Setenv("AZURE_CLIENT_SECRET", "secret")
+
+	token, err := resolveAzureToken(context.Background(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == nil || token.accessToken != "entra-token" || token.expires.IsZero() {
+		t.Errorf("expected the issued token with an expiry, got %+v", token)
+	}
+	if path != "/tenant/oauth2/v2.0/token" {
+		t.Errorf("expected a request for the tenant's token endpoint, got %q", path)
+	}
+	if form["grant_type"] != "client_credentials" || form["client_secret"] != "secret" || form["scope"] != "https://storage.azure.com/.default" {
+		t.Errorf("unexpected token request: %v", form)
+	}
+}
+
+func TestResolveAzureToken_WorkloadIdentity(t *testing.T) {
+	clearAzureEnv(t)
+	var path string
+	var form map[string]string
+	server := newEntraServer(t, &path, &form)
+	t.Setenv("AZURE_AUTHORITY_HOST", server.URL)
+	t.Setenv("AZURE_TENANT_ID", "tenant")
+	t.Setenv("AZURE_CLIENT_ID", "client")
+	t.Setenv("AZURE_FEDERATED_TOKEN_FILE", writeTempFile(t, "federated-token\n"))
+
+	token, err := resolveAzureToken(context.Background(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == nil || token.accessToken != "entra-token" {
+		t.Errorf("expected the issued token, got %+v", token)
+	}
+	if form["client_assertion"] != "federated-token" || form["client_assertion_type"] != "urn:ietf:params:oauth:client-assertion-type:jwt-bearer" {
+		t.Errorf("unexpected token request: %v", form)
+	}
+}
+
+func TestResolveAzureToken_AppService(t *testing.T) {
+	clearAzureEnv(t)
+	var header, resource string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("X-Identity-Header")
+		resource = r.URL.Query().Get("resource")
+		_, _ = w.Write([]byte(`{"access_token":"app-token","expires_on":"4102444800","resource":"https://storage.azure.com/"}`))
+	}))
+	defer server.Close()
+	t.Setenv("IDENTITY_ENDPOINT", server.URL+"/msi/token")
+	t.Setenv("IDENTITY_HEADER", "identity-secret")
+
+	token, err := resolveAzureToken(context.Background(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == nil || token.accessToken != "app-token" || token.expires.Unix() != 4102444800 {
+		t.Errorf("expected the managed identity token, got %+v", token)
+	}
+	if header != "identity-secret" || resource != azureStorageResource {
+		t.Errorf("unexpected managed identity request: header %q, resource %q", header, resource)
+	}
+}
+
+func TestResolveAzureToken_InstanceMetadata(t *testing.T) {
+	clearAzureEnv(t)
+	t.Setenv("AZURE_CLIENT_ID", "user-assigned")
+	var metadata, clientID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		metadata = r.Header.Get("Metadata")
+		clientID = r.URL.Query().Get("client_id")
+		if r.URL.Path != "/metadata/identity/oauth2/token" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"vm-token","expires_in":"3599","expires_on":4102444800}`))
+	}))
+	defer server.Close()
+	t.Setenv("AZURE_POD_IDENTITY_AUTHORITY_HOST", server.URL)
+
+	token, err := resolveAzureToken(context.Background(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == nil || token.accessToken != "vm-token" {
+		t.Errorf("expected the managed identity token, got %+v", token)
+	}
+	if metadata != "true" || clientID != "user-assigned" {
+		t.Errorf("unexpected metadata request: Metadata %q, client_id %q", metadata, clientID)
+	}
+}
+
+func TestResolveAzureToken_None(t *testing.T) {
+	clearAzureEnv(t)
+
+	token, err := resolveAzureToken(context.Background(), http.DefaultClient)
+	if err != nil || token != nil {
+		t.Errorf("expected no token, got %+v, %v", token, err)
+	}
+}
+
+func TestGetTitles_AzblobManagedIdentity(t *testing.T) {
+	clearAzureEnv(t)
+	var tokenRequests atomic.Int32
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests.Add(1)
+		_, _ = w.Write([]byte(`{"access_token":"vm-token","expires_on":"4102444800"}`))
+	}))
+	defer metadata.Close()
+	t.Setenv("AZURE_POD_IDENTITY_AUTHORITY_HOST", metadata.URL)
+
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	setAzureEmulatorEnv(t, server)
+
+	c := NewClient("azblob://catalogs/definitions.json", "")
+	for range 2 {
+		_, err := c.GetTitles(context.Background())
+		authErr, ok := errors.AsType[*AuthenticationError](err)
+		if !ok {
+			t.Fatalf("expected AuthenticationError, got %v", err)
+		}
+		if authErr.Mechanism != AuthMechanismAzureEntraID {
+			t.Errorf("expected azure_entra_id mechanism, got %q", authErr.Mechanism)
+		}
+	}
+	if got := tokenRequests.Load(); got != 1 {
+		t.Errorf("expected the token to be resolved once per client, got %d requests", got)
+	}
+	if authorization != "Bearer vm-token" {
+		t.Errorf("expected the managed identity token, got %q", authorization)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// GetTitlesByBundleIDWithSource behaves like GetTitlesWithSource but selects titles by the
+// Application Bundle ID of their patch definition instead of by name. The definitions API can only
+// filter by name, so the full catalog is read with GetTitlesWithSource and the decoded titles are
+// filtered in memory. Bundle IDs are matched case-insensitively, and every title sharing a requested
+// bundle ID is returned.
+//
+// A BundleIDsNotFoundError is returned when no title has one of the requested bundle IDs. Malformed
+// catalog entries are only reported, with a MalformedTitlesError, when a requested bundle ID was not
+// found, since their bundle ID cannot be read.
+func (c *Client) GetTitlesByBundleIDWithSource(ctx context.Context, bundleIDs ...string) ([]Title, Source, error) {
+	titles, source, err := c.GetTitlesWithSource(ctx)
+	malformedErr, partial := errors.AsType[*MalformedTitlesError](err)
+	if err != nil && !partial {
+		return nil, Source{}, err
+	}
+
+	wanted := make(map[string]bool, len(bundleIDs))
+	for _, id := range bundleIDs {
+		wanted[strings.ToLower(id)] = false
+	}
+
+	var matched []Title
+	for _, title := range titles {
+		id := title.PatchDefinition.BundleID()
+		if id == nil {
+			continue
+		}
+		key := strings.ToLower(*id)
+		if _, ok := wanted[key]; ok {
+			wanted[key] = true
+			matched = append(matched, title)
+		}
+	}
+
+	var missing []string
+	for _, id := range bundleIDs {
+		if !wanted[strings.ToLower(id)] {
+			missing = append(missing, id)
+		}
+	}
+	if len(missing) > 0 {
+		if partial {
+			return matched, source, malformedErr
+		}
+		return nil, Source{}, &BundleIDsNotFoundError{MissingBundleIDs: missing}
+	}
+
+	return matched, source, nil
+}
@@ -0,0 +1,87 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testBundleIDTitlesJSON = `[
+	{"title_name":"GoogleChrome","patch_definition":{"requirements":[{"name":"Application Bundle ID","value":"com.google.Chrome"}]}},
+	{"title_name":"Firefox","patch_definition":{"requirements":[{"name":"Application Bundle ID","value":"org.mozilla.firefox"}]}},
+	{"title_name":"FirefoxESR","patch_definition":{"requirements":[{"name":"Application Bundle ID","value":"org.mozilla.firefox"}]}},
+	{"title_name":"Zoom","patch_definition":{"requirements":[]}}
+]`
+
+func TestGetTitlesByBundleIDWithSource(t *testing.T) {
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		paths = append(paths, r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testBundleIDTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	titles, source, err := c.GetTitlesByBundleIDWithSource(context.Background(), "COM.GOOGLE.CHROME", "org.mozilla.firefox")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(titles) != 3 {
+		t.Fatalf("expected 3 titles, got %d", len(titles))
+	}
+	for i, want := range []string{"GoogleChrome", "Firefox", "FirefoxESR"} {
+		if *titles[i].TitleName != want {
+			t.Errorf("title %d: expected %s, got %s", i, want, *titles[i].TitleName)
+		}
+	}
+	if source.Type != SourceTypeURL {
+		t.Errorf("expected source type %s, got %s", SourceTypeURL, source.Type)
+	}
+	if len(paths) != 1 || paths[0] != "/" && paths[0] != "" {
+		t.Errorf("expected a single request for the full catalog, got %v", paths)
+	}
+}
+
+func TestGetTitlesByBundleIDWithSource_NotFound(t *testing.T) {
+	c := NewClient("", writeTempFile(t, testBundleIDTitlesJSON))
+
+	_, _, err := c.GetTitlesByBundleIDWithSource(context.Background(), "com.google.Chrome", "us.zoom.xos")
+	notFound, ok := errors.AsType[*BundleIDsNotFoundError](err)
+	if !ok {
+		t.Fatalf("expected BundleIDsNotFoundError, got %v", err)
+	}
+	if len(notFound.MissingBundleIDs) != 1 || notFound.MissingBundleIDs[0] != "us.zoom.xos" {
+		t.Errorf("expected us.zoom.xos to be missing, got %v", notFound.MissingBundleIDs)
+	}
+}
+
+func TestGetTitlesByBundleIDWithSource_Malformed(t *testing.T) {
+	catalog := `[
+		{"title_name":"GoogleChrome","patch_definition":{"requirements":[{"name":"Application Bundle ID","value":"com.google.Chrome"}]}},
+		{"title_name":"Broken","title_version":7}
+	]`
+	c := NewClient("", writeTempFile(t, catalog))
+
+	titles, _, err := c.GetTitlesByBundleIDWithSource(context.Background(), "com.google.Chrome")
+	if err != nil {
+		t.Fatalf("expected malformed entries to be ignored when every bundle ID is found, got %v", err)
+	}
+	if len(titles) != 1 {
+		t.Errorf("expected 1 title, got %d", len(titles))
+	}
+
+	titles, _, err = c.GetTitlesByBundleIDWithSource(context.Background(), "com.google.Chrome", "com.example.broken")
+	if _, ok := errors.AsType[*MalformedTitlesError](err); !ok {
+		t.Fatalf("expected MalformedTitlesError when a bundle ID is missing, got %v", err)
+	}
+	if len(titles) != 1 {
+		t.Errorf("expected the matched titles alongside the error, got %d", len(titles))
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// responseCache stores catalog responses on disk so reads within ttl reuse them instead of
+// downloading the catalog again. Each entry is a file holding the redacted URL that served the
+// response on its first line, followed by the response body.
+type responseCache struct {
+	dir string
+	ttl time.Duration
+}
+
+// SetCache enables the on-disk response cache in dir, creating it if needed. Titles read from a
+// definitions URL are served from a response cached less than ttl ago, keyed by the request URL,
+// every setting that changes the response and the refresh key of the read. Responses are cached
+// once they decoded successfully. Definitions files and inline catalogs are never cached.
+func (c *Client) SetCache(dir string, ttl time.Duration) error {
+	if ttl <= 0 {
+		return fmt.Errorf("cache TTL must be greater than zero, got %s", ttl)
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("error creating cache directory: %w", err)
+	}
+	c.cache = &responseCache{dir: dir, ttl: ttl}
+	return nil
+}
+
+// cacheKey returns the cache key of a request for path, covering every setting that changes the
+// response and the refresh key of ctx. Catalog objects in cloud storage are read whole whatever the
+// path, so they share one key.
+func (c *Client) cacheKey(ctx context.Context, path string) string {
+	if isObjectURL(c.baseURL) {
+		path = ""
+	}
+	sum := sha256.Sum256([]byte(strings.Join([]string{c.baseURL, path, c.locale, c.channel, c.catalogVersion, strconv.Itoa(c.apiVersion), refreshKey(ctx)}, "\n")))
+	return hex.EncodeToString(sum[:])
+}
+
+// path returns the file an entry is stored in.
+func (rc *responseCache) path(key string) string {
+	return filepath.Join(rc.dir, key+".json")
+}
+
+// open returns the body of a fresh entry along with the location that served it and when it was
+// cached. The boolean result is false when there is no fresh entry.
+func (rc *responseCache) open(key string) (io.ReadCloser, string, time.Time, bool) {
+	file, err := os.Open(rc.path(key))
+	if err != nil {
+		return nil, "", time.Time{}, false
+	}
+	info, err := file.Stat()
+	if err != nil || time.Since(info.ModTime()) >= rc.ttl {
+		_ = file.Close()
+		return nil, "", time.Time{}, false
+	}
+
+	reader := bufio.NewReader(file)
+	location, err := reader.ReadString('\n')
+	if err != nil {
+		_ = file.Close()
+		return nil, "", time.Time{}, false
+	}
+	return struct {
+		io.Reader
+		io.Closer
+	}{reader, file}, strings.TrimSuffix(location, "\n"), info.ModTime(), true
+}
+
+// remove deletes an entry, for example one that could not be decoded.
+func (rc *responseCache) remove(key string) {
+	_ = os.Remove(rc.path(key))
+}
+
+// cacheEntry is a response being written to the cache. Content is written to a temporary file
+// that only replaces the entry on commit, so readers never see a partial response.
+type cacheEntry struct {
+	file *os.File
+	path string
+	err  error
+}
+
+// create starts a new entry for a response served by location.
+func (rc *responseCache) create(key, location string) (*cacheEntry, error) {
+	file, err := os.CreateTemp(rc.dir, "."+key+"-*")
+	if err != nil {
+		return nil, fmt.Errorf("error creating cache entry: %w", err)
+	}
+	entry := &cacheEntry{file: file, path: rc.path(key)}
+	_, entry.err = io.WriteString(file, location+"\n")
+	return entry, nil
+}
+
+// Write appends p to the entry. Write errors are recorded and reported by commit rather than
+// returned, so a failing cache never fails the read it is attached to.
+func (e *cacheEntry) Write(p []byte) (int, error) {
+	if e.err == nil {
+		_, e.err = e.file.Write(p)
+	}
+	return len(p), nil
+}
+
+// commit moves the entry into place.
+func (e *cacheEntry) commit() error {
+	defer os.Remove(e.file.Name())
+	if err := e.file.Close(); err != nil && e.err == nil {
+		e.err = err
+	}
+	if e.err != nil {
+		return fmt.Errorf("error writing cache entry: %w", e.err)
+	}
+	if err := os.Rename(e.file.Name(), e.path); err != nil {
+		return fmt.Errorf("error moving cache entry into place: %w", err)
+	}
+	return nil
+}
+
+// discard abandons the entry.
+func (e *cacheEntry) discard() {
+	_ = e.file.Close()
+	_ = os.Remove(e.file.Name())
+}
+
+// storeCacheEntry commits entry once the response teed into it through body has been decoded
+// with decodeErr, reading the rest of body first so the entry holds the whole response. Entries of
+// responses that failed to decode are discarded. Cache failures are logged rather than returned.
+func (c *Client) storeCacheEntry(ctx context.Context, entry *cacheEntry, body io.Reader, decodeErr error) {
+	if !cacheableDecodeError(decodeErr) {
+		entry.discard()
+		return
+	}
+	if _, err := io.Copy(io.Discard, body); err != nil {
+		entry.discard()
+		return
+	}
+	if err := entry.commit(); err != nil && c.logger != nil {
+		c.logger.LogAuth(ctx, "Unable to cache response", map[string]any{"error": err.Error()})
+	}
+}
+
+// fetchTitlesFromCache decodes titles from a fresh cached response for path. The boolean result
+// is false when there is no usable entry, in which case the titles must be downloaded.
+func (c *Client) fetchTitlesFromCache(ctx context.Context, path string, titleNames []string) ([]Title, Source, bool, error) {
+	key := c.cacheKey(ctx, path)
+	body, location, cachedAt, ok := c.cache.open(key)
+	if !ok {
+		return nil, Source{}, false, nil
+	}
+	defer c.closeWithLog(ctx, body, "cache entry")
+
+	if c.logger != nil {
+		c.logger.LogAuth(ctx, "Reading titles from response cache", map[string]any{
+			"source":    location,
+			"cached_at": cachedAt.Format(time.RFC3339),
+		})
+	}
+
+	titles, err := c.decodeTitles(ctx, body, "cached response", titleNames)
+	if !cacheableDecodeError(err) {
+		c.cache.remove(key)
+		return nil, Source{}, false, nil
+	}
+	return titles, Source{Type: SourceTypeURL, Location: location, FetchedAt: cachedAt}, true, err
+}
+
+// cacheableDecodeError reports whether a response decoded with err is worth caching: it decoded
+// cleanly, skipped malformed titles or lacked some requested titles.
+func cacheableDecodeError(err error) bool {
+	_, partial := errors.AsType[*MalformedTitlesError](err)
+	_, notFound := errors.AsType[*TitlesNotFoundError](err)
+	return err == nil || partial || notFound
+}
@@ -0,0 +1,178 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newCountingServer(t *testing.T, body string) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestSetCache_ReusesFreshResponse(t *testing.T) {
+	server, requests := newCountingServer(t, testMultipleTitlesJSON)
+
+	dir := t.TempDir()
+	first := NewClient(server.URL, "")
+	if err := first.SetCache(dir, time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want, err := first.GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A second client shares the cache directory, as a later plan would.
+	second := NewClient(server.URL, "")
+	if err := second.SetCache(dir, time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, source, err := second.GetTitlesWithSource(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected one request, got %d", n)
+	}
+	if len(got) != len(want) {
+		t.Errorf("expected %d cached titles, got %d", len(want), len(got))
+	}
+	if source.Type != SourceTypeURL || source.Location != server.URL {
+		t.Errorf("unexpected source: %+v", source)
+	}
+}
+
+func TestSetCache_ExpiredEntry(t *testing.T) {
+	server, requests := newCountingServer(t, testMultipleTitlesJSON)
+
+	dir := t.TempDir()
+	c := NewClient(server.URL, "")
+	if err := c.SetCache(dir, time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	stale := time.Now().Add(-2 * time.Hour)
+	if err := os.Chtimes(c.cache.path(c.cacheKey(context.Background(), "")), stale, stale); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c = NewClient(server.URL, "")
+	if err := c.SetCache(dir, time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected the expired entry to be refetched, got %d requests", n)
+	}
+}
+
+func TestSetCache_SkipsUndecodableResponse(t *testing.T) {
+	server, requests := newCountingServer(t, "{not json")
+
+	dir := t.TempDir()
+	c := NewClient(server.URL, "")
+	if err := c.SetCache(dir, time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 2 {
+		if _, err := c.GetTitles(context.Background()); err == nil {
+			t.Fatal("expected a decode error")
+		}
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected both reads to reach the server, got %d requests", n)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected an empty cache directory, got %d entries", len(entries))
+	}
+}
+
+func TestSetCache_KeyCoversLocale(t *testing.T) {
+	server, requests := newCountingServer(t, testMultipleTitlesJSON)
+
+	dir := t.TempDir()
+	for _, locale := range []string{"en-US", "de-DE"} {
+		c := NewClient(server.URL, "")
+		if err := c.SetCache(dir, time.Hour); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := c.SetLocale(locale); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := c.GetTitles(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected one request per locale, got %d", n)
+	}
+}
+
+func TestSetCache_Invalid(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	if err := c.SetCache(t.TempDir(), 0); err == nil {
+		t.Error("expected an error for a zero TTL")
+	}
+
+	file := filepath.Join(t.TempDir(), "file")
+	if err := os.WriteFile(file, nil, 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.SetCache(filepath.Join(file, "cache"), time.Hour); err == nil {
+		t.Error("expected an error for a directory that cannot be created")
+	}
+}
+
+func TestCacheKey_CoversRequestSettings(t *testing.T) {
+	ctx := context.Background()
+	c := NewClient("https://example.com", "")
+	base := c.cacheKey(ctx, "/GoogleChrome")
+
+	if err := c.SetCatalogVersion("2026.10"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	versioned := c.cacheKey(ctx, "/GoogleChrome")
+	if versioned == base {
+		t.Error("expected catalog_version to change the cache key")
+	}
+
+	if err := c.SetAPIVersion(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.cacheKey(ctx, "/GoogleChrome") == versioned {
+		t.Error("expected api_version to change the cache key")
+	}
+
+	if c.cacheKey(WithRefreshKey(ctx, "refresh"), "/GoogleChrome") == c.cacheKey(ctx, "/GoogleChrome") {
+		t.Error("expected the refresh key to change the cache key")
+	}
+}
@@ -0,0 +1,284 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+)
+
+// decodeTitles streams a JSON array of titles from r, decoding one title at a time. The array may
+// also be wrapped in an object under a "titles" key, as newer catalog exports are, in which case
+// titles are decoded according to the object's "schema_version"; an UnsupportedSchemaVersionError
+// is returned for versions the client cannot parse. If titleNames is not empty, only the named
+// titles are returned and a TitlesNotFoundError is returned if any are missing. The whole array is
+// read even then, so every entry of a duplicated title is returned. Entries with fields of an
+// unexpected type are skipped and reported in a MalformedTitlesError returned with the remaining
+// titles. With strict decoding, an UnknownFieldsError is returned instead when the titles to be
+// returned carry fields the provider does not read. The source describes r in errors and log entries. Decoding stops as soon as ctx is done.
+func (c *Client) decodeTitles(ctx context.Context, r io.Reader, source string, titleNames []string) ([]Title, error) {
+	decoder := json.NewDecoder(&contextReader{ctx: ctx, r: r})
+
+	wanted := make(map[string]struct{}, len(titleNames))
+	for _, name := range titleNames {
+		wanted[name] = struct{}{}
+	}
+
+	header, err := openTitlesArray(decoder, source)
+	if err != nil {
+		return nil, err
+	}
+	decodeTitle, err := titleDecoderFor(header.schemaVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	progress := newProgressTracker()
+	var titles []Title
+	var malformed []MalformedTitle
+	var unknown map[string][]string
+	decoded := 0
+	for decoder.More() {
+		if err := ctx.Err(); err != nil {
+			return nil, fmt.Errorf("reading %s interrupted: %w", source, err)
+		}
+
+		var title Title
+		var titleUnknown []string
+		var err error
+		if c.strictDecoding {
+			titleUnknown, err = decodeTitleStrict(decoder, decodeTitle, &title)
+		} else {
+			err = decodeTitle(decoder, &title)
+		}
+		index := decoded
+		decoded++
+		if err != nil {
+			// The decoder consumes the whole entry before reporting a type error, so decoding can
+			// continue with the next title. Any other error leaves the stream unusable.
+			typeErr, ok := errors.AsType[*json.UnmarshalTypeError](err)
+			if !ok {
+				return nil, fmt.Errorf("error decoding %s: %w", source, err)
+			}
+			var name string
+			if title.TitleName != nil {
+				name = *title.TitleName
+			}
+			if _, ok := wanted[name]; len(titleNames) > 0 && !ok {
+				continue
+			}
+			malformed = append(malformed, MalformedTitle{TitleName: name, Index: index, Err: typeErr})
+			continue
+		}
+
+		if c.logger != nil && progress.due() {
+			c.logger.LogAuth(ctx, "Decoding titles", map[string]any{
+				"source":         source,
+				"titles_decoded": decoded,
+			})
+		}
+
+		if len(titleNames) > 0 {
+			if title.TitleName == nil {
+				continue
+			}
+			if _, ok := wanted[*title.TitleName]; !ok {
+				continue
+			}
+		}
+
+		titles = append(titles, title)
+		for _, path := range titleUnknown {
+			if unknown == nil {
+				unknown = make(map[string][]string)
+			}
+			name := "unnamed title"
+			if title.TitleName != nil {
+				name = *title.TitleName
+			}
+			unknown[path] = append(unknown[path], name)
+		}
+	}
+
+	// Keys that follow the titles array can only be checked once the titles have been decoded.
+	if header.wrapped {
+		if err := readCatalogTrailer(decoder, source, &header); err != nil {
+			return nil, err
+		}
+	}
+	if err := c.checkCatalogVersion(source, header.catalogVersion); err != nil {
+		return nil, err
+	}
+	if err := c.checkChannel(source, header.channel); err != nil {
+		return nil, err
+	}
+
+	if len(titleNames) > 0 {
+		missing := slices.DeleteFunc(titlesMissing(titles, titleNames), func(name string) bool {
+			return slices.ContainsFunc(malformed, func(m MalformedTitle) bool { return m.TitleName == name })
+		})
+		if len(missing) > 0 {
+			return nil, &TitlesNotFoundError{MissingTitles: missing}
+		}
+	}
+
+	if len(unknown) > 0 {
+		return nil, &UnknownFieldsError{Fields: unknown}
+	}
+
+	if len(malformed) > 0 {
+		return titles, &MalformedTitlesError{Titles: malformed}
+	}
+
+	return titles, nil
+}
+
+// catalogHeader describes the part of a catalog document preceding its first title.
+type catalogHeader struct {
+	// wrapped is true when the titles array is held in an object rather than being the document.
+	wrapped bool
+	// schemaVersion is the declared schema version, or DefaultSchemaVersion if none was declared.
+	schemaVersion int
+	// versionDeclared is true when schema_version preceded the titles array.
+	versionDeclared bool
+	// catalogVersion is the declared catalog_version, or empty if none was declared.
+	catalogVersion string
+	// channel is the declared channel, or empty if none was declared.
+	channel string
+}
+
+// openTitlesArray advances decoder to the first title of a catalog. The catalog is either a bare
+// JSON array of titles or an object holding the array under a "titles" key; "schema_version",
+// "catalog_version" and "channel" keys preceding the array are recorded in the returned header and
+// other keys are skipped.
+func openTitlesArray(decoder *json.Decoder, source string) (catalogHeader, error) {
+	header := catalogHeader{schemaVersion: DefaultSchemaVersion}
+	notCatalog := fmt.Errorf("%s must contain a JSON array of titles or an object with a \"titles\" array", source)
+
+	token, err := decoder.Token()
+	if err != nil {
+		return header, fmt.Errorf("error reading %s: %w", source, err)
+	}
+	delim, ok := token.(json.Delim)
+	if !ok || (delim != '[' && delim != '{') {
+		return header, notCatalog
+	}
+	if delim == '[' {
+		return header, nil
+	}
+	header.wrapped = true
+
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return header, fmt.Errorf("error reading %s: %w", source, err)
+		}
+
+		switch key, _ := keyToken.(string); key {
+		case "titles":
+			token, err := decoder.Token()
+			if err != nil {
+				return header, fmt.Errorf("error reading %s: %w", source, err)
+			}
+			if delim, ok := token.(json.Delim); !ok || delim != '[' {
+				return header, fmt.Errorf("%s \"titles\" must be a JSON array", source)
+			}
+			return header, nil
+		case "schema_version":
+			if err := decoder.Decode(&header.schemaVersion); err != nil {
+				return header, fmt.Errorf("error decoding %s schema_version: %w", source, err)
+			}
+			header.versionDeclared = true
+		case "catalog_version":
+			if err := decoder.Decode(&header.catalogVersion); err != nil {
+				return header, fmt.Errorf("error decoding %s catalog_version: %w", source, err)
+			}
+		case "channel":
+			if err := decoder.Decode(&header.channel); err != nil {
+				return header, fmt.Errorf("error decoding %s channel: %w", source, err)
+			}
+		default:
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return header, fmt.Errorf("error reading %s: %w", source, err)
+			}
+		}
+	}
+
+	return header, notCatalog
+}
+
+// readCatalogTrailer reads the keys following the titles array of a wrapped catalog into header.
+// A "schema_version" among them fails the read if it differs from the version the titles were
+// decoded with, unless schema_version already preceded the array.
+func readCatalogTrailer(decoder *json.Decoder, source string, header *catalogHeader) error {
+	// Consume the closing bracket of the titles array.
+	if _, err := decoder.Token(); err != nil {
+		return fmt.Errorf("error reading %s: %w", source, err)
+	}
+
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return fmt.Errorf("error reading %s: %w", source, err)
+		}
+
+		switch key, _ := keyToken.(string); {
+		case key == "schema_version" && !header.versionDeclared:
+			var version int
+			if err := decoder.Decode(&version); err != nil {
+				return fmt.Errorf("error decoding %s schema_version: %w", source, err)
+			}
+			if err := checkTrailingSchemaVersion(source, version, header.schemaVersion); err != nil {
+				return err
+			}
+		case key == "catalog_version":
+			if err := decoder.Decode(&header.catalogVersion); err != nil {
+				return fmt.Errorf("error decoding %s catalog_version: %w", source, err)
+			}
+		case key == "channel":
+			if err := decoder.Decode(&header.channel); err != nil {
+				return fmt.Errorf("error decoding %s channel: %w", source, err)
+			}
+		default:
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return fmt.Errorf("error reading %s: %w", source, err)
+			}
+		}
+	}
+	return nil
+}
+
+// checkTrailingSchemaVersion fails if version, declared after the titles array of a catalog,
+// differs from decodedVersion, the version the titles were decoded with.
+func checkTrailingSchemaVersion(source string, version, decodedVersion int) error {
+	if version == decodedVersion {
+		return nil
+	}
+	if _, err := titleDecoderFor(version); err != nil {
+		return err
+	}
+	return fmt.Errorf("%s declares schema_version %d after its titles; schema_version must precede \"titles\" for catalogs that are not version %d",
+		source, version, decodedVersion)
+}
+
+// contextReader is an io.Reader that fails with the context error once ctx is done, interrupting
+// reads of large documents part way through a single JSON value.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+// Read reads from the underlying reader unless the context is done.
+func (cr *contextReader) Read(p []byte) (int, error) {
+	if err := cr.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return cr.r.Read(p)
+}
@@ -0,0 +1,194 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDecodeTitles_All(t *testing.T) {
+	c := NewClient("", "")
+	titles, err := c.decodeTitles(context.Background(), strings.NewReader(testMultipleTitlesJSON), "test", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 2 {
+		t.Errorf("expected 2 titles, got %d", len(titles))
+	}
+}
+
+func TestDecodeTitles_Filtered(t *testing.T) {
+	c := NewClient("", "")
+	titles, err := c.decodeTitles(context.Background(), strings.NewReader(testMultipleTitlesJSON), "test", []string{"Firefox"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 || *titles[0].TitleName != "Firefox" {
+		t.Errorf("expected only Firefox, got %v", titles)
+	}
+}
+
+func TestDecodeTitles_MissingPreservesRequestOrder(t *testing.T) {
+	c := NewClient("", "")
+	_, err := c.decodeTitles(context.Background(), strings.NewReader(testMultipleTitlesJSON), "test", []string{"Zoom", "Firefox", "Atom"})
+	notFoundErr, ok := err.(*TitlesNotFoundError)
+	if !ok {
+		t.Fatalf("expected TitlesNotFoundError, got %T: %v", err, err)
+	}
+	if strings.Join(notFoundErr.MissingTitles, ",") != "Zoom,Atom" {
+		t.Errorf("expected [Zoom Atom], got %v", notFoundErr.MissingTitles)
+	}
+}
+
+func TestDecodeTitles_ErrorNamesSource(t *testing.T) {
+	c := NewClient("", "")
+	_, err := c.decodeTitles(context.Background(), strings.NewReader(`{}`), "definitions file", nil)
+	if err == nil || !strings.Contains(err.Error(), "definitions file must contain a JSON array") {
+		t.Errorf("expected error naming the source, got %v", err)
+	}
+}
+
+func TestDecodeTitles_WrappedCatalog(t *testing.T) {
+	c := NewClient("", "")
+	for name, catalog := range map[string]string{
+		"version first": `{"schema_version": 2, "titles": ` + testMultipleTitlesJSON + `}`,
+		"version last":  `{"titles": ` + testMultipleTitlesJSON + `, "schema_version": 2}`,
+		"extra keys":    `{"generated": {"by": "export"}, "titles": ` + testMultipleTitlesJSON + `}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			titles, err := c.decodeTitles(context.Background(), strings.NewReader(catalog), "test", []string{"Firefox"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(titles) != 1 || *titles[0].TitleName != "Firefox" {
+				t.Errorf("expected only Firefox, got %v", titles)
+			}
+		})
+	}
+}
+
+func TestDecodeTitles_WrappedCatalogInvalid(t *testing.T) {
+	c := NewClient("", "")
+	for name, catalog := range map[string]string{
+		"no titles":           `{"schema_version": 2}`,
+		"titles not array":    `{"titles": {"title_name": "Firefox"}}`,
+		"not array or object": `"titles"`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := c.decodeTitles(context.Background(), strings.NewReader(catalog), "test", nil); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestDecodeTitles_UnsupportedSchemaVersion(t *testing.T) {
+	c := NewClient("", "")
+	for name, catalog := range map[string]string{
+		"version first": `{"schema_version": 3, "titles": ` + testMultipleTitlesJSON + `}`,
+		"version last":  `{"titles": ` + testMultipleTitlesJSON + `, "schema_version": 3}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := c.decodeTitles(context.Background(), strings.NewReader(catalog), "test", nil)
+			versionErr, ok := errors.AsType[*UnsupportedSchemaVersionError](err)
+			if !ok {
+				t.Fatalf("expected UnsupportedSchemaVersionError, got %v", err)
+			}
+			if versionErr.Version != 3 || !slices.Equal(versionErr.Supported, []int{2}) {
+				t.Errorf("unexpected error %+v", versionErr)
+			}
+		})
+	}
+}
+
+func TestDecodeTitles_InvalidSchemaVersion(t *testing.T) {
+	c := NewClient("", "")
+	catalog := `{"schema_version": "two", "titles": []}`
+	if _, err := c.decodeTitles(context.Background(), strings.NewReader(catalog), "test", nil); err == nil {
+		t.Fatal("expected error for a non-numeric schema_version")
+	}
+}
+
+const testMalformedTitlesJSON = `[{"title_name":"GoogleChrome","title_version":1,"patch_definition":{"requirements":[]}},{"title_name":"Firefox","title_version":"2.0","patch_definition":{"requirements":[]}},{"title_name":7}]`
+
+func TestDecodeTitles_MalformedTitlesSkipped(t *testing.T) {
+	c := NewClient("", "")
+	titles, err := c.decodeTitles(context.Background(), strings.NewReader(testMalformedTitlesJSON), "test", nil)
+	malformedErr, ok := err.(*MalformedTitlesError)
+	if !ok {
+		t.Fatalf("expected MalformedTitlesError, got %T: %v", err, err)
+	}
+	if len(titles) != 1 || *titles[0].TitleName != "Firefox" {
+		t.Errorf("expected only Firefox, got %v", titles)
+	}
+	if len(malformedErr.Titles) != 2 {
+		t.Fatalf("expected 2 malformed titles, got %d", len(malformedErr.Titles))
+	}
+	if got := malformedErr.Titles[0]; got.TitleName != "GoogleChrome" || got.Index != 0 {
+		t.Errorf("expected GoogleChrome at index 0, got %+v", got)
+	}
+	if got := malformedErr.Titles[1]; got.TitleName != "" || got.Index != 2 {
+		t.Errorf("expected unnamed entry at index 2, got %+v", got)
+	}
+	if !strings.Contains(err.Error(), "entry 2") {
+		t.Errorf("expected unnamed entry to be described by index, got %v", err)
+	}
+}
+
+func TestDecodeTitles_MalformedRequestedTitleNotMissing(t *testing.T) {
+	c := NewClient("", "")
+	titles, err := c.decodeTitles(context.Background(), strings.NewReader(testMalformedTitlesJSON), "test", []string{"GoogleChrome", "Firefox"})
+	malformedErr, ok := err.(*MalformedTitlesError)
+	if !ok {
+		t.Fatalf("expected MalformedTitlesError, got %T: %v", err, err)
+	}
+	if len(titles) != 1 || len(malformedErr.Titles) != 1 {
+		t.Errorf("expected 1 title and 1 malformed title, got %d and %d", len(titles), len(malformedErr.Titles))
+	}
+}
+
+func TestDecodeTitles_SyntaxErrorFails(t *testing.T) {
+	c := NewClient("", "")
+	_, err := c.decodeTitles(context.Background(), strings.NewReader(`[{"title_name":}]`), "test", nil)
+	if err == nil {
+		t.Fatal("expected error for invalid JSON")
+	}
+	if _, ok := err.(*MalformedTitlesError); ok {
+		t.Error("expected syntax errors not to be reported as malformed titles")
+	}
+}
+
+func TestDecodeTitles_PatchDefinition(t *testing.T) {
+	c := NewClient("", "")
+	catalog := `[{"title_name":"GoogleChrome","patch_definition":{
+		"requirements":[{"name":"Application Bundle ID","operator":"is","value":"com.google.Chrome","type":"recon","and":true}],
+		"capabilities":[{"name":"Operating System Version","operator":"greater than or equal","value":"12.0","type":"recon"}],
+		"components":[{"name":"Google Chrome","version":"1.0","criteria":[{"name":"Application Version","operator":"is","value":"1.0","type":"recon"}]}],
+		"killApps":[{"bundleId":"com.google.Chrome","appName":"Google Chrome.app"}]}}]`
+	titles, err := c.decodeTitles(context.Background(), strings.NewReader(catalog), "test", nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	definition := titles[0].PatchDefinition
+	if got := definition.Requirements[0]; *got.Operator != "is" || *got.Type != "recon" || !*got.And {
+		t.Errorf("unexpected requirement: %+v", got)
+	}
+	if len(definition.Capabilities) != 1 || *definition.Capabilities[0].Value != "12.0" {
+		t.Errorf("unexpected capabilities: %+v", definition.Capabilities)
+	}
+	if len(definition.Components) != 1 || *definition.Components[0].Version != "1.0" || len(definition.Components[0].Criteria) != 1 {
+		t.Errorf("unexpected components: %+v", definition.Components)
+	}
+	if len(definition.KillApps) != 1 || *definition.KillApps[0].BundleID != "com.google.Chrome" || *definition.KillApps[0].AppName != "Google Chrome.app" {
+		t.Errorf("unexpected kill apps: %+v", definition.KillApps)
+	}
+	if got := definition.BundleID(); got == nil || *got != "com.google.Chrome" {
+		t.Errorf("expected bundle ID com.google.Chrome, got %v", got)
+	}
+}
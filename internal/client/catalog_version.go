@@ -0,0 +1,64 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+// catalogVersionQueryParameter is the query parameter that selects a catalog snapshot on the
+// definitions server.
+const catalogVersionQueryParameter = "catalog_version"
+
+// SetCatalogVersion pins reads to the catalog snapshot identified by version, so every read sees
+// the same catalog state however often the catalog is updated. An unset version reads the latest
+// catalog. Every catalog read must declare the pinned version in its "catalog_version" key, so a
+// server that ignores the query parameter, or a catalog object in cloud storage holding another
+// snapshot, fails the read with a CatalogVersionMismatchError instead of serving the latest titles.
+func (c *Client) SetCatalogVersion(version string) error {
+	if version == "" || strings.ContainsFunc(version, unicode.IsSpace) {
+		return errors.New("catalog version must be a non-empty value without whitespace")
+	}
+	c.catalogVersion = version
+	return nil
+}
+
+// setCatalogVersionQuery adds the query parameter selecting the pinned catalog snapshot to req.
+func (c *Client) setCatalogVersionQuery(req *http.Request) {
+	if c.catalogVersion == "" {
+		return
+	}
+	query := req.URL.Query()
+	query.Set(catalogVersionQueryParameter, c.catalogVersion)
+	req.URL.RawQuery = query.Encode()
+}
+
+// checkCatalogVersion fails if the client is pinned to a catalog version and declared, the
+// catalog_version declared by the catalog read from source, is not that version.
+func (c *Client) checkCatalogVersion(source, declared string) error {
+	if c.catalogVersion == "" || declared == c.catalogVersion {
+		return nil
+	}
+	return &CatalogVersionMismatchError{Source: source, Requested: c.catalogVersion, Declared: declared}
+}
+
+// CatalogVersionMismatchError is returned when a catalog read by a client pinned to a catalog
+// version declares another version, or none at all.
+type CatalogVersionMismatchError struct {
+	Source    string
+	Requested string
+	Declared  string
+}
+
+// Error returns a formatted string naming the requested and declared catalog versions.
+func (e *CatalogVersionMismatchError) Error() string {
+	if e.Declared == "" {
+		return fmt.Sprintf("catalog_version %q was requested but the %s does not declare a catalog_version; the definitions server may not support catalog_version", e.Requested, e.Source)
+	}
+	return fmt.Sprintf("catalog_version %q was requested but the %s declares catalog_version %q", e.Requested, e.Source, e.Declared)
+}
@@ -0,0 +1,121 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newSnapshotServer returns a server that serves the catalog snapshot requested by the
+// catalog_version query parameter, declaring the version before its titles.
+func newSnapshotServer(t *testing.T) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"catalog_version": %q, "titles": %s}`, r.URL.Query().Get("catalog_version"), testMultipleTitlesJSON)
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+func TestSetCatalogVersion_Query(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.RawQuery
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"channel": "beta", "titles": %s, "catalog_version": "2026-10-01"}`, testMultipleTitlesJSON)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	if err := c.SetChannel(ChannelBeta); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := c.SetCatalogVersion("2026-10-01"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := c.GetTitles(context.Background(), "GoogleChrome"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "catalog_version=2026-10-01&channel=beta"; query != want {
+		t.Errorf("expected query %q, got %q", want, query)
+	}
+}
+
+func TestSetCatalogVersion_CacheKey(t *testing.T) {
+	server, requests := newSnapshotServer(t)
+
+	dir := t.TempDir()
+	for _, version := range []string{"2026-10-01", "2026-10-08"} {
+		c := NewClient(server.URL, "")
+		if err := c.SetCache(dir, time.Hour); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if err := c.SetCatalogVersion(version); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if _, err := c.GetTitles(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected one request per catalog version, got %d", n)
+	}
+}
+
+func TestSetCatalogVersion_Mismatch(t *testing.T) {
+	for name, body := range map[string]string{
+		"undeclared": testMultipleTitlesJSON,
+		"other":      fmt.Sprintf(`{"catalog_version": "2026-10-08", "titles": %s}`, testMultipleTitlesJSON),
+	} {
+		t.Run(name, func(t *testing.T) {
+			server, _ := newCountingServer(t, body)
+			c := NewClient(server.URL, "")
+			if err := c.SetCatalogVersion("2026-10-01"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, err := c.GetTitles(context.Background())
+			mismatch, ok := errors.AsType[*CatalogVersionMismatchError](err)
+			if !ok {
+				t.Fatalf("expected CatalogVersionMismatchError, got %v", err)
+			}
+			if mismatch.Requested != "2026-10-01" {
+				t.Errorf("expected requested version 2026-10-01, got %q", mismatch.Requested)
+			}
+		})
+	}
+}
+
+func TestSetCatalogVersion_FetchCatalog(t *testing.T) {
+	server, _ := newCountingServer(t, testMultipleTitlesJSON)
+	c := NewClient(server.URL, "")
+	if err := c.SetCatalogVersion("2026-10-01"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, _, err := c.FetchCatalog(context.Background()); err == nil {
+		t.Fatal("expected the unpinned catalog to be rejected")
+	}
+}
+
+func TestSetCatalogVersion_Invalid(t *testing.T) {
+	c := NewClient("", "")
+	for _, version := range []string{"", "2026 10 01", "v1\n"} {
+		if err := c.SetCatalogVersion(version); err == nil {
+			t.Errorf("expected error for catalog version %q", version)
+		}
+	}
+}
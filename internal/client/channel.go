@@ -0,0 +1,73 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+)
+
+// Catalog channels accepted by SetChannel.
+const (
+	// ChannelStable is the production catalog feed.
+	ChannelStable = "stable"
+	// ChannelBeta is the feed of pre-release definitions, for pilot workspaces.
+	ChannelBeta = "beta"
+)
+
+// Channels lists the supported catalog channels.
+var Channels = []string{ChannelStable, ChannelBeta}
+
+// channelQueryParameter is the query parameter that selects the catalog feed on the definitions server.
+const channelQueryParameter = "channel"
+
+// SetChannel sets the catalog feed titles are read from, one of Channels. Requests to the stable
+// channel are sent unchanged, so servers without channel support keep working. Catalogs read from
+// the beta channel must declare it in their "channel" key, and catalogs read from the stable
+// channel must not declare another, so a server that ignores the query parameter fails the read
+// with a ChannelMismatchError instead of serving titles from the wrong feed.
+func (c *Client) SetChannel(channel string) error {
+	if !slices.Contains(Channels, channel) {
+		return fmt.Errorf("channel must be one of %s, got: %q", strings.Join(Channels, ", "), channel)
+	}
+	c.channel = channel
+	return nil
+}
+
+// setChannelQuery adds the query parameter selecting the configured channel to req.
+func (c *Client) setChannelQuery(req *http.Request) {
+	if c.channel == "" || c.channel == ChannelStable {
+		return
+	}
+	query := req.URL.Query()
+	query.Set(channelQueryParameter, c.channel)
+	req.URL.RawQuery = query.Encode()
+}
+
+// checkChannel fails if the client has a channel set and declared, the channel declared by the
+// catalog read from source, is another one. Catalogs that declare no channel are taken to be stable.
+func (c *Client) checkChannel(source, declared string) error {
+	if c.channel == "" || declared == c.channel || (declared == "" && c.channel == ChannelStable) {
+		return nil
+	}
+	return &ChannelMismatchError{Source: source, Requested: c.channel, Declared: declared}
+}
+
+// ChannelMismatchError is returned when a catalog read by a client with a channel set declares
+// another channel, or declares none when a channel other than stable was requested.
+type ChannelMismatchError struct {
+	Source    string
+	Requested string
+	Declared  string
+}
+
+// Error returns a formatted string naming the requested and declared channels.
+func (e *ChannelMismatchError) Error() string {
+	if e.Declared == "" {
+		return fmt.Sprintf("channel %q was requested but the %s does not declare a channel; the definitions server may not support channels", e.Requested, e.Source)
+	}
+	return fmt.Sprintf("channel %q was requested but the %s declares channel %q", e.Requested, e.Source, e.Declared)
+}
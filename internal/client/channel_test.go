@@ -0,0 +1,93 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSetChannel_Query(t *testing.T) {
+	tests := []struct {
+		channel string
+		want    string
+	}{
+		{"", ""},
+		{ChannelStable, ""},
+		{ChannelBeta, "channel=beta"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.channel, func(t *testing.T) {
+			var query string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				query = r.URL.RawQuery
+				w.Header().Set("Content-Type", "application/json")
+				if channel := r.URL.Query().Get("channel"); channel != "" {
+					_, _ = fmt.Fprintf(w, `{"channel": %q, "titles": %s}`, channel, testMultipleTitlesJSON)
+					return
+				}
+				_, _ = w.Write([]byte(testMultipleTitlesJSON))
+			}))
+			defer server.Close()
+
+			c := NewClient(server.URL, "")
+			if tt.channel != "" {
+				if err := c.SetChannel(tt.channel); err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			if _, err := c.GetTitles(context.Background(), "GoogleChrome"); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if query != tt.want {
+				t.Errorf("expected query %q, got %q", tt.want, query)
+			}
+		})
+	}
+}
+
+func TestSetChannel_Mismatch(t *testing.T) {
+	tests := map[string]struct {
+		channel string
+		body    string
+	}{
+		"beta undeclared": {ChannelBeta, testMultipleTitlesJSON},
+		"beta as stable":  {ChannelBeta, fmt.Sprintf(`{"channel": "stable", "titles": %s}`, testMultipleTitlesJSON)},
+		"stable as beta":  {ChannelStable, fmt.Sprintf(`{"titles": %s, "channel": "beta"}`, testMultipleTitlesJSON)},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, _ := newCountingServer(t, tt.body)
+			c := NewClient(server.URL, "")
+			if err := c.SetChannel(tt.channel); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			_, err := c.GetTitles(context.Background())
+			mismatch, ok := errors.AsType[*ChannelMismatchError](err)
+			if !ok {
+				t.Fatalf("expected ChannelMismatchError, got %v", err)
+			}
+			if mismatch.Requested != tt.channel {
+				t.Errorf("expected requested channel %q, got %q", tt.channel, mismatch.Requested)
+			}
+		})
+	}
+}
+
+func TestSetChannel_Invalid(t *testing.T) {
+	c := NewClient("", "")
+	for _, channel := range []string{"", "preview", "Beta"} {
+		if err := c.SetChannel(channel); err == nil {
+			t.Errorf("expected error for channel %q", channel)
+		}
+	}
+}
@@ -6,12 +6,19 @@ package client
 import (
 	"bytes"
 	"context"
-	"encoding/json"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
+	"math"
+	"net"
 	"net/http"
+	"slices"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
 )
 
 // defaultHTTPTimeout is the maximum duration for HTTP requests made by the client.
@@ -19,20 +26,69 @@ const defaultHTTPTimeout = 30 * time.Second
 
 // Client is a Jamf Auto Update API client.
 type Client struct {
-	baseURL         string
-	definitionsFile string
-	httpClient      *http.Client
-	logger          Logger
+	baseURL          string
+	definitionsFile  string
+	httpClient       *http.Client
+	transport        *http.Transport
+	tokenTransport   http.RoundTripper
+	dialer           *net.Dialer
+	logger           Logger
+	maxRetries       int
+	retryMinWait     time.Duration
+	retryMaxWait     time.Duration
+	maxResponseSize  int64
+	auditLogPath     string
+	auditMu          sync.Mutex
+	mirrors          []string
+	mirrorOrder      []string
+	mirrorMu         sync.Mutex
+	unixSockets      map[string]string
+	duplicatePolicy  string
+	locale           string
+	channel          string
+	authToken        string
+	basicUsername    string
+	basicPassword    string
+	oauth            *tokenSource
+	fallbackFile     string
+	definitionsJSON  string
+	userAgent        string
+	cache            *responseCache
+	shared           sharedTitles
+	requestSlots     chan struct{}
+	catalogVersion   string
+	offline          bool
+	apiVersion       int
+	strictDecoding   bool
+	uninstallOverlay *imaging.Overlay
+	awsCredentials   *awsCredentialCache
+	googleTokens     *cloudTokenCache
+	azureTokens      *cloudTokenCache
 }
 
 // NewClient creates a new Jamf Auto Update API client.
 // If definitionsFile is not empty, it will read from the file instead of making HTTP requests.
+// A baseURL of the form unix:///path/to/socket sends requests to a server listening on that Unix socket.
 func NewClient(baseURL string, definitionsFile string) *Client {
-	return &Client{
-		baseURL:         baseURL,
+	c := &Client{
 		definitionsFile: definitionsFile,
-		httpClient:      &http.Client{Timeout: defaultHTTPTimeout},
+		dialer:          &net.Dialer{Timeout: defaultConnectTimeout, KeepAlive: defaultKeepAlive},
+		maxRetries:      defaultMaxRetries,
+		retryMinWait:    defaultRetryMinWait,
+		retryMaxWait:    defaultRetryMaxWait,
+		maxResponseSize: defaultMaxResponseSize,
+		duplicatePolicy: defaultDuplicateTitlesPolicy,
+		userAgent:       defaultUserAgent,
 	}
+	c.transport = newTransport(c.dialContext)
+	c.httpClient = &http.Client{Timeout: defaultHTTPTimeout, Transport: c.transport}
+	c.tokenTransport = c.transport
+	c.awsCredentials = newAWSCredentialCache(c.tokenTransport)
+	c.googleTokens = newCloudTokenCache(c.tokenTransport, resolveGoogleToken)
+	c.azureTokens = newCloudTokenCache(c.tokenTransport, resolveAzureToken)
+	c.baseURL = c.resolveBaseURL(baseURL)
+
+	return c
 }
 
 // SetLogger sets the logger for the client.
@@ -40,87 +96,286 @@ func (c *Client) SetLogger(logger Logger) {
 	c.logger = logger
 }
 
+// SetInsecureSkipVerify disables verification of the definitions server's TLS certificate when skip is true.
+// This is intended only for lab environments using self-signed mirrors.
+func (c *Client) SetInsecureSkipVerify(skip bool) {
+	if c.transport.TLSClientConfig == nil {
+		c.transport.TLSClientConfig = &tls.Config{}
+	}
+	c.transport.TLSClientConfig.InsecureSkipVerify = skip //nolint:gosec // explicitly requested by the provider configuration
+}
+
+// SetDisableKeepAlives disables HTTP keep-alives, so every request uses a new connection, when disable is true.
+func (c *Client) SetDisableKeepAlives(disable bool) {
+	c.transport.DisableKeepAlives = disable
+}
+
+// SetMaxIdleConns sets the maximum number of idle connections kept open to the definitions server.
+// Zero means no limit.
+func (c *Client) SetMaxIdleConns(n int) {
+	c.transport.MaxIdleConns = n
+	c.transport.MaxIdleConnsPerHost = n
+	if n == 0 {
+		// A zero MaxIdleConnsPerHost falls back to http.DefaultMaxIdleConnsPerHost rather than no limit.
+		c.transport.MaxIdleConnsPerHost = math.MaxInt
+	}
+}
+
+// SetHTTP2Enabled controls whether the client negotiates HTTP/2 with the definitions server.
+// Disabling HTTP/2 can help with middleboxes that mishandle it.
+func (c *Client) SetHTTP2Enabled(enabled bool) {
+	protocols := new(http.Protocols)
+	protocols.SetHTTP1(true)
+	protocols.SetHTTP2(enabled)
+	c.transport.Protocols = protocols
+
+	// The cloned default transport may already advertise h2 via ALPN.
+	if c.transport.TLSClientConfig != nil && !enabled {
+		c.transport.TLSClientConfig.NextProtos = slices.DeleteFunc(slices.Clone(c.transport.TLSClientConfig.NextProtos), func(p string) bool {
+			return p == "h2"
+		})
+	}
+}
+
 // GetTitles retrieves titles from the API or file. If titleNames is empty, it returns all titles.
-// If titleNames contains one or more names, it returns data for those specific titles.
+// If titleNames contains one or more names, it returns data for those specific titles. When some
+// catalog entries are malformed, the remaining titles are returned with a MalformedTitlesError.
+// Concurrent reads of the same titles share one request, and successful reads are reused for the
+// lifetime of the client unless ctx carries a different refresh key; see WithRefreshKey.
 func (c *Client) GetTitles(ctx context.Context, titleNames ...string) ([]Title, error) {
+	titles, _, err := c.GetTitlesWithSource(ctx, titleNames...)
+	return titles, err
+}
+
+// GetTitlesWithSource behaves like GetTitles and additionally reports where and when the titles
+// were read from. Catalog entries sharing a title_name are handled according to the duplicate
+// titles policy: DuplicateTitlesPolicyError fails the read with a DuplicateTitlesError,
+// DuplicateTitlesPolicyWarn keeps the first entry of each title and reports the duplicates in
+// Source.Duplicates, and the precedence policies keep the preferred entry and log the choice.
+func (c *Client) GetTitlesWithSource(ctx context.Context, titleNames ...string) ([]Title, Source, error) {
+	titles, source, err := c.fetchTitlesShared(ctx, titleNames)
+	malformedErr, partial := errors.AsType[*MalformedTitlesError](err)
+	if err != nil && !partial {
+		return nil, Source{}, err
+	}
+
+	titles, duplicates := resolveDuplicates(titles, c.duplicatePolicy)
+	if len(duplicates) > 0 {
+		switch c.duplicatePolicy {
+		case DuplicateTitlesPolicyError:
+			return nil, Source{}, &DuplicateTitlesError{Titles: duplicates}
+		case DuplicateTitlesPolicyWarn:
+			source.Duplicates = duplicates
+		default:
+			c.logResolvedDuplicates(ctx, duplicates)
+		}
+	}
+	source.UnknownProfiles = unknownProfiles(titles)
+
+	if c.auditLogPath != "" {
+		if err := c.writeAuditRecord(source, titleNames, titles); err != nil {
+			return nil, Source{}, err
+		}
+	}
+
+	if partial {
+		return titles, source, malformedErr
+	}
+	return titles, source, nil
+}
+
+// fetchTitles retrieves titles from the API or file and reports the source that served them.
+func (c *Client) fetchTitles(ctx context.Context, titleNames []string) ([]Title, Source, error) {
+	if c.definitionsJSON != "" {
+		return c.getTitlesFromInline(ctx, titleNames)
+	}
 	if c.definitionsFile != "" {
-		return c.getTitlesFromFile(ctx, titleNames...)
+		titles, err := c.getTitlesFromFiles(ctx, c.definitionsFile, titleNames...)
+		return titles, Source{Type: SourceTypeFile, Location: c.definitionsFile, FetchedAt: time.Now()}, err
 	}
 
-	url := c.baseURL
+	var path string
 	if len(titleNames) > 0 {
-		url = fmt.Sprintf("%s/%s", c.baseURL, strings.Join(titleNames, ","))
+		path = "/" + strings.Join(titleNames, ",")
+	}
+
+	if c.cache != nil {
+		if titles, source, ok, err := c.fetchTitlesFromCache(ctx, path, titleNames); ok {
+			return titles, source, err
+		}
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	resp, url, err := c.getFromMirrors(ctx, path)
 	if err != nil {
-		return nil, fmt.Errorf("error creating request: %w", err)
+		// The API responds with 404 when none of the requested titles exist.
+		// Catalog objects in cloud storage are filtered locally, so a 404 means the object is missing.
+		if apiErr, ok := errors.AsType[*APIError](err); ok && apiErr.StatusCode == http.StatusNotFound && len(titleNames) > 0 && !isObjectURL(c.baseURL) {
+			return nil, Source{}, &TitlesNotFoundError{MissingTitles: titleNames}
+		}
+		if c.fallbackFile != "" && shouldFailover(ctx, err) {
+			return c.fetchTitlesFromFallback(ctx, titleNames, err)
+		}
+		return nil, Source{}, err
 	}
+	defer c.closeWithLog(ctx, resp.Body, "response body")
 
+	var body io.Reader = resp.Body
 	if c.logger != nil {
-		c.logger.LogRequest(ctx, req.Method, req.URL.String(), nil)
+		body = &progressReader{ReadCloser: resp.Body, ctx: ctx, logger: c.logger, source: url, progress: newProgressTracker()}
 	}
 
-	resp, err := c.httpClient.Do(req)
+	source := Source{Type: SourceTypeURL, Location: redactURL(resp.Request.URL), FetchedAt: time.Now()}
+
+	var entry *cacheEntry
+	if c.cache != nil {
+		if entry, err = c.cache.create(c.cacheKey(ctx, path), source.Location); err == nil {
+			body = io.TeeReader(body, entry)
+		} else if c.logger != nil {
+			c.logger.LogAuth(ctx, "Unable to cache response", map[string]any{"error": err.Error()})
+		}
+	}
+
+	titles, err := c.decodeTitles(ctx, body, "response", titleNames)
+	if entry != nil {
+		c.storeCacheEntry(ctx, entry, body, err)
+	}
+	return titles, source, err
+}
+
+// get performs a GET request for path against base and returns the response if it has a 200
+// status code and is not an HTML page. Transient failures are retried according to the client's
+// retry policy, and a request rejected with 401 is sent once more with a newly obtained OAuth2
+// access token, since the server may have revoked or rotated the cached one early. The caller is
+// responsible for closing the response body.
+func (c *Client) get(ctx context.Context, base, path string) (*http.Response, error) {
+	resp, mechanism, err := c.getOnce(ctx, base, path)
+	if apiErr, ok := errors.AsType[*APIError](err); ok && apiErr.StatusCode == http.StatusUnauthorized && c.oauth != nil && !isObjectURL(base) {
+		if c.logger != nil {
+			c.logger.LogAuth(ctx, "Retrying request with a new OAuth2 access token", map[string]any{
+				"error": err.Error(),
+			})
+		}
+		resp, mechanism, err = c.getOnce(ctx, base, path)
+	}
+	if authErr, ok := errors.AsType[*AuthenticationError](err); ok {
+		authErr.Mechanism = mechanism
+	}
 	if err != nil {
-		return nil, fmt.Errorf("error making request: %w", err)
+		return nil, err
+	}
+
+	if err := checkContentType(resp); err != nil {
+		c.closeWithLog(ctx, resp.Body, "response body")
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// getOnce builds a GET request for path against base, with fresh credentials, and sends it with
+// the client's retry policy. It also returns the authentication mechanism the request used.
+func (c *Client) getOnce(ctx context.Context, base, path string) (*http.Response, string, error) {
+	req, mechanism, err := c.newBaseRequest(ctx, http.MethodGet, base, path)
+	if err != nil {
+		return nil, "", err
+	}
+	if !isObjectURL(base) {
+		c.setChannelQuery(req)
+		c.setCatalogVersionQuery(req)
+	}
+
+	resp, err := c.doWithRetry(ctx, req)
+	return resp, mechanism, err
+}
+
+// do sends req once and returns the response if it has a 200 status code.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	release, err := c.acquireRequestSlot(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	if c.logger != nil {
-		c.logHTTPResponse(ctx, resp)
+		c.logger.LogRequest(ctx, req.Method, req.URL.String(), nil)
 	}
 
-	defer c.closeWithLog(ctx, resp.Body, "response body")
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		release()
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	resp.Body = &slotReader{ReadCloser: resp.Body, release: release}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("API request failed with status code: %d", resp.StatusCode)
+	if c.maxResponseSize > 0 && resp.Body != nil {
+		resp.Body = newLimitReader(resp.Body, c.maxResponseSize)
 	}
 
-	var titles []Title
-	if err := json.NewDecoder(resp.Body).Decode(&titles); err != nil {
-		return nil, fmt.Errorf("error decoding response: %w", err)
+	if c.logger != nil {
+		c.logHTTPResponse(ctx, resp)
 	}
 
-	if len(titleNames) > 0 {
-		if missing := titlesMissing(titles, titleNames); len(missing) > 0 {
-			return nil, &TitlesNotFoundError{MissingTitles: missing}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxLogBodySize))
+		c.closeWithLog(ctx, resp.Body, "response body")
+		message, code := parseAPIErrorBody(body)
+		apiErr := &APIError{StatusCode: resp.StatusCode, Message: message, Code: code}
+		switch resp.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			if c.oauth != nil {
+				c.oauth.invalidate()
+			}
+			return nil, &AuthenticationError{Mechanism: c.authMechanism(), Err: apiErr}
+		case http.StatusTooManyRequests:
+			return nil, &RateLimitedError{RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()), Err: apiErr}
 		}
+		return nil, apiErr
 	}
 
-	return titles, nil
+	return resp, nil
 }
 
-// maxLogBodySize is the maximum number of bytes read from a response body for logging purposes.
+// maxLogBodySize is the maximum number of bytes of a response body captured for logging purposes.
 const maxLogBodySize = 1 << 20 // 1 MiB
 
-// logHTTPResponse logs the HTTP response details using the client's logger.
-// It reads the full body, logs up to maxLogBodySize bytes, and replaces resp.Body
-// so subsequent readers still see the complete response.
+// logHTTPResponse arranges for the HTTP response to be logged using the client's logger. The body is
+// not read up front: resp.Body is replaced with a reader that captures up to maxLogBodySize bytes as
+// the caller streams it and logs the response when the body is closed, so logging does not change
+// the memory profile of large responses.
 func (c *Client) logHTTPResponse(ctx context.Context, resp *http.Response) {
 	if resp.Body == nil {
 		c.logger.LogResponse(ctx, resp.StatusCode, resp.Header, nil)
 		return
 	}
 
-	responseBody, err := io.ReadAll(resp.Body)
-	if err != nil {
-		c.logger.LogAuth(ctx, "Failed to read response body", map[string]any{
-			"error": err.Error(),
-		})
-		return
-	}
+	resp.Body = &loggingReader{ReadCloser: resp.Body, ctx: ctx, logger: c.logger, resp: resp}
+}
 
-	if err := resp.Body.Close(); err != nil {
-		c.logger.LogAuth(ctx, "Failed to close response body", map[string]any{
-			"error": err.Error(),
-		})
-	}
+// loggingReader is an io.ReadCloser that captures the start of a response body as it is read and
+// logs the response once the body is closed.
+type loggingReader struct {
+	io.ReadCloser
+	ctx    context.Context
+	logger Logger
+	resp   *http.Response
+	buf    bytes.Buffer
+	logged bool
+}
 
-	logBody := responseBody
-	if len(logBody) > maxLogBodySize {
-		logBody = logBody[:maxLogBodySize]
+// Read reads from the underlying body, capturing bytes until maxLogBodySize is reached.
+func (lr *loggingReader) Read(p []byte) (int, error) {
+	n, err := lr.ReadCloser.Read(p)
+	if remaining := maxLogBodySize - lr.buf.Len(); remaining > 0 {
+		lr.buf.Write(p[:min(n, remaining)])
 	}
+	return n, err
+}
 
-	c.logger.LogResponse(ctx, resp.StatusCode, resp.Header, logBody)
-	resp.Body = io.NopCloser(bytes.NewBuffer(responseBody))
+// Close closes the underlying body and logs the response with the captured body.
+func (lr *loggingReader) Close() error {
+	err := lr.ReadCloser.Close()
+	if !lr.logged {
+		lr.logged = true
+		lr.logger.LogResponse(lr.ctx, lr.resp.StatusCode, lr.resp.Header, lr.buf.Bytes())
+	}
+	return err
 }
@@ -5,9 +5,15 @@ package client
 
 import (
 	"context"
+	"errors"
+	"io"
+	"math"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 )
 
 const testTitleJSON = `[{"title_name":"GoogleChrome","title_display_name":"Google Chrome","title_version":"1.0","patch_definition":{"requirements":[{"name":"Application Bundle ID","value":"com.google.Chrome"}]}}]`
@@ -49,6 +55,104 @@ func TestSetLogger(t *testing.T) {
 	}
 }
 
+func TestSetInsecureSkipVerify(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.maxRetries = 0
+	if _, err := c.GetTitles(context.Background()); err == nil {
+		t.Fatal("expected certificate verification error for self-signed server")
+	}
+
+	c.SetInsecureSkipVerify(true)
+	titles, err := c.GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error with verification disabled: %v", err)
+	}
+	if len(titles) != 1 {
+		t.Errorf("expected 1 title, got %d", len(titles))
+	}
+}
+
+func TestTransportSetters(t *testing.T) {
+	c := NewClient("https://example.com", "")
+
+	c.SetDisableKeepAlives(true)
+	if !c.transport.DisableKeepAlives {
+		t.Error("expected keep-alives to be disabled")
+	}
+
+	c.SetMaxIdleConns(7)
+	if c.transport.MaxIdleConns != 7 || c.transport.MaxIdleConnsPerHost != 7 {
+		t.Errorf("expected 7 idle connections, got %d/%d", c.transport.MaxIdleConns, c.transport.MaxIdleConnsPerHost)
+	}
+
+	c.SetMaxIdleConns(0)
+	if c.transport.MaxIdleConns != 0 || c.transport.MaxIdleConnsPerHost != math.MaxInt {
+		t.Errorf("expected no idle connection limit, got %d/%d", c.transport.MaxIdleConns, c.transport.MaxIdleConnsPerHost)
+	}
+
+	c.SetHTTP2Enabled(false)
+	if c.transport.Protocols.HTTP2() {
+		t.Error("expected HTTP/2 to be disabled")
+	}
+
+	c.SetHTTP2Enabled(true)
+	if !c.transport.Protocols.HTTP2() || !c.transport.Protocols.HTTP1() {
+		t.Error("expected HTTP/2 to be enabled")
+	}
+}
+
+func TestSetHTTP2Enabled_NegotiatesHTTP1(t *testing.T) {
+	var proto string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		proto = r.Proto
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	server.EnableHTTP2 = true
+	server.StartTLS()
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetInsecureSkipVerify(true)
+	c.SetHTTP2Enabled(false)
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if proto != "HTTP/1.1" {
+		t.Errorf("expected HTTP/1.1, got %s", proto)
+	}
+}
+
+func TestGetTitlesWithSource_URL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	before := time.Now()
+	c := NewClient(server.URL, "")
+	_, source, err := c.GetTitlesWithSource(context.Background(), "GoogleChrome")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.Type != SourceTypeURL {
+		t.Errorf("expected source type %s, got %s", SourceTypeURL, source.Type)
+	}
+	if source.Location != server.URL+"/GoogleChrome" {
+		t.Errorf("unexpected source location %q", source.Location)
+	}
+	if source.FetchedAt.Before(before) {
+		t.Errorf("expected fetched_at after %s, got %s", before, source.FetchedAt)
+	}
+}
+
 func TestGetTitles_AllTitles_Success(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.URL.Path != "/" {
@@ -225,14 +329,151 @@ func TestGetTitles_NilPointerFields(t *testing.T) {
 type mockLogger struct {
 	requestCount  int
 	responseCount int
+	messageCount  int
+	lastBody      []byte
 }
 
 func (m *mockLogger) LogRequest(_ context.Context, _, _ string, _ []byte) {
 	m.requestCount++
 }
 
-func (m *mockLogger) LogResponse(_ context.Context, _ int, _ http.Header, _ []byte) {
+func (m *mockLogger) LogResponse(_ context.Context, _ int, _ http.Header, body []byte) {
 	m.responseCount++
+	m.lastBody = body
+}
+
+func (m *mockLogger) LogAuth(_ context.Context, _ string, _ map[string]any) {
+	m.messageCount++
+}
+
+func TestLogHTTPResponse_LogsStreamedBodyOnClose(t *testing.T) {
+	logger := &mockLogger{}
+	c := NewClient("", "")
+	c.SetLogger(logger)
+
+	resp := &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(testTitleJSON))}
+	c.logHTTPResponse(context.Background(), resp)
+
+	if logger.responseCount != 0 {
+		t.Fatal("expected response not to be logged before the body is closed")
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != testTitleJSON {
+		t.Errorf("expected body to be passed through unchanged, got %q", data)
+	}
+
+	_ = resp.Body.Close()
+	_ = resp.Body.Close()
+	if logger.responseCount != 1 {
+		t.Errorf("expected response to be logged once, got %d", logger.responseCount)
+	}
+	if string(logger.lastBody) != testTitleJSON {
+		t.Errorf("expected logged body to match, got %q", logger.lastBody)
+	}
+}
+
+func TestLogHTTPResponse_TruncatesLoggedBody(t *testing.T) {
+	logger := &mockLogger{}
+	c := NewClient("", "")
+	c.SetLogger(logger)
+
+	body := strings.Repeat("a", maxLogBodySize+100)
+	resp := &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}
+	c.logHTTPResponse(context.Background(), resp)
+
+	data, _ := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+
+	if len(data) != len(body) {
+		t.Errorf("expected full body to be read, got %d bytes", len(data))
+	}
+	if len(logger.lastBody) != maxLogBodySize {
+		t.Errorf("expected logged body to be truncated to %d bytes, got %d", maxLogBodySize, len(logger.lastBody))
+	}
+}
+
+func TestGetTitles_LogsErrorResponseBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("internal failure"))
+	}))
+	defer server.Close()
+
+	logger := &mockLogger{}
+	c := NewClient(server.URL, "")
+	c.SetLogger(logger)
+
+	if _, err := c.GetTitles(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+	if string(logger.lastBody) != "internal failure" {
+		t.Errorf("expected error body to be logged, got %q", logger.lastBody)
+	}
 }
 
-func (m *mockLogger) LogAuth(_ context.Context, _ string, _ map[string]any) {}
+func TestGetTitles_StructuredErrorBody(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{"message":"catalog rebuilding","code":"CATALOG_UNAVAILABLE"}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	_, err := c.GetTitles(context.Background())
+
+	apiErr, ok := errors.AsType[*APIError](err)
+	if !ok {
+		t.Fatalf("expected APIError, got %v", err)
+	}
+	if apiErr.Message != "catalog rebuilding" || apiErr.Code != "CATALOG_UNAVAILABLE" {
+		t.Errorf("expected server message and code, got %+v", apiErr)
+	}
+}
+
+func TestGetTitles_NotFoundMapsToTitlesNotFoundError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+
+	_, err := c.GetTitles(context.Background(), "NoSuchApp", "OtherApp")
+	notFound, ok := errors.AsType[*TitlesNotFoundError](err)
+	if !ok {
+		t.Fatalf("expected TitlesNotFoundError, got %v", err)
+	}
+	if !slices.Equal(notFound.MissingTitles, []string{"NoSuchApp", "OtherApp"}) {
+		t.Errorf("expected requested titles to be listed, got %v", notFound.MissingTitles)
+	}
+
+	_, err = c.GetTitles(context.Background())
+	if _, ok := errors.AsType[*APIError](err); !ok {
+		t.Errorf("expected APIError when fetching all titles, got %v", err)
+	}
+}
+
+func TestGetTitles_AuthenticationError(t *testing.T) {
+	for _, status := range []int{http.StatusUnauthorized, http.StatusForbidden} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(status)
+		}))
+
+		c := NewClient(server.URL, "")
+		_, err := c.GetTitles(context.Background(), "GoogleChrome")
+		server.Close()
+
+		authErr, ok := errors.AsType[*AuthenticationError](err)
+		if !ok {
+			t.Fatalf("status %d: expected AuthenticationError, got %v", status, err)
+		}
+		if authErr.Mechanism != AuthMechanismNone || authErr.Err.StatusCode != status {
+			t.Errorf("status %d: unexpected error %+v", status, authErr)
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// cloudToken is an OAuth2 access token issued to the workload by a cloud provider's identity service.
+type cloudToken struct {
+	accessToken string
+	// expires is when the token expires, and zero when the lifetime is unknown.
+	expires time.Time
+}
+
+// cloudTokenCache resolves a cloud access token once per client and caches it until shortly before
+// it expires, so credential files and metadata services are not read for every request.
+type cloudTokenCache struct {
+	httpClient *http.Client
+	resolve    func(ctx context.Context, httpClient *http.Client) (*cloudToken, error)
+
+	mu       sync.Mutex
+	resolved bool
+	token    *cloudToken
+}
+
+// newCloudTokenCache returns a token cache that resolves tokens with resolve, sending requests to
+// identity endpoints through transport.
+func newCloudTokenCache(transport http.RoundTripper, resolve func(ctx context.Context, httpClient *http.Client) (*cloudToken, error)) *cloudTokenCache {
+	return &cloudTokenCache{httpClient: &http.Client{Timeout: defaultHTTPTimeout, Transport: transport}, resolve: resolve}
+}
+
+// accessToken returns the cached token, resolving it again when none was resolved yet or the cached
+// one is about to expire. It returns nil when no credentials are configured. Failures are not
+// cached, so the next request tries again.
+func (c *cloudTokenCache) accessToken(ctx context.Context) (*cloudToken, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.resolved && (c.token == nil || c.token.expires.IsZero() || time.Now().Add(tokenRefreshMargin).Before(c.token.expires)) {
+		return c.token, nil
+	}
+
+	token, err := c.resolve(ctx, c.httpClient)
+	if err != nil {
+		return nil, err
+	}
+	c.token, c.resolved = token, true
+	return token, nil
+}
+
+// expiresIn returns the expiry of a token issued now that is valid for seconds, or zero when the
+// lifetime is not positive.
+func expiresIn(seconds int64) time.Time {
+	if seconds <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(seconds) * time.Second)
+}
+
+// flexibleInt64 decodes a JSON number or a string holding one, as identity endpoints report token
+// lifetimes in either form.
+type flexibleInt64 int64
+
+// UnmarshalJSON decodes a number or a numeric string.
+func (n *flexibleInt64) UnmarshalJSON(data []byte) error {
+	value, err := strconv.ParseInt(strings.Trim(string(data), `"`), 10, 64)
+	if err != nil {
+		return fmt.Errorf("expected a number, got %s", data)
+	}
+	*n = flexibleInt64(value)
+	return nil
+}
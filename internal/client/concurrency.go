@@ -0,0 +1,53 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+)
+
+// SetMaxConcurrentRequests limits how many requests to the definitions server are in flight at
+// once; further requests wait for a free slot. A request holds its slot until its response body is
+// closed, so downloads of large catalogs count against the limit. Zero removes the limit.
+func (c *Client) SetMaxConcurrentRequests(n int) error {
+	if n < 0 {
+		return fmt.Errorf("must not be negative, got %d", n)
+	}
+	if n == 0 {
+		c.requestSlots = nil
+		return nil
+	}
+	c.requestSlots = make(chan struct{}, n)
+	return nil
+}
+
+// acquireRequestSlot waits until a request may be sent and returns the function that frees its
+// slot again. It returns immediately when requests are not limited.
+func (c *Client) acquireRequestSlot(ctx context.Context) (func(), error) {
+	if c.requestSlots == nil {
+		return func() {}, nil
+	}
+	select {
+	case c.requestSlots <- struct{}{}:
+		var once sync.Once
+		return func() { once.Do(func() { <-c.requestSlots }) }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("error waiting for a free request slot: %w", ctx.Err())
+	}
+}
+
+// slotReader is an io.ReadCloser that frees the request slot of its response when closed.
+type slotReader struct {
+	io.ReadCloser
+	release func()
+}
+
+// Close closes the underlying body and frees the request slot.
+func (sr *slotReader) Close() error {
+	defer sr.release()
+	return sr.ReadCloser.Close()
+}
@@ -0,0 +1,86 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSetMaxConcurrentRequests_LimitsInFlightRequests(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	if err := c.SetMaxConcurrentRequests(2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	for i := range 6 {
+		// Distinct reads, so they are not shared.
+		wg.Go(func() {
+			_, err := c.GetTitles(context.Background(), fmt.Sprintf("Title%d", i))
+			if _, ok := errors.AsType[*TitlesNotFoundError](err); err != nil && !ok {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+	wg.Wait()
+
+	if p := peak.Load(); p != 2 {
+		t.Errorf("expected at most 2 requests in flight, got a peak of %d", p)
+	}
+}
+
+func TestSetMaxConcurrentRequests_WaitRespectsContext(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	if err := c.SetMaxConcurrentRequests(1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	release, err := c.acquireRequestSlot(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer release()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := c.acquireRequestSlot(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected a deadline error, got %v", err)
+	}
+}
+
+func TestSetMaxConcurrentRequests_Invalid(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	if err := c.SetMaxConcurrentRequests(-1); err == nil {
+		t.Error("expected an error for a negative limit")
+	}
+	if err := c.SetMaxConcurrentRequests(0); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if c.requestSlots != nil {
+		t.Error("expected no limit")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+)
+
+// contentSnippetSize is the number of bytes of an unexpected response body included in errors.
+const contentSnippetSize = 256
+
+// UnexpectedContentTypeError is returned when the definitions server responds with a document that
+// cannot be a catalog, such as the HTML login page of a captive portal or SSO proxy.
+type UnexpectedContentTypeError struct {
+	ContentType string
+	Snippet     string
+}
+
+func (e *UnexpectedContentTypeError) Error() string {
+	return fmt.Sprintf("expected a JSON response but received %s; the definitions URL may be returning a login or captive portal page. Response begins: %q",
+		e.ContentType, e.Snippet)
+}
+
+// checkContentType returns an UnexpectedContentTypeError, including the start of the body, when resp
+// is an HTML document. Other content types are accepted, since catalogs are not always served as
+// application/json.
+func checkContentType(resp *http.Response) error {
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+		return nil
+	}
+
+	snippet, _ := io.ReadAll(io.LimitReader(resp.Body, contentSnippetSize))
+	return &UnexpectedContentTypeError{
+		ContentType: mediaType,
+		Snippet:     strings.Join(strings.Fields(strings.ToValidUTF8(string(snippet), "")), " "),
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCheckContentType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		wantErr     bool
+	}{
+		{"application/json", false},
+		{"application/json; charset=utf-8", false},
+		{"text/plain", false},
+		{"", false},
+		{"text/html; charset=utf-8", true},
+		{"application/xhtml+xml", true},
+	}
+
+	for _, tt := range tests {
+		resp := &http.Response{
+			Header: http.Header{"Content-Type": []string{tt.contentType}},
+			Body:   io.NopCloser(strings.NewReader("<html>\n  <body>Sign in</body>\n</html>")),
+		}
+		err := checkContentType(resp)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("content type %q: expected error %v, got %v", tt.contentType, tt.wantErr, err)
+		}
+	}
+}
+
+func TestCheckContentType_SnippetIsTruncatedAndCollapsed(t *testing.T) {
+	body := "<html>\n\t<body>" + strings.Repeat("x", 2*contentSnippetSize) + "</body></html>"
+	resp := &http.Response{
+		Header: http.Header{"Content-Type": []string{"text/html"}},
+		Body:   io.NopCloser(strings.NewReader(body)),
+	}
+
+	err := checkContentType(resp)
+	contentErr, ok := errors.AsType[*UnexpectedContentTypeError](err)
+	if !ok {
+		t.Fatalf("expected UnexpectedContentTypeError, got %v", err)
+	}
+	if !strings.HasPrefix(contentErr.Snippet, "<html> <body>xxx") {
+		t.Errorf("expected whitespace to be collapsed, got %q", contentErr.Snippet)
+	}
+	if len(contentErr.Snippet) > contentSnippetSize {
+		t.Errorf("expected snippet of at most %d bytes, got %d", contentSnippetSize, len(contentErr.Snippet))
+	}
+}
+
+func TestGetTitles_HTMLResponse(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		_, _ = w.Write([]byte("<!DOCTYPE html><html><head><title>Captive Portal Login</title></head></html>"))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	_, err := c.GetTitles(context.Background(), "GoogleChrome")
+
+	contentErr, ok := errors.AsType[*UnexpectedContentTypeError](err)
+	if !ok {
+		t.Fatalf("expected UnexpectedContentTypeError, got %v", err)
+	}
+	if !strings.Contains(contentErr.Snippet, "Captive Portal Login") {
+		t.Errorf("expected snippet to include the page title, got %q", contentErr.Snippet)
+	}
+}
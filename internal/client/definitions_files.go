@@ -0,0 +1,83 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// globMetaCharacters are the characters that make a definitions file path a glob pattern.
+const globMetaCharacters = "*?["
+
+// definitionsFilePaths returns the files a definitions file setting refers to. A path containing
+// glob metacharacters, such as catalogs/*.json, is expanded to the files it matches in lexical
+// order, so merged catalogs are read in the same order on every run. Any other path is returned
+// as is.
+func definitionsFilePaths(pattern string) ([]string, error) {
+	if !strings.ContainsAny(pattern, globMetaCharacters) {
+		return []string{pattern}, nil
+	}
+
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid definitions file pattern %q: %w", pattern, err)
+	}
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("definitions file pattern %q matches no files", pattern)
+	}
+	slices.Sort(paths)
+	return paths, nil
+}
+
+// getTitlesFromFiles retrieves titles from the definitions files matched by pattern. When pattern
+// matches more than one file, the titles of every file are merged in file order. Titles that appear
+// in more than one file are then resolved by the duplicate titles policy like duplicates within a
+// single catalog, so with the first-entry and last_wins policies the file that sorts first or last
+// takes precedence.
+func (c *Client) getTitlesFromFiles(ctx context.Context, pattern string, titleNames ...string) ([]Title, error) {
+	paths, err := definitionsFilePaths(pattern)
+	if err != nil {
+		return nil, err
+	}
+	if len(paths) == 1 {
+		return c.getTitlesFromFile(ctx, paths[0], titleNames...)
+	}
+
+	var titles []Title
+	var malformed []MalformedTitle
+	for _, path := range paths {
+		fileTitles, err := c.getTitlesFromFile(ctx, path)
+		if malformedErr, ok := errors.AsType[*MalformedTitlesError](err); ok {
+			malformed = append(malformed, malformedErr.Titles...)
+		} else if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		titles = append(titles, fileTitles...)
+	}
+
+	if len(titleNames) > 0 {
+		titles = slices.DeleteFunc(titles, func(title Title) bool {
+			return title.TitleName == nil || !slices.Contains(titleNames, *title.TitleName)
+		})
+		malformed = slices.DeleteFunc(malformed, func(m MalformedTitle) bool {
+			return !slices.Contains(titleNames, m.TitleName)
+		})
+		missing := slices.DeleteFunc(titlesMissing(titles, titleNames), func(name string) bool {
+			return slices.ContainsFunc(malformed, func(m MalformedTitle) bool { return m.TitleName == name })
+		})
+		if len(missing) > 0 {
+			return nil, &TitlesNotFoundError{MissingTitles: missing}
+		}
+	}
+
+	if len(malformed) > 0 {
+		return titles, &MalformedTitlesError{Titles: malformed}
+	}
+	return titles, nil
+}
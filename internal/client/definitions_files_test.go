@@ -0,0 +1,132 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeDefinitionsFiles writes each catalog to a file of the given name in a new directory and
+// returns the directory.
+func writeDefinitionsFiles(t *testing.T, catalogs map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range catalogs {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatalf("failed to write %s: %v", name, err)
+		}
+	}
+	return dir
+}
+
+func TestDefinitionsFilePaths(t *testing.T) {
+	dir := writeDefinitionsFiles(t, map[string]string{"b.json": "[]", "a.json": "[]", "notes.txt": ""})
+
+	paths, err := definitionsFilePaths(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := []string{filepath.Join(dir, "a.json"), filepath.Join(dir, "b.json")}
+	if len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] {
+		t.Errorf("expected %v, got %v", want, paths)
+	}
+
+	if paths, err := definitionsFilePaths("definitions.json"); err != nil || len(paths) != 1 || paths[0] != "definitions.json" {
+		t.Errorf("expected a plain path to be returned as is, got %v, %v", paths, err)
+	}
+	if _, err := definitionsFilePaths(filepath.Join(dir, "*.yaml")); err == nil {
+		t.Error("expected error for a pattern matching no files, got nil")
+	}
+}
+
+func TestGetTitles_MergesDefinitionsFiles(t *testing.T) {
+	dir := writeDefinitionsFiles(t, map[string]string{
+		"team-a.json": `[{"title_name": "AppA", "title_version": "1.0"}, {"title_name": "Shared", "title_version": "1.0"}]`,
+		"team-b.json": `{"titles": [{"title_name": "AppB", "title_version": "2.0"}, {"title_name": "Shared", "title_version": "2.0"}]}`,
+	})
+
+	c := NewClient("", filepath.Join(dir, "*.json"))
+	if err := c.SetDuplicateTitlesPolicy(DuplicateTitlesPolicyLastWins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	titles, err := c.GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	versions := make(map[string]string, len(titles))
+	for _, title := range titles {
+		versions[*title.TitleName] = *title.TitleVersion
+	}
+	if len(versions) != 3 || versions["AppA"] != "1.0" || versions["AppB"] != "2.0" {
+		t.Errorf("expected titles from both files, got %v", versions)
+	}
+	if versions["Shared"] != "2.0" {
+		t.Errorf("expected the file sorting last to win under last_wins, got %q", versions["Shared"])
+	}
+}
+
+func TestGetTitles_MergedDefinitionsFilesDuplicatesError(t *testing.T) {
+	dir := writeDefinitionsFiles(t, map[string]string{
+		"a.json": `[{"title_name": "Shared", "title_version": "1.0"}]`,
+		"b.json": `[{"title_name": "Shared", "title_version": "2.0"}]`,
+	})
+
+	_, err := NewClient("", filepath.Join(dir, "*.json")).GetTitles(context.Background())
+	if _, ok := errors.AsType[*DuplicateTitlesError](err); !ok {
+		t.Fatalf("expected DuplicateTitlesError under the default policy, got %v", err)
+	}
+}
+
+func TestGetTitles_MergedDefinitionsFilesByName(t *testing.T) {
+	dir := writeDefinitionsFiles(t, map[string]string{
+		"a.json": `[{"title_name": "AppA", "title_version": "1.0"}]`,
+		"b.json": `[{"title_name": "AppB", "title_version": "2.0"}]`,
+	})
+	c := NewClient("", filepath.Join(dir, "*.json"))
+
+	titles, err := c.GetTitles(context.Background(), "AppB")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 || *titles[0].TitleName != "AppB" {
+		t.Errorf("expected only AppB, got %v", titles)
+	}
+
+	_, err = c.GetTitles(context.Background(), "AppA", "Missing")
+	notFound, ok := errors.AsType[*TitlesNotFoundError](err)
+	if !ok || len(notFound.MissingTitles) != 1 || notFound.MissingTitles[0] != "Missing" {
+		t.Errorf("expected Missing to be reported as not found, got %v", err)
+	}
+}
+
+func TestCheckHealth_DefinitionsFilePattern(t *testing.T) {
+	dir := writeDefinitionsFiles(t, map[string]string{"a.json": "[]"})
+
+	if status := NewClient("", filepath.Join(dir, "*.json")).CheckHealth(context.Background()); !status.Healthy {
+		t.Errorf("expected healthy status, got %q", status.Message)
+	}
+	if status := NewClient("", filepath.Join(dir, "*.yaml")).CheckHealth(context.Background()); status.Healthy {
+		t.Error("expected a pattern matching no files to be unhealthy")
+	}
+}
+
+func TestCatalogSchemaVersion_LowestOfDefinitionsFiles(t *testing.T) {
+	dir := writeDefinitionsFiles(t, map[string]string{
+		"a.json": `{"schema_version": 2, "titles": []}`,
+		"b.json": `[]`,
+	})
+
+	version, err := NewClient("", filepath.Join(dir, "*.json")).CatalogSchemaVersion(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != DefaultSchemaVersion {
+		t.Errorf("expected the lowest schema version %d, got %d", DefaultSchemaVersion, version)
+	}
+}
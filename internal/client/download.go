@@ -0,0 +1,64 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+)
+
+// FetchCatalog downloads the full catalog and returns its raw content along with the source that
+// served it. The content is checked to be a catalog of titles before it is returned, so a broken
+// response is never passed on to be stored; catalogs with malformed entries are returned as is.
+func (c *Client) FetchCatalog(ctx context.Context) ([]byte, string, error) {
+	content, source, err := c.readCatalog(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if _, err := c.decodeTitles(ctx, bytes.NewReader(content), "catalog", nil); err != nil {
+		if _, ok := errors.AsType[*MalformedTitlesError](err); !ok {
+			return nil, "", err
+		}
+	}
+
+	return content, source, nil
+}
+
+// readCatalog reads the full catalog from the definitions file or API without decoding it.
+func (c *Client) readCatalog(ctx context.Context) ([]byte, string, error) {
+	if c.definitionsJSON != "" {
+		return []byte(c.definitionsJSON), inlineLocation, nil
+	}
+	if c.definitionsFile != "" {
+		paths, err := definitionsFilePaths(c.definitionsFile)
+		if err != nil {
+			return nil, "", err
+		}
+		if len(paths) > 1 {
+			return nil, "", fmt.Errorf("definitions file pattern %q matches %d files; the catalog can only be read from a single file", c.definitionsFile, len(paths))
+		}
+		content, err := os.ReadFile(paths[0])
+		if err != nil {
+			return nil, "", fmt.Errorf("error reading definitions file: %w", err)
+		}
+		return content, paths[0], nil
+	}
+
+	resp, _, err := c.getFromMirrors(ctx, "")
+	if err != nil {
+		return nil, "", err
+	}
+	defer c.closeWithLog(ctx, resp.Body, "response body")
+
+	content, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, "", fmt.Errorf("error reading response: %w", err)
+	}
+	return content, redactURL(resp.Request.URL), nil
+}
@@ -0,0 +1,65 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchCatalog_URL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	content, source, err := c.FetchCatalog(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != testMultipleTitlesJSON {
+		t.Errorf("expected catalog to be returned unchanged, got %q", content)
+	}
+	if source == "" {
+		t.Error("expected source to be reported")
+	}
+}
+
+func TestFetchCatalog_File(t *testing.T) {
+	path := writeTempFile(t, testMultipleTitlesJSON)
+	c := NewClient("", path)
+	content, source, err := c.FetchCatalog(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != testMultipleTitlesJSON {
+		t.Errorf("expected catalog to be returned unchanged, got %q", content)
+	}
+	if source != path {
+		t.Errorf("expected source %s, got %s", path, source)
+	}
+}
+
+func TestFetchCatalog_MalformedEntriesAccepted(t *testing.T) {
+	catalog := `[{"title_name": "GoogleChrome"}, {"title_name": "Broken", "title_version": 1}]`
+	c := NewClient("", writeTempFile(t, catalog))
+	content, _, err := c.FetchCatalog(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != catalog {
+		t.Errorf("expected catalog to be returned unchanged, got %q", content)
+	}
+}
+
+func TestFetchCatalog_NotACatalog(t *testing.T) {
+	c := NewClient("", writeTempFile(t, `{"error": "maintenance"}`))
+	if _, _, err := c.FetchCatalog(context.Background()); err == nil {
+		t.Fatal("expected error for content that is not a catalog")
+	}
+}
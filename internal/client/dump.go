@@ -0,0 +1,210 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// maxDumpBodySize is the maximum number of bytes of a response body written to the dump file.
+const maxDumpBodySize = 4 << 10 // 4 KiB
+
+// redactedValue replaces sensitive header and query parameter values in dumps.
+const redactedValue = "REDACTED"
+
+// sensitiveHeaders are always redacted from dumps, regardless of their name matching sensitiveWords.
+var sensitiveHeaders = []string{"Authorization", "Proxy-Authorization", "Cookie", "Set-Cookie"}
+
+// sensitiveWords mark header and query parameter names whose values are redacted from dumps.
+var sensitiveWords = []string{"token", "secret", "password", "key", "signature", "credential", "session"}
+
+// SetDumpFile appends a sanitized transcript of every request and response to the file at path.
+// Credentials are redacted from headers and query parameters, and response bodies are truncated,
+// so the file can be attached to support tickets as is. Requests for access tokens and cloud
+// credentials are dumped too, with their response bodies left out. The file is opened for each
+// entry and closed again, so no handle is held between requests.
+func (c *Client) SetDumpFile(path string) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("error opening dump file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error closing dump file: %w", err)
+	}
+
+	dump := &dumpFile{path: path}
+	c.httpClient.Transport = &dumpTransport{next: c.transport, w: dump}
+	c.setTokenTransport(&dumpTransport{next: c.transport, w: dump, redactBodies: true})
+	return nil
+}
+
+// setTokenTransport sends the requests made to obtain access tokens and cloud credentials
+// through transport.
+func (c *Client) setTokenTransport(transport http.RoundTripper) {
+	c.tokenTransport = transport
+	c.awsCredentials.httpClient.Transport = transport
+	c.googleTokens.httpClient.Transport = transport
+	c.azureTokens.httpClient.Transport = transport
+	if c.oauth != nil {
+		c.oauth.httpClient.Transport = transport
+	}
+}
+
+// dumpFile is an io.Writer that appends each write to the file at path, opening and closing the
+// file every time. Writes are serialized, so transports sharing a dumpFile do not interleave.
+type dumpFile struct {
+	path string
+	mu   sync.Mutex
+}
+
+// Write appends p to the file.
+func (f *dumpFile) Write(p []byte) (int, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return 0, err
+	}
+	n, err := file.Write(p)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	return n, err
+}
+
+// dumpTransport is an http.RoundTripper that writes a redacted transcript of each exchange to w.
+// An entry is written once the response body is closed, so entries from concurrent requests do
+// not interleave. When redactBodies is set, only the size of each response body is written, as
+// the bodies of credential exchanges hold secrets.
+type dumpTransport struct {
+	next         http.RoundTripper
+	mu           sync.Mutex
+	w            io.Writer
+	redactBodies bool
+}
+
+// RoundTrip sends req using the next transport and arranges for the exchange to be dumped.
+func (t *dumpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var entry bytes.Buffer
+	start := time.Now()
+	fmt.Fprintf(&entry, "=== %s %s %s\n", start.UTC().Format(time.RFC3339), req.Method, redactURL(req.URL))
+	writeHeaders(&entry, "> ", req.Header)
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		fmt.Fprintf(&entry, "! error after %s: %s\n\n", time.Since(start).Round(time.Millisecond), err)
+		t.write(entry.Bytes())
+		return nil, err
+	}
+
+	fmt.Fprintf(&entry, "< %s %s (%s)\n", resp.Proto, resp.Status, time.Since(start).Round(time.Millisecond))
+	writeHeaders(&entry, "< ", resp.Header)
+	resp.Body = &dumpBody{ReadCloser: resp.Body, transport: t, entry: entry, redact: t.redactBodies}
+	return resp, nil
+}
+
+// write appends a complete entry to the dump.
+func (t *dumpTransport) write(entry []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	_, _ = t.w.Write(entry)
+}
+
+// dumpBody is an io.ReadCloser that captures the start of a response body and writes the dump
+// entry when the body is closed.
+type dumpBody struct {
+	io.ReadCloser
+	transport *dumpTransport
+	entry     bytes.Buffer
+	body      bytes.Buffer
+	total     int64
+	written   bool
+	redact    bool
+}
+
+// Read reads from the underlying body, capturing bytes until maxDumpBodySize is reached.
+func (b *dumpBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	b.total += int64(n)
+	if b.redact {
+		return n, err
+	}
+	if remaining := maxDumpBodySize - b.body.Len(); remaining > 0 {
+		b.body.Write(p[:min(n, remaining)])
+	}
+	return n, err
+}
+
+// Close closes the underlying body and writes the dump entry.
+func (b *dumpBody) Close() error {
+	err := b.ReadCloser.Close()
+	if !b.written {
+		b.written = true
+		if b.redact && b.total > 0 {
+			fmt.Fprintf(&b.entry, "[body redacted: %d bytes read]\n", b.total)
+		} else if b.body.Len() > 0 {
+			b.entry.Write(b.body.Bytes())
+			if b.total > int64(b.body.Len()) {
+				fmt.Fprintf(&b.entry, "\n[truncated: %d of %d bytes read shown]", b.body.Len(), b.total)
+			}
+			b.entry.WriteString("\n")
+		}
+		b.entry.WriteString("\n")
+		b.transport.write(b.entry.Bytes())
+	}
+	return err
+}
+
+// writeHeaders writes headers to w in sorted order with sensitive values redacted.
+func writeHeaders(w io.Writer, prefix string, header http.Header) {
+	for _, name := range slices.Sorted(maps.Keys(header)) {
+		for _, value := range header[name] {
+			if isSensitive(name) || slices.Contains(sensitiveHeaders, http.CanonicalHeaderKey(name)) {
+				value = redactedValue
+			}
+			fmt.Fprintf(w, "%s%s: %s\n", prefix, name, value)
+		}
+	}
+}
+
+// redactURL returns u as a string with user info and sensitive query parameter values redacted.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	if redacted.User != nil {
+		redacted.User = url.User(redactedValue)
+	}
+
+	query := redacted.Query()
+	changed := false
+	for name := range query {
+		if isSensitive(name) {
+			query[name] = []string{redactedValue}
+			changed = true
+		}
+	}
+	if changed {
+		redacted.RawQuery = query.Encode()
+	}
+
+	return redacted.String()
+}
+
+// isSensitive reports whether a header or query parameter name suggests its value is a credential.
+func isSensitive(name string) bool {
+	lower := strings.ToLower(name)
+	return slices.ContainsFunc(sensitiveWords, func(word string) bool {
+		return strings.Contains(lower, word)
+	})
+}
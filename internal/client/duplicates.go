@@ -0,0 +1,178 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/versions"
+)
+
+// Duplicate title policies accepted by SetDuplicateTitlesPolicy.
+const (
+	// DuplicateTitlesPolicyError fails the read with a DuplicateTitlesError.
+	DuplicateTitlesPolicyError = "error"
+	// DuplicateTitlesPolicyWarn keeps the first entry of each duplicated title and reports the
+	// duplicates in Source.Duplicates.
+	DuplicateTitlesPolicyWarn = "warn"
+	// DuplicateTitlesPolicyLastWins keeps the last entry of each duplicated title, for catalogs
+	// where later entries are expected to override earlier ones.
+	DuplicateTitlesPolicyLastWins = "last_wins"
+	// DuplicateTitlesPolicyHighestVersionWins keeps the entry with the highest title_version of
+	// each duplicated title, compared with versions.Compare. Ties keep the earlier entry.
+	DuplicateTitlesPolicyHighestVersionWins = "highest_version_wins"
+)
+
+// DuplicateTitlesPolicies lists the supported duplicate title policies.
+var DuplicateTitlesPolicies = []string{
+	DuplicateTitlesPolicyError,
+	DuplicateTitlesPolicyWarn,
+	DuplicateTitlesPolicyLastWins,
+	DuplicateTitlesPolicyHighestVersionWins,
+}
+
+// defaultDuplicateTitlesPolicy is the duplicate title policy used unless another is set.
+const defaultDuplicateTitlesPolicy = DuplicateTitlesPolicyError
+
+// SetDuplicateTitlesPolicy sets how catalog entries sharing a title_name are handled, one of
+// DuplicateTitlesPolicies.
+func (c *Client) SetDuplicateTitlesPolicy(policy string) error {
+	if !slices.Contains(DuplicateTitlesPolicies, policy) {
+		return fmt.Errorf("duplicate titles policy must be one of %s, got: %q", strings.Join(DuplicateTitlesPolicies, ", "), policy)
+	}
+	c.duplicatePolicy = policy
+	return nil
+}
+
+// DuplicateTitle describes a title_name that appears in more than one catalog entry.
+type DuplicateTitle struct {
+	TitleName string
+	// Versions holds the title_version of each entry, in catalog order.
+	Versions []string
+	// Kept is the index into Versions of the entry that was used.
+	Kept int
+}
+
+// DuplicateTitlesError is returned when the catalog contains more than one entry for a title and
+// the duplicate titles policy is DuplicateTitlesPolicyError.
+type DuplicateTitlesError struct {
+	Titles []DuplicateTitle
+}
+
+// Error returns a formatted string listing each duplicated title and the versions of its entries.
+func (e *DuplicateTitlesError) Error() string {
+	return fmt.Sprintf("The following titles appear more than once in the catalog: %s", describeDuplicates(e.Titles))
+}
+
+// String formats the duplicated title as "GoogleChrome (120.0, 121.0)".
+func (d DuplicateTitle) String() string {
+	return fmt.Sprintf("%s (%s)", d.TitleName, strings.Join(d.Versions, ", "))
+}
+
+// describeDuplicates formats duplicated titles as a semicolon separated list.
+func describeDuplicates(duplicates []DuplicateTitle) string {
+	descriptions := make([]string, 0, len(duplicates))
+	for _, duplicate := range duplicates {
+		descriptions = append(descriptions, duplicate.String())
+	}
+	return strings.Join(descriptions, "; ")
+}
+
+// resolveDuplicates returns titles with a single entry kept for each title_name, chosen according
+// to policy, along with the title_names that appeared more than once. Titles keep the position of
+// their first entry, and entries without a title_name are kept as is.
+func resolveDuplicates(titles []Title, policy string) ([]Title, []DuplicateTitle) {
+	position := make(map[string]int, len(titles))
+	entries := make(map[string][]Title, len(titles))
+	unique := make([]Title, 0, len(titles))
+	for _, title := range titles {
+		if title.TitleName == nil {
+			unique = append(unique, title)
+			continue
+		}
+		name := *title.TitleName
+		if _, seen := position[name]; !seen {
+			position[name] = len(unique)
+			unique = append(unique, title)
+		}
+		entries[name] = append(entries[name], title)
+	}
+
+	var duplicates []DuplicateTitle
+	for i, title := range unique {
+		if title.TitleName == nil || len(entries[*title.TitleName]) < 2 {
+			continue
+		}
+		group := entries[*title.TitleName]
+
+		kept := preferredEntry(group, policy)
+		unique[i] = group[kept]
+
+		duplicate := DuplicateTitle{TitleName: *title.TitleName, Kept: kept}
+		for _, entry := range group {
+			duplicate.Versions = append(duplicate.Versions, titleVersion(entry))
+		}
+		duplicates = append(duplicates, duplicate)
+	}
+	return unique, duplicates
+}
+
+// preferredEntry returns the index of the entry kept from entries sharing a title_name under policy.
+func preferredEntry(entries []Title, policy string) int {
+	switch policy {
+	case DuplicateTitlesPolicyLastWins:
+		return len(entries) - 1
+	case DuplicateTitlesPolicyHighestVersionWins:
+		best := 0
+		for i := 1; i < len(entries); i++ {
+			if compareTitleVersions(entries[i], entries[best]) > 0 {
+				best = i
+			}
+		}
+		return best
+	default:
+		return 0
+	}
+}
+
+// compareTitleVersions compares the title_version of two titles with versions.Compare. A title
+// without a version is older than any title with one.
+func compareTitleVersions(a, b Title) int {
+	switch {
+	case a.TitleVersion == nil && b.TitleVersion == nil:
+		return 0
+	case a.TitleVersion == nil:
+		return -1
+	case b.TitleVersion == nil:
+		return 1
+	}
+	return versions.Compare(*a.TitleVersion, *b.TitleVersion)
+}
+
+// logResolvedDuplicates logs which entry was kept for each duplicated title.
+func (c *Client) logResolvedDuplicates(ctx context.Context, duplicates []DuplicateTitle) {
+	if c.logger == nil {
+		return
+	}
+	for _, duplicate := range duplicates {
+		c.logger.LogAuth(ctx, "Resolved duplicate title", map[string]any{
+			"title_name":   duplicate.TitleName,
+			"versions":     duplicate.Versions,
+			"policy":       c.duplicatePolicy,
+			"kept_entry":   duplicate.Kept,
+			"kept_version": duplicate.Versions[duplicate.Kept],
+		})
+	}
+}
+
+// titleVersion returns the title_version of a title for display, or "unknown version" if it has none.
+func titleVersion(title Title) string {
+	if title.TitleVersion == nil {
+		return "unknown version"
+	}
+	return *title.TitleVersion
+}
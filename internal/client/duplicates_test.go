@@ -0,0 +1,131 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+const testDuplicateTitlesJSON = `[{"title_name":"GoogleChrome","title_version":"120.0"},{"title_name":"Firefox","title_version":"2.0"},{"title_name":"GoogleChrome","title_version":"121.0"}]`
+
+func TestResolveDuplicates_KeepsFirst(t *testing.T) {
+	titles := []Title{
+		{TitleName: new("GoogleChrome"), TitleVersion: new("120.0")},
+		{TitleName: new("Firefox")},
+		{},
+		{TitleName: new("GoogleChrome"), TitleVersion: new("121.0")},
+		{TitleName: new("Firefox"), TitleVersion: new("2.0")},
+		{},
+	}
+
+	unique, duplicates := resolveDuplicates(titles, DuplicateTitlesPolicyWarn)
+	if len(unique) != 4 {
+		t.Fatalf("expected 4 titles, got %d", len(unique))
+	}
+	if *unique[0].TitleVersion != "120.0" {
+		t.Errorf("expected the first GoogleChrome entry to be kept, got %s", *unique[0].TitleVersion)
+	}
+	if len(duplicates) != 2 {
+		t.Fatalf("expected 2 duplicated titles, got %v", duplicates)
+	}
+	if duplicates[0].TitleName != "GoogleChrome" || !slices.Equal(duplicates[0].Versions, []string{"120.0", "121.0"}) {
+		t.Errorf("unexpected duplicate %+v", duplicates[0])
+	}
+	if !slices.Equal(duplicates[1].Versions, []string{"unknown version", "2.0"}) {
+		t.Errorf("unexpected duplicate %+v", duplicates[1])
+	}
+}
+
+func TestResolveDuplicates_Precedence(t *testing.T) {
+	titles := []Title{
+		{TitleName: new("GoogleChrome"), TitleVersion: new("120.0.6099.10")},
+		{TitleName: new("Firefox"), TitleVersion: new("2.0")},
+		{TitleName: new("GoogleChrome"), TitleVersion: new("120.0.6099.9")},
+		{TitleName: new("GoogleChrome")},
+	}
+
+	tests := []struct {
+		policy string
+		kept   int
+		want   string
+	}{
+		{DuplicateTitlesPolicyWarn, 0, "120.0.6099.10"},
+		{DuplicateTitlesPolicyLastWins, 2, "unknown version"},
+		{DuplicateTitlesPolicyHighestVersionWins, 0, "120.0.6099.10"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.policy, func(t *testing.T) {
+			unique, duplicates := resolveDuplicates(titles, tt.policy)
+			if len(unique) != 2 || *unique[1].TitleName != "Firefox" {
+				t.Fatalf("expected titles to keep the position of their first entry, got %d titles", len(unique))
+			}
+			if len(duplicates) != 1 || duplicates[0].Kept != tt.kept {
+				t.Fatalf("expected entry %d to be kept, got %+v", tt.kept, duplicates)
+			}
+			if got := titleVersion(unique[0]); got != tt.want {
+				t.Errorf("expected version %s to be kept, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetTitles_DuplicatesResolvedByPrecedence(t *testing.T) {
+	c := NewClient("", writeTempFile(t, testDuplicateTitlesJSON))
+	if err := c.SetDuplicateTitlesPolicy(DuplicateTitlesPolicyHighestVersionWins); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	titles, source, err := c.GetTitlesWithSource(context.Background(), "GoogleChrome")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 || *titles[0].TitleVersion != "121.0" {
+		t.Errorf("expected the highest version to be kept, got %v", titles)
+	}
+	if len(source.Duplicates) != 0 {
+		t.Errorf("expected resolved duplicates not to be reported, got %v", source.Duplicates)
+	}
+}
+
+func TestGetTitles_DuplicatesFail(t *testing.T) {
+	c := NewClient("", writeTempFile(t, testDuplicateTitlesJSON))
+	_, err := c.GetTitles(context.Background(), "GoogleChrome")
+
+	dupErr, ok := errors.AsType[*DuplicateTitlesError](err)
+	if !ok {
+		t.Fatalf("expected DuplicateTitlesError, got %v", err)
+	}
+	if !strings.Contains(dupErr.Error(), "GoogleChrome (120.0, 121.0)") {
+		t.Errorf("expected both versions in error, got %q", dupErr.Error())
+	}
+}
+
+func TestGetTitlesWithSource_DuplicatesWarn(t *testing.T) {
+	c := NewClient("", writeTempFile(t, testDuplicateTitlesJSON))
+	if err := c.SetDuplicateTitlesPolicy(DuplicateTitlesPolicyWarn); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	titles, source, err := c.GetTitlesWithSource(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 2 || *titles[0].TitleVersion != "120.0" {
+		t.Errorf("expected the first entry of each title, got %d titles", len(titles))
+	}
+	if len(source.Duplicates) != 1 || source.Duplicates[0].TitleName != "GoogleChrome" {
+		t.Errorf("expected GoogleChrome to be reported as duplicated, got %v", source.Duplicates)
+	}
+}
+
+func TestSetDuplicateTitlesPolicy_Invalid(t *testing.T) {
+	c := NewClient("", "")
+	if err := c.SetDuplicateTitlesPolicy("ignore"); err == nil {
+		t.Error("expected error for unsupported policy")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SetFallbackDefinitionsFile configures a local definitions file that titles are read from when
+// the definitions API and every mirror fail with a connection error or a server error, for example
+// a nightly copy of the catalog kept for runners that lose internet access. Reads that the API
+// answers, including with client errors such as 404, never fall back.
+func (c *Client) SetFallbackDefinitionsFile(path string) {
+	c.fallbackFile = path
+}
+
+// fetchTitlesFromFallback reads titles from the fallback definitions file after the definitions
+// API failed with apiErr. The returned source records why the fallback was used.
+func (c *Client) fetchTitlesFromFallback(ctx context.Context, titleNames []string, apiErr error) ([]Title, Source, error) {
+	if c.logger != nil {
+		c.logger.LogAuth(ctx, "Definitions API unreachable, reading titles from fallback definitions file", map[string]any{
+			"definitions_file": c.fallbackFile,
+			"error":            apiErr.Error(),
+		})
+	}
+
+	titles, err := c.getTitlesFromFiles(ctx, c.fallbackFile, titleNames...)
+	_, partial := errors.AsType[*MalformedTitlesError](err)
+	_, notFound := errors.AsType[*TitlesNotFoundError](err)
+	if err != nil && !partial && !notFound {
+		return nil, Source{}, fmt.Errorf("%w; reading the fallback definitions file also failed: %w", apiErr, err)
+	}
+	return titles, Source{
+		Type:           SourceTypeFile,
+		Location:       c.fallbackFile,
+		FetchedAt:      time.Now(),
+		FallbackReason: apiErr.Error(),
+	}, err
+}
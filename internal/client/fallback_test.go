@@ -0,0 +1,79 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetTitles_FallbackOnConnectionError(t *testing.T) {
+	closed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	closed.Close()
+	fallback := writeTempFile(t, testMultipleTitlesJSON)
+
+	c := NewClient(closed.URL, "")
+	c.maxRetries = 0
+	c.SetFallbackDefinitionsFile(fallback)
+
+	titles, source, err := c.GetTitlesWithSource(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) == 0 {
+		t.Fatal("expected titles from the fallback file")
+	}
+	if source.Type != SourceTypeFile || source.Location != fallback {
+		t.Errorf("expected the fallback file as source, got %+v", source)
+	}
+	if source.FallbackReason == "" {
+		t.Error("expected the fallback reason to be recorded")
+	}
+}
+
+func TestGetTitles_NoFallbackOnClientError(t *testing.T) {
+	primary := newStatusServer(t, http.StatusNotFound, nil)
+
+	c := NewClient(primary.URL, "")
+	c.SetFallbackDefinitionsFile(writeTempFile(t, testMultipleTitlesJSON))
+
+	if _, err := c.GetTitles(context.Background(), "GoogleChrome"); err == nil {
+		t.Fatal("expected error")
+	}
+}
+
+func TestGetTitles_FallbackNotUsedWhenAPIServes(t *testing.T) {
+	var requests atomic.Int32
+	primary := newStatusServer(t, http.StatusOK, &requests)
+
+	c := NewClient(primary.URL, "")
+	c.SetFallbackDefinitionsFile(filepath.Join(t.TempDir(), "missing.json"))
+
+	_, source, err := c.GetTitlesWithSource(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.Type != SourceTypeURL || source.FallbackReason != "" {
+		t.Errorf("expected titles from the API, got %+v", source)
+	}
+}
+
+func TestGetTitles_FallbackFileFails(t *testing.T) {
+	closed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	closed.Close()
+
+	c := NewClient(closed.URL, "")
+	c.maxRetries = 0
+	c.SetFallbackDefinitionsFile(filepath.Join(t.TempDir(), "missing.json"))
+
+	_, err := c.GetTitles(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "fallback definitions file also failed") {
+		t.Errorf("expected both failures to be reported, got %v", err)
+	}
+}
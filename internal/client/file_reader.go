@@ -5,17 +5,17 @@ package client
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os"
-	"slices"
 )
 
-// getTitlesFromFile retrieves titles from a local JSON file.
-func (c *Client) getTitlesFromFile(ctx context.Context, titleNames ...string) ([]Title, error) {
+// getTitlesFromFile retrieves titles from the local JSON file at path. The file is decoded one title at a
+// time and reading stops as soon as ctx is cancelled or its deadline passes, so very large files
+// can be interrupted.
+func (c *Client) getTitlesFromFile(ctx context.Context, path string, titleNames ...string) ([]Title, error) {
 	if c.logger != nil {
 		fields := map[string]any{
-			"definitions_file": c.definitionsFile,
+			"definitions_file": path,
 		}
 		if len(titleNames) > 0 {
 			fields["requested_titles"] = titleNames
@@ -23,65 +23,15 @@ func (c *Client) getTitlesFromFile(ctx context.Context, titleNames ...string) ([
 		c.logger.LogAuth(ctx, "Reading titles from definitions file", fields)
 	}
 
-	file, err := os.Open(c.definitionsFile)
-	if err != nil {
-		return nil, fmt.Errorf("error opening definitions file: %w", err)
-	}
-	defer c.closeWithLog(ctx, file, "definitions file")
-
-	decoder := json.NewDecoder(file)
-
-	if len(titleNames) == 0 {
-		var titles []Title
-		if err := decoder.Decode(&titles); err != nil {
-			return nil, fmt.Errorf("error decoding definitions file: %w", err)
-		}
-		return titles, nil
-	}
-
-	wanted := make(map[string]struct{}, len(titleNames))
-	for _, name := range titleNames {
-		wanted[name] = struct{}{}
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("reading definitions file interrupted: %w", err)
 	}
 
-	token, err := decoder.Token()
+	file, err := os.Open(path)
 	if err != nil {
-		return nil, fmt.Errorf("error reading definitions file: %w", err)
-	}
-	delim, ok := token.(json.Delim)
-	if !ok || delim != '[' {
-		return nil, fmt.Errorf("definitions file must contain a JSON array of titles")
-	}
-
-	var titles []Title
-	for decoder.More() {
-		var title Title
-		if err := decoder.Decode(&title); err != nil {
-			return nil, fmt.Errorf("error decoding definitions file: %w", err)
-		}
-
-		if title.TitleName == nil {
-			continue
-		}
-
-		if _, ok := wanted[*title.TitleName]; ok {
-			titles = append(titles, title)
-			delete(wanted, *title.TitleName)
-
-			if len(wanted) == 0 {
-				break
-			}
-		}
-	}
-
-	if len(wanted) > 0 {
-		missing := make([]string, 0, len(wanted))
-		for name := range wanted {
-			missing = append(missing, name)
-		}
-		slices.Sort(missing)
-		return nil, &TitlesNotFoundError{MissingTitles: missing}
+		return nil, fmt.Errorf("error opening definitions file: %w", err)
 	}
+	defer c.closeWithLog(ctx, file, "definitions file")
 
-	return titles, nil
+	return c.decodeTitles(ctx, file, "definitions file", titleNames)
 }
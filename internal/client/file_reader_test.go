@@ -5,7 +5,9 @@ package client
 
 import (
 	"context"
+	"errors"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -42,6 +44,21 @@ func TestGetTitlesFromFile_AllTitles(t *testing.T) {
 	}
 }
 
+func TestGetTitlesWithSource_File(t *testing.T) {
+	path := writeTempFile(t, testMultipleTitlesJSON)
+	c := NewClient("", path)
+	_, source, err := c.GetTitlesWithSource(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.Type != SourceTypeFile || source.Location != path {
+		t.Errorf("expected file source %s, got %s %s", path, source.Type, source.Location)
+	}
+	if source.FetchedAt.IsZero() {
+		t.Error("expected fetched_at to be set")
+	}
+}
+
 func TestGetTitlesFromFile_SpecificTitles(t *testing.T) {
 	path := writeTempFile(t, testMultipleTitlesJSON)
 	c := NewClient("", path)
@@ -126,3 +143,30 @@ func TestGetTitlesFromFile_NullTitleName(t *testing.T) {
 		t.Errorf("expected AppA, got %s", *titles[0].TitleName)
 	}
 }
+
+func TestGetTitlesFromFile_ContextCancelled(t *testing.T) {
+	path := writeTempFile(t, testMultipleTitlesJSON)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	c := NewClient("", path)
+	_, err := c.GetTitles(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
+
+func TestContextReader_StopsWhenCancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	reader := &contextReader{ctx: ctx, r: strings.NewReader("abcdef")}
+
+	buf := make([]byte, 3)
+	if n, err := reader.Read(buf); err != nil || n != 3 {
+		t.Fatalf("expected 3 bytes before cancellation, got %d, %v", n, err)
+	}
+
+	cancel()
+	if _, err := reader.Read(buf); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled after cancellation, got %v", err)
+	}
+}
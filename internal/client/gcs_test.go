@@ -0,0 +1,67 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetTitles_GCS(t *testing.T) {
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "ya29.token")
+
+	var path, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+	t.Setenv("STORAGE_EMULATOR_HOST", server.Listener.Addr().String())
+
+	titles, err := NewClient("gs://catalogs/exports/definitions.json", "").GetTitles(context.Background(), "GoogleChrome")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 {
+		t.Errorf("expected the requested title to be filtered from the object, got %d titles", len(titles))
+	}
+	if path != "/catalogs/exports/definitions.json" {
+		t.Errorf("expected a request for the object, got %q", path)
+	}
+	if authorization != "Bearer ya29.token" {
+		t.Errorf("expected the access token, got %q", authorization)
+	}
+}
+
+func TestGetTitles_GCSForbidden(t *testing.T) {
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "ya29.expired")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+	t.Setenv("STORAGE_EMULATOR_HOST", server.URL)
+
+	_, err := NewClient("gs://catalogs/definitions.json", "").GetTitles(context.Background())
+	authErr, ok := errors.AsType[*AuthenticationError](err)
+	if !ok {
+		t.Fatalf("expected AuthenticationError, got %v", err)
+	}
+	if authErr.Mechanism != AuthMechanismGoogleAccessToken {
+		t.Errorf("expected google_access_token mechanism, got %q", authErr.Mechanism)
+	}
+}
+
+func TestNewGCSStore_Invalid(t *testing.T) {
+	for _, rawURL := range []string{"gs://catalogs", "gs:///definitions.json"} {
+		if _, err := NewClient("", "").openObjectStore(rawURL); err == nil {
+			t.Errorf("%s: expected error, got nil", rawURL)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"cmp"
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// Endpoints and scopes of the Google credential sources.
+const (
+	// googleStorageScope is the OAuth2 scope tokens are requested with, read-only Cloud Storage access.
+	googleStorageScope = "https://www.googleapis.com/auth/devstorage.read_only"
+	// defaultGoogleTokenURI is the token endpoint credentials files are exchanged at unless they name another.
+	defaultGoogleTokenURI = "https://oauth2.googleapis.com/token"
+	// defaultGCEMetadataHost is the Compute Engine metadata server, which serves tokens of the
+	// service account attached to Compute Engine, GKE, Cloud Run and Cloud Functions workloads.
+	defaultGCEMetadataHost = "metadata.google.internal"
+	// serviceAccountTokenLifetime is the lifetime requested for tokens of service account keys.
+	serviceAccountTokenLifetime = time.Hour
+)
+
+// resolveGoogleToken resolves a Google access token in the order of Application Default Credentials:
+//   - the access token in the GOOGLE_OAUTH_ACCESS_TOKEN environment variable, as printed by
+//     `gcloud auth print-access-token`
+//   - the credentials file named by GOOGLE_APPLICATION_CREDENTIALS
+//   - the credentials file written by `gcloud auth application-default login`
+//   - the metadata server at GCE_METADATA_HOST or its default address
+//
+// Credentials files hold either a user's refresh token or a service account key. It returns nil
+// when no credentials are configured.
+func resolveGoogleToken(ctx context.Context, httpClient *http.Client) (*cloudToken, error) {
+	if token := os.Getenv("GOOGLE_OAUTH_ACCESS_TOKEN"); token != "" {
+		return &cloudToken{accessToken: token}, nil
+	}
+
+	if path := os.Getenv("GOOGLE_APPLICATION_CREDENTIALS"); path != "" {
+		return googleCredentialsFileToken(ctx, httpClient, path)
+	}
+	if path := wellKnownGoogleCredentialsFile(); path != "" {
+		if _, err := os.Stat(path); err == nil {
+			return googleCredentialsFileToken(ctx, httpClient, path)
+		} else if !errors.Is(err, fs.ErrNotExist) {
+			return nil, fmt.Errorf("error reading Google credentials file: %w", err)
+		}
+	}
+
+	// Outside Google Cloud the metadata server is unreachable, which only means no credentials are configured.
+	token, err := gceMetadataToken(ctx, httpClient)
+	if err != nil {
+		return nil, nil
+	}
+	return token, nil
+}
+
+// wellKnownGoogleCredentialsFile returns the path of the credentials file written by
+// `gcloud auth application-default login`, in the directory named by CLOUDSDK_CONFIG or the
+// default gcloud configuration directory.
+func wellKnownGoogleCredentialsFile() string {
+	const name = "application_default_credentials.json"
+	if dir := os.Getenv("CLOUDSDK_CONFIG"); dir != "" {
+		return filepath.Join(dir, name)
+	}
+	if runtime.GOOS == "windows" {
+		if dir := os.Getenv("APPDATA"); dir != "" {
+			return filepath.Join(dir, "gcloud", name)
+		}
+		return ""
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".config", "gcloud", name)
+}
+
+// googleCredentialsFile is a Google credentials file holding a user's refresh token or a service
+// account key.
+type googleCredentialsFile struct {
+	Type string `json:"type"`
+	// ClientID, ClientSecret and RefreshToken are set for authorized_user credentials.
+	ClientID     string `json:"client_id"`
+	ClientSecret string `json:"client_secret"`
+	RefreshToken string `json:"refresh_token"`
+	// ClientEmail, PrivateKey and PrivateKeyID are set for service_account credentials.
+	ClientEmail  string `json:"client_email"`
+	PrivateKey   string `json:"private_key"`
+	PrivateKeyID string `json:"private_key_id"`
+	TokenURI     string `json:"token_uri"`
+}
+
+// googleCredentialsFileToken exchanges the credentials in the file at path for an access token.
+func googleCredentialsFileToken(ctx context.Context, httpClient *http.Client, path string) (*cloudToken, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading Google credentials file: %w", err)
+	}
+	var file googleCredentialsFile
+	if err := json.Unmarshal(content, &file); err != nil {
+		return nil, fmt.Errorf("error decoding Google credentials file %s: %w", path, err)
+	}
+
+	tokenURI := cmp.Or(file.TokenURI, defaultGoogleTokenURI)
+	form := url.Values{}
+	switch file.Type {
+	case "authorized_user":
+		if file.ClientID == "" || file.ClientSecret == "" || file.RefreshToken == "" {
+			return nil, fmt.Errorf("Google credentials file %s must set client_id, client_secret and refresh_token", path)
+		}
+		form.Set("grant_type", "refresh_token")
+		form.Set("client_id", file.ClientID)
+		form.Set("client_secret", file.ClientSecret)
+		form.Set("refresh_token", file.RefreshToken)
+	case "service_account":
+		assertion, err := serviceAccountAssertion(file, tokenURI, time.Now())
+		if err != nil {
+			return nil, fmt.Errorf("error signing with the service account key in %s: %w", path, err)
+		}
+		form.Set("grant_type", "urn:ietf:params:oauth:grant-type:jwt-bearer")
+		form.Set("assertion", assertion)
+	default:
+		return nil, fmt.Errorf("Google credentials file %s has unsupported type %q; use authorized_user or service_account credentials, or GOOGLE_OAUTH_ACCESS_TOKEN", path, file.Type)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("error creating Google token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var token tokenResponse
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return json.Unmarshal(body, &token) }); err != nil {
+		return nil, fmt.Errorf("error getting Google access token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("Google token response contains no access_token")
+	}
+	return &cloudToken{accessToken: token.AccessToken, expires: expiresIn(token.ExpiresIn)}, nil
+}
+
+// serviceAccountAssertion returns the signed JWT a service account key is exchanged for an access
+// token with at tokenURI, as described in RFC 7523.
+func serviceAccountAssertion(file googleCredentialsFile, tokenURI string, now time.Time) (string, error) {
+	if file.ClientEmail == "" || file.PrivateKey == "" {
+		return "", errors.New("service account credentials must set client_email and private_key")
+	}
+	block, _ := pem.Decode([]byte(file.PrivateKey))
+	if block == nil {
+		return "", errors.New("private_key is not PEM encoded")
+	}
+	var key *rsa.PrivateKey
+	if parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		rsaKey, ok := parsed.(*rsa.PrivateKey)
+		if !ok {
+			return "", errors.New("private_key is not an RSA key")
+		}
+		key = rsaKey
+	} else if key, err = x509.ParsePKCS1PrivateKey(block.Bytes); err != nil {
+		return "", fmt.Errorf("error parsing private_key: %w", err)
+	}
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT", "kid": file.PrivateKeyID})
+	if err != nil {
+		return "", err
+	}
+	claims, err := json.Marshal(map[string]any{
+		"iss":   file.ClientEmail,
+		"scope": googleStorageScope,
+		"aud":   tokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(serviceAccountTokenLifetime).Unix(),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return "", err
+	}
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+// gceMetadataToken returns a token of the default service account from the metadata server at
+// GCE_METADATA_HOST or its default address.
+func gceMetadataToken(ctx context.Context, httpClient *http.Client) (*cloudToken, error) {
+	host := cmp.Or(os.Getenv("GCE_METADATA_HOST"), defaultGCEMetadataHost)
+	endpoint := "http://" + host + "/computeMetadata/v1/instance/service-accounts/default/token?" +
+		url.Values{"scopes": {googleStorageScope}}.Encode()
+
+	probeCtx, cancel := context.WithTimeout(ctx, imdsProbeTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(probeCtx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating metadata server request: %w", err)
+	}
+	req.Header.Set("Metadata-Flavor", "Google")
+
+	var token tokenResponse
+	if err := doCredentialRequest(httpClient, req, func(body []byte) error { return json.Unmarshal(body, &token) }); err != nil {
+		return nil, fmt.Errorf("error getting metadata server token: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("metadata server token response contains no access_token")
+	}
+	return &cloudToken{accessToken: token.AccessToken, expires: expiresIn(token.ExpiresIn)}, nil
+}
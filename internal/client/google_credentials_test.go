@@ -0,0 +1,248 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// clearGoogleEnv unsets the environment variables that affect Google credential resolution, points
+// the gcloud configuration directory at an empty one and the metadata server at a closed port.
+func clearGoogleEnv(t *testing.T) {
+	t.Helper()
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "")
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", "")
+	t.Setenv("CLOUDSDK_CONFIG", t.TempDir())
+	t.Setenv("GCE_METADATA_HOST", "127.0.0.1:1")
+}
+
+// newGoogleTokenServer returns a token endpoint that records the form of each request and issues
+// the access token ya29.issued.
+func newGoogleTokenServer(t *testing.T, forms chan<- map[string]string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Errorf("failed to parse token request: %v", err)
+		}
+		form := make(map[string]string)
+		for name := range r.PostForm {
+			form[name] = r.PostForm.Get(name)
+		}
+		forms <- form
+		_, _ = w.Write([]byte(`{"access_token":"ya29.issued","token_type":"Bearer","expires_in":3599}`))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestResolveGoogleToken_Environment(t *testing.T) {
+	clearGoogleEnv(t)
+	t.Setenv("GOOGLE_OAUTH_ACCESS_TOKEN", "ya29.env")
+
+	token, err := resolveGoogleToken(context.Background(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == nil || token.accessToken != "ya29.env" {
+		t.Errorf("expected the environment token, got %+v", token)
+	}
+}
+
+func TestResolveGoogleToken_AuthorizedUser(t *testing.T) {
+	clearGoogleEnv(t)
+	forms := make(chan map[string]string, 1)
+	server := newGoogleTokenServer(t, forms)
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", writeTempFile(t, fmt.Sprintf(
+		`{"type":"authorized_user","client_id":"client","client_secret":"secret","refresh_token":"refresh","token_uri":%q}`, server.URL)))
+
+	token, err := resolveGoogleToken(context.Background(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == nil || token.accessToken != "ya29.issued" || token.expires.IsZero() {
+		t.Errorf("expected the issued token with an expiry, got %+v", token)
+	}
+	form := <-forms
+	if form["grant_type"] != "refresh_token" || form["refresh_token"] != "refresh" || form["client_id"] != "client" {
+		t.Errorf("unexpected token request: %v", form)
+	}
+}
+
+func TestResolveGoogleToken_ServiceAccount(t *testing.T) {
+	clearGoogleEnv(t)
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	privateKey := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+
+	forms := make(chan map[string]string, 1)
+	server := newGoogleTokenServer(t, forms)
+	file, err := json.Marshal(map[string]string{
+		"type":           "service_account",
+		"client_email":   "reader@project.iam.gserviceaccount.com",
+		"private_key":    privateKey,
+		"private_key_id": "key-1",
+		"token_uri":      server.URL,
+	})
+	if err != nil {
+		t.Fatalf("failed to marshal credentials: %v", err)
+	}
+	// The well-known file is used when GOOGLE_APPLICATION_CREDENTIALS is not set.
+	t.Setenv("CLOUDSDK_CONFIG", t.TempDir())
+	if err := os.WriteFile(wellKnownGoogleCredentialsFile(), file, 0o600); err != nil {
+		t.Fatalf("failed to write credentials file: %v", err)
+	}
+
+	token, err := resolveGoogleToken(context.Background(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == nil || token.accessToken != "ya29.issued" {
+		t.Errorf("expected the issued token, got %+v", token)
+	}
+
+	form := <-forms
+	if form["grant_type"] != "urn:ietf:params:oauth:grant-type:jwt-bearer" {
+		t.Errorf("unexpected grant type %q", form["grant_type"])
+	}
+	parts := strings.Split(form["assertion"], ".")
+	if len(parts) != 3 {
+		t.Fatalf("expected a signed JWT, got %q", form["assertion"])
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		t.Fatalf("failed to decode signature: %v", err)
+	}
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	if err := rsa.VerifyPKCS1v15(&key.PublicKey, crypto.SHA256, digest[:], signature); err != nil {
+		t.Errorf("assertion signature does not verify: %v", err)
+	}
+	claimsJSON, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		t.Fatalf("failed to decode claims: %v", err)
+	}
+	var claims map[string]any
+	if err := json.Unmarshal(claimsJSON, &claims); err != nil {
+		t.Fatalf("failed to unmarshal claims: %v", err)
+	}
+	if claims["iss"] != "reader@project.iam.gserviceaccount.com" || claims["aud"] != server.URL || claims["scope"] != googleStorageScope {
+		t.Errorf("unexpected claims: %v", claims)
+	}
+}
+
+func TestResolveGoogleToken_UnsupportedType(t *testing.T) {
+	clearGoogleEnv(t)
+	t.Setenv("GOOGLE_APPLICATION_CREDENTIALS", writeTempFile(t, `{"type":"external_account"}`))
+
+	if _, err := resolveGoogleToken(context.Background(), http.DefaultClient); err == nil || !strings.Contains(err.Error(), "external_account") {
+		t.Errorf("expected an unsupported type error, got %v", err)
+	}
+}
+
+func TestResolveGoogleToken_MetadataServer(t *testing.T) {
+	clearGoogleEnv(t)
+	var flavor, scopes string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flavor = r.Header.Get("Metadata-Flavor")
+		scopes = r.URL.Query().Get("scopes")
+		if r.URL.Path != "/computeMetadata/v1/instance/service-accounts/default/token" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(`{"access_token":"ya29.metadata","expires_in":3599,"token_type":"Bearer"}`))
+	}))
+	defer server.Close()
+	t.Setenv("GCE_METADATA_HOST", server.Listener.Addr().String())
+
+	token, err := resolveGoogleToken(context.Background(), http.DefaultClient)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token == nil || token.accessToken != "ya29.metadata" {
+		t.Errorf("expected the metadata server token, got %+v", token)
+	}
+	if flavor != "Google" || scopes != googleStorageScope {
+		t.Errorf("unexpected metadata request: Metadata-Flavor %q, scopes %q", flavor, scopes)
+	}
+}
+
+func TestResolveGoogleToken_None(t *testing.T) {
+	clearGoogleEnv(t)
+
+	token, err := resolveGoogleToken(context.Background(), http.DefaultClient)
+	if err != nil || token != nil {
+		t.Errorf("expected no token, got %+v, %v", token, err)
+	}
+}
+
+func TestGetTitles_GCSResolvesTokenOnce(t *testing.T) {
+	clearGoogleEnv(t)
+	var tokenRequests atomic.Int32
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenRequests.Add(1)
+		_, _ = w.Write([]byte(`{"access_token":"ya29.metadata","expires_in":3599}`))
+	}))
+	defer metadata.Close()
+	t.Setenv("GCE_METADATA_HOST", metadata.Listener.Addr().String())
+
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+	t.Setenv("STORAGE_EMULATOR_HOST", server.URL)
+
+	c := NewClient("gs://catalogs/definitions.json", "")
+	for range 2 {
+		if _, err := c.GetTitles(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := tokenRequests.Load(); got != 1 {
+		t.Errorf("expected the token to be resolved once per client, got %d requests", got)
+	}
+	if authorization != "Bearer ya29.metadata" {
+		t.Errorf("expected the metadata server token, got %q", authorization)
+	}
+}
+
+func TestCloudTokenCache_RefreshesExpiring(t *testing.T) {
+	var resolves atomic.Int32
+	cache := newCloudTokenCache(http.DefaultTransport, func(context.Context, *http.Client) (*cloudToken, error) {
+		resolves.Add(1)
+		return &cloudToken{accessToken: "token", expires: time.Now().Add(tokenRefreshMargin / 2)}, nil
+	})
+
+	for range 2 {
+		if _, err := cache.accessToken(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := resolves.Load(); got != 2 {
+		t.Errorf("expected a token about to expire to be resolved again, got %d resolves", got)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+)
+
+// HealthStatus describes the result of a definitions source health check.
+type HealthStatus struct {
+	Healthy    bool
+	Latency    time.Duration
+	StatusCode int
+	CheckedAt  time.Time
+	Message    string
+}
+
+// CheckHealth checks whether the definitions source is available. For a definitions URL it issues
+// a HEAD request, falling back to GET when HEAD is not supported, and reports the response latency.
+// For a definitions file it checks that every file it refers to exists and is a regular file, and
+// for an inline catalog that it is valid JSON. Failures are reported through the returned status
+// rather than as an error.
+func (c *Client) CheckHealth(ctx context.Context) HealthStatus {
+	checkedAt := time.Now()
+
+	if c.definitionsJSON != "" {
+		status := HealthStatus{Healthy: json.Valid([]byte(c.definitionsJSON)), CheckedAt: checkedAt}
+		if !status.Healthy {
+			status.Message = "definitions_json is not valid JSON"
+		}
+		return status
+	}
+
+	if c.definitionsFile != "" {
+		message := checkDefinitionsFiles(c.definitionsFile)
+		return HealthStatus{
+			Healthy:   message == "",
+			Latency:   time.Since(checkedAt),
+			CheckedAt: checkedAt,
+			Message:   message,
+		}
+	}
+
+	return c.checkURL(ctx, c.baseURL)
+}
+
+// CheckConnection behaves like CheckHealth, but when mirrors are configured it reports the first
+// healthy one, since reads fail over to mirrors. The status of the primary definitions URL is
+// returned when no mirror is healthy.
+func (c *Client) CheckConnection(ctx context.Context) HealthStatus {
+	status := c.CheckHealth(ctx)
+	if status.Healthy || c.definitionsJSON != "" || c.definitionsFile != "" {
+		return status
+	}
+	for _, mirror := range c.mirrors {
+		if mirrorStatus := c.checkURL(ctx, mirror); mirrorStatus.Healthy {
+			return mirrorStatus
+		}
+	}
+	return status
+}
+
+// checkDefinitionsFiles checks that the files matched by pattern exist and are regular files,
+// returning a description of the first problem found or an empty string.
+func checkDefinitionsFiles(pattern string) string {
+	paths, err := definitionsFilePaths(pattern)
+	if err != nil {
+		return err.Error()
+	}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Sprintf("definitions file is not accessible: %s", err)
+		}
+		if !info.Mode().IsRegular() {
+			return "definitions file is not a regular file"
+		}
+	}
+	return ""
+}
+
+// checkURL probes url with a HEAD request, falling back to GET when HEAD is not supported.
+func (c *Client) checkURL(ctx context.Context, url string) HealthStatus {
+	status := c.probe(ctx, http.MethodHead, url)
+	if status.StatusCode == http.StatusMethodNotAllowed || status.StatusCode == http.StatusNotImplemented {
+		status = c.probe(ctx, http.MethodGet, url)
+	}
+	return status
+}
+
+// probe issues a request with the given method against url and reports the time taken to
+// receive the response headers. The response body is not read.
+func (c *Client) probe(ctx context.Context, method, url string) HealthStatus {
+	// Time spent waiting for a request slot is not part of the latency.
+	release, err := c.acquireRequestSlot(ctx)
+	if err != nil {
+		return HealthStatus{CheckedAt: time.Now(), Message: err.Error()}
+	}
+	defer release()
+
+	status := HealthStatus{CheckedAt: time.Now()}
+
+	req, _, err := c.newBaseRequest(ctx, method, url, "")
+	if err != nil {
+		status.Message = err.Error()
+		return status
+	}
+
+	if c.logger != nil {
+		c.logger.LogRequest(ctx, req.Method, req.URL.String(), nil)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	status.Latency = time.Since(status.CheckedAt)
+	if err != nil {
+		status.Message = fmt.Sprintf("error making request: %s", err)
+		return status
+	}
+	defer c.closeWithLog(ctx, resp.Body, "response body")
+
+	if c.logger != nil {
+		c.logger.LogResponse(ctx, resp.StatusCode, resp.Header, nil)
+	}
+
+	status.StatusCode = resp.StatusCode
+	if resp.StatusCode >= http.StatusOK && resp.StatusCode < http.StatusBadRequest {
+		status.Healthy = true
+	} else {
+		status.Message = fmt.Sprintf("definitions endpoint returned status code: %d", resp.StatusCode)
+	}
+
+	return status
+}
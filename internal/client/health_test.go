@@ -0,0 +1,151 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCheckHealth_HTTPHealthy(t *testing.T) {
+	var method string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	status := c.CheckHealth(context.Background())
+	if !status.Healthy {
+		t.Fatalf("expected healthy status, got %+v", status)
+	}
+	if method != http.MethodHead {
+		t.Errorf("expected HEAD request, got %s", method)
+	}
+	if status.StatusCode != http.StatusOK {
+		t.Errorf("expected status code 200, got %d", status.StatusCode)
+	}
+	if status.CheckedAt.IsZero() {
+		t.Error("expected CheckedAt to be set")
+	}
+}
+
+func TestCheckHealth_HTTPFallsBackToGet(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		_, _ = w.Write([]byte("[]"))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	status := c.CheckHealth(context.Background())
+	if !status.Healthy {
+		t.Fatalf("expected healthy status, got %+v", status)
+	}
+	if len(methods) != 2 || methods[1] != http.MethodGet {
+		t.Errorf("expected HEAD then GET, got %v", methods)
+	}
+}
+
+func TestCheckHealth_HTTPUnhealthy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	status := c.CheckHealth(context.Background())
+	if status.Healthy {
+		t.Fatal("expected unhealthy status")
+	}
+	if status.StatusCode != http.StatusServiceUnavailable {
+		t.Errorf("expected status code 503, got %d", status.StatusCode)
+	}
+	if status.Message == "" {
+		t.Error("expected a message describing the failure")
+	}
+}
+
+func TestCheckHealth_HTTPUnreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	url := server.URL
+	server.Close()
+
+	c := NewClient(url, "")
+	status := c.CheckHealth(context.Background())
+	if status.Healthy {
+		t.Fatal("expected unhealthy status for unreachable endpoint")
+	}
+	if status.Message == "" {
+		t.Error("expected a message describing the failure")
+	}
+}
+
+func TestCheckHealth_File(t *testing.T) {
+	c := NewClient("", writeTempFile(t, "[]"))
+	status := c.CheckHealth(context.Background())
+	if !status.Healthy {
+		t.Fatalf("expected healthy status, got %+v", status)
+	}
+}
+
+func TestCheckHealth_FileMissing(t *testing.T) {
+	c := NewClient("", "/nonexistent/path/titles.json")
+	status := c.CheckHealth(context.Background())
+	if status.Healthy {
+		t.Fatal("expected unhealthy status for missing file")
+	}
+}
+
+func TestCheckHealth_FileIsDirectory(t *testing.T) {
+	c := NewClient("", t.TempDir())
+	status := c.CheckHealth(context.Background())
+	if status.Healthy {
+		t.Fatal("expected unhealthy status for directory")
+	}
+}
+
+func TestCheckConnection_HealthyMirror(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer mirror.Close()
+
+	c := NewClient(primary.URL, "")
+	c.SetMirrors(mirror.URL)
+	if status := c.CheckConnection(context.Background()); !status.Healthy {
+		t.Errorf("expected the healthy mirror to be reported, got %+v", status)
+	}
+}
+
+func TestCheckConnection_NoHealthyMirror(t *testing.T) {
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer primary.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer mirror.Close()
+
+	c := NewClient(primary.URL, "")
+	c.SetMirrors(mirror.URL)
+	status := c.CheckConnection(context.Background())
+	if status.Healthy {
+		t.Fatal("expected unhealthy status")
+	}
+	if status.StatusCode != http.StatusUnauthorized {
+		t.Errorf("expected the status of the primary URL, got %d", status.StatusCode)
+	}
+}
@@ -4,7 +4,9 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 )
@@ -40,3 +42,62 @@ func titlesMissing(titles []Title, requested []string) []string {
 
 	return missing
 }
+
+// apiErrorBody covers the JSON error payloads returned by the definitions API and common proxies:
+// {"message": ..., "code": ...}, {"error": "..."} and {"error": {"message": ..., "code": ...}}.
+type apiErrorBody struct {
+	Message string          `json:"message"`
+	Detail  string          `json:"detail"`
+	Code    json.RawMessage `json:"code"`
+	Error   json.RawMessage `json:"error"`
+}
+
+// parseAPIErrorBody extracts the server's error message and code from a JSON error payload.
+// Empty strings are returned when body is not a recognised JSON error.
+func parseAPIErrorBody(body []byte) (message, code string) {
+	var payload apiErrorBody
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return "", ""
+	}
+
+	message = payload.Message
+	if message == "" {
+		message = payload.Detail
+	}
+	code = rawJSONString(payload.Code)
+
+	if len(payload.Error) > 0 {
+		var nestedMessage, nestedCode string
+		if err := json.Unmarshal(payload.Error, &nestedMessage); err != nil {
+			nestedMessage, nestedCode = parseAPIErrorBody(payload.Error)
+		}
+		if message == "" {
+			message = nestedMessage
+		}
+		if code == "" {
+			code = nestedCode
+		}
+	}
+
+	return message, code
+}
+
+// rawJSONString returns a JSON string or number as text, or an empty string for any other value.
+func rawJSONString(raw json.RawMessage) string {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 {
+		return ""
+	}
+
+	var text string
+	if err := json.Unmarshal(raw, &text); err == nil {
+		return text
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(raw, &number); err == nil {
+		return number.String()
+	}
+
+	return ""
+}
@@ -59,3 +59,30 @@ func TestTitlesMissing_EmptyRequested(t *testing.T) {
 		t.Errorf("expected no missing titles, got %v", missing)
 	}
 }
+
+func TestParseAPIErrorBody(t *testing.T) {
+	tests := []struct {
+		name        string
+		body        string
+		wantMessage string
+		wantCode    string
+	}{
+		{"message and string code", `{"message":"catalog rebuilding","code":"CATALOG_UNAVAILABLE"}`, "catalog rebuilding", "CATALOG_UNAVAILABLE"},
+		{"numeric code", `{"message":"bad request","code":4001}`, "bad request", "4001"},
+		{"detail", `{"detail":"not allowed"}`, "not allowed", ""},
+		{"error string", `{"error":"upstream timeout"}`, "upstream timeout", ""},
+		{"nested error", `{"error":{"message":"quota exceeded","code":"QUOTA"}}`, "quota exceeded", "QUOTA"},
+		{"not json", `<html>oops</html>`, "", ""},
+		{"empty", ``, "", ""},
+		{"array", `[1,2]`, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			message, code := parseAPIErrorBody([]byte(tt.body))
+			if message != tt.wantMessage || code != tt.wantCode {
+				t.Errorf("expected (%q, %q), got (%q, %q)", tt.wantMessage, tt.wantCode, message, code)
+			}
+		})
+	}
+}
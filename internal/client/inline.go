@@ -0,0 +1,27 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// inlineLocation is the location reported for titles read from an inline catalog.
+const inlineLocation = "definitions_json"
+
+// NewInlineClient creates a client that reads titles from definitionsJSON, a catalog in the same
+// format as a definitions file, instead of making HTTP requests or reading files.
+func NewInlineClient(definitionsJSON string) *Client {
+	c := NewClient("", "")
+	c.definitionsJSON = definitionsJSON
+	return c
+}
+
+// getTitlesFromInline decodes titles from the inline catalog.
+func (c *Client) getTitlesFromInline(ctx context.Context, titleNames []string) ([]Title, Source, error) {
+	titles, err := c.decodeTitles(ctx, strings.NewReader(c.definitionsJSON), inlineLocation, titleNames)
+	return titles, Source{Type: SourceTypeInline, Location: inlineLocation, FetchedAt: time.Now()}, err
+}
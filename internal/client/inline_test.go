@@ -0,0 +1,53 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNewInlineClient_GetTitles(t *testing.T) {
+	c := NewInlineClient(testMultipleTitlesJSON)
+
+	titles, source, err := c.GetTitlesWithSource(context.Background(), "GoogleChrome")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 || *titles[0].TitleName != "GoogleChrome" {
+		t.Errorf("expected GoogleChrome, got %v", titles)
+	}
+	if source.Type != SourceTypeInline || source.Location != "definitions_json" {
+		t.Errorf("expected an inline source, got %+v", source)
+	}
+}
+
+func TestNewInlineClient_Catalog(t *testing.T) {
+	c := NewInlineClient(`{"schema_version": 2, "titles": []}`)
+
+	content, source, err := c.FetchCatalog(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != `{"schema_version": 2, "titles": []}` || source != "definitions_json" {
+		t.Errorf("expected the inline catalog, got %q from %q", content, source)
+	}
+
+	version, err := c.CatalogSchemaVersion(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != 2 {
+		t.Errorf("expected schema version 2, got %d", version)
+	}
+}
+
+func TestNewInlineClient_CheckHealth(t *testing.T) {
+	if status := NewInlineClient(testMultipleTitlesJSON).CheckHealth(context.Background()); !status.Healthy {
+		t.Errorf("expected healthy status, got %q", status.Message)
+	}
+	if status := NewInlineClient(`[{"title_name":`).CheckHealth(context.Background()); status.Healthy {
+		t.Error("expected invalid JSON to be unhealthy")
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"io"
+)
+
+// defaultMaxResponseSize is the largest response body the client reads before failing.
+const defaultMaxResponseSize = 256 << 20 // 256 MiB
+
+// bytesPerMB is the number of bytes in a megabyte as used by response size limits.
+const bytesPerMB = 1 << 20
+
+// ResponseTooLargeError is returned when a response body exceeds the client's maximum response size.
+type ResponseTooLargeError struct {
+	Limit int64
+}
+
+func (e *ResponseTooLargeError) Error() string {
+	if e.Limit%bytesPerMB == 0 {
+		return fmt.Sprintf("response exceeded %d MB", e.Limit/bytesPerMB)
+	}
+	return fmt.Sprintf("response exceeded %d bytes", e.Limit)
+}
+
+// SetMaxResponseSize sets the largest response body, in bytes, the client reads before failing with
+// a ResponseTooLargeError. A limit of zero disables the check.
+func (c *Client) SetMaxResponseSize(limit int64) {
+	c.maxResponseSize = limit
+}
+
+// limitReader is an io.ReadCloser that fails with a ResponseTooLargeError once more than limit
+// bytes have been read, protecting the provider from endpoints that return unbounded responses.
+type limitReader struct {
+	io.ReadCloser
+	limit     int64
+	remaining int64
+}
+
+// newLimitReader wraps rc so that reading more than limit bytes fails.
+func newLimitReader(rc io.ReadCloser, limit int64) *limitReader {
+	return &limitReader{ReadCloser: rc, limit: limit, remaining: limit}
+}
+
+// Read reads from the underlying reader, allowing one byte beyond the limit to detect oversized bodies.
+func (lr *limitReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > lr.remaining+1 {
+		p = p[:lr.remaining+1]
+	}
+	n, err := lr.ReadCloser.Read(p)
+	if int64(n) > lr.remaining {
+		n = int(lr.remaining)
+		lr.remaining = 0
+		return n, &ResponseTooLargeError{Limit: lr.limit}
+	}
+	lr.remaining -= int64(n)
+	return n, err
+}
@@ -0,0 +1,83 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestLimitReader_WithinLimit(t *testing.T) {
+	r := newLimitReader(io.NopCloser(strings.NewReader("hello")), 5)
+	data, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != "hello" {
+		t.Errorf("expected hello, got %q", data)
+	}
+}
+
+func TestLimitReader_ExceedsLimit(t *testing.T) {
+	r := newLimitReader(io.NopCloser(strings.NewReader("hello world")), 5)
+	data, err := io.ReadAll(r)
+	tooLarge, ok := errors.AsType[*ResponseTooLargeError](err)
+	if !ok {
+		t.Fatalf("expected ResponseTooLargeError, got %v", err)
+	}
+	if tooLarge.Limit != 5 {
+		t.Errorf("expected limit 5, got %d", tooLarge.Limit)
+	}
+	if string(data) != "hello" {
+		t.Errorf("expected only the first 5 bytes, got %q", data)
+	}
+}
+
+func TestResponseTooLargeError_Message(t *testing.T) {
+	if got := (&ResponseTooLargeError{Limit: 10 * bytesPerMB}).Error(); got != "response exceeded 10 MB" {
+		t.Errorf("unexpected message: %s", got)
+	}
+	if got := (&ResponseTooLargeError{Limit: 100}).Error(); got != "response exceeded 100 bytes" {
+		t.Errorf("unexpected message: %s", got)
+	}
+}
+
+func TestGetTitles_ResponseTooLarge(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	defer server.Close()
+
+	for _, logger := range []Logger{nil, &mockLogger{}} {
+		c := NewClient(server.URL, "")
+		c.SetLogger(logger)
+		c.SetMaxResponseSize(16)
+
+		_, err := c.GetTitles(context.Background())
+		if _, ok := errors.AsType[*ResponseTooLargeError](err); !ok {
+			t.Errorf("expected ResponseTooLargeError with logger %T, got %v", logger, err)
+		}
+	}
+}
+
+func TestGetTitles_ResponseSizeLimitDisabled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetMaxResponseSize(0)
+
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
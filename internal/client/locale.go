@@ -0,0 +1,31 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+)
+
+// localePattern matches the language tags accepted for the locale: a two or three letter language
+// code followed by optional script, region or variant subtags, such as "de", "pt-BR" or "zh-Hant-TW".
+var localePattern = regexp.MustCompile(`^[A-Za-z]{2,3}(-[A-Za-z0-9]{2,8})*$`)
+
+// SetLocale sets the language tag sent as the Accept-Language header on every request, so the
+// definitions server returns localized content where it has any. An empty locale sends no header.
+func (c *Client) SetLocale(locale string) error {
+	if locale != "" && !localePattern.MatchString(locale) {
+		return fmt.Errorf("locale must be a language tag such as \"en\" or \"de-CH\", got: %q", locale)
+	}
+	c.locale = locale
+	return nil
+}
+
+// setLocaleHeader adds the Accept-Language header for the configured locale to req.
+func (c *Client) setLocaleHeader(req *http.Request) {
+	if c.locale != "" {
+		req.Header.Set("Accept-Language", c.locale)
+	}
+}
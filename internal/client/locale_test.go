@@ -0,0 +1,77 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestSetLocale_AcceptLanguage(t *testing.T) {
+	var mu sync.Mutex
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		headers = append(headers, r.Header.Get("Accept-Language"))
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	if err := c.SetLocale("de-CH"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := c.GetTitles(context.Background(), "GoogleChrome"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c.CheckHealth(context.Background())
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(headers) < 2 {
+		t.Fatalf("expected a catalog and a health request, got %d requests", len(headers))
+	}
+	for i, header := range headers {
+		if header != "de-CH" {
+			t.Errorf("request %d: expected Accept-Language de-CH, got %q", i, header)
+		}
+	}
+}
+
+func TestSetLocale_Unset(t *testing.T) {
+	var header string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header.Get("Accept-Language")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(server.URL, "").GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if header != "" {
+		t.Errorf("expected no Accept-Language header, got %q", header)
+	}
+}
+
+func TestSetLocale_Invalid(t *testing.T) {
+	c := NewClient("", "")
+	for _, locale := range []string{"english", "en_US", "e", "en-", "en-US,fr"} {
+		if err := c.SetLocale(locale); err == nil {
+			t.Errorf("expected error for locale %q", locale)
+		}
+	}
+	for _, locale := range []string{"en", "pt-BR", "zh-Hant-TW", ""} {
+		if err := c.SetLocale(locale); err != nil {
+			t.Errorf("unexpected error for locale %q: %v", locale, err)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"sync"
+	"time"
+)
+
+// mirrorProbeTimeout bounds the health probes used to rank mirrors.
+const mirrorProbeTimeout = 5 * time.Second
+
+// SetMirrors configures additional definitions URLs that are tried, in order, after the base URL
+// when a request fails with a connection error or a server error.
+func (c *Client) SetMirrors(urls ...string) {
+	c.mirrorMu.Lock()
+	defer c.mirrorMu.Unlock()
+
+	c.mirrors = nil
+	c.mirrorOrder = nil
+	for _, u := range urls {
+		c.mirrors = append(c.mirrors, c.resolveBaseURL(u))
+	}
+}
+
+// baseURLs returns the base URLs in the order they should be tried. When mirrors are configured,
+// the first call probes every mirror and orders healthy mirrors by latency; the order is then kept
+// for the lifetime of the client so a run sticks with the fastest healthy mirror.
+func (c *Client) baseURLs(ctx context.Context) []string {
+	if len(c.mirrors) == 0 {
+		return []string{c.baseURL}
+	}
+
+	c.mirrorMu.Lock()
+	defer c.mirrorMu.Unlock()
+
+	if c.mirrorOrder == nil {
+		c.mirrorOrder = c.rankMirrors(ctx, append([]string{c.baseURL}, c.mirrors...))
+	}
+	return slices.Clone(c.mirrorOrder)
+}
+
+// rankMirrors probes bases concurrently and returns them with healthy mirrors first, fastest first.
+// Unhealthy mirrors keep their configured order at the end so they are still tried as a last resort.
+func (c *Client) rankMirrors(ctx context.Context, bases []string) []string {
+	probeCtx, cancel := context.WithTimeout(ctx, mirrorProbeTimeout)
+	defer cancel()
+
+	statuses := make([]HealthStatus, len(bases))
+	var wg sync.WaitGroup
+	for i, base := range bases {
+		wg.Go(func() {
+			statuses[i] = c.checkURL(probeCtx, base)
+		})
+	}
+	wg.Wait()
+
+	order := make([]int, len(bases))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		sa, sb := statuses[a], statuses[b]
+		switch {
+		case sa.Healthy && !sb.Healthy:
+			return -1
+		case !sa.Healthy && sb.Healthy:
+			return 1
+		case sa.Healthy:
+			return cmp.Compare(sa.Latency, sb.Latency)
+		default:
+			return 0
+		}
+	})
+
+	ranked := make([]string, len(order))
+	for i, idx := range order {
+		ranked[i] = bases[idx]
+		if c.logger != nil {
+			c.logger.LogAuth(ctx, "Ranked definitions mirror", map[string]any{
+				"mirror":     bases[idx],
+				"rank":       i + 1,
+				"healthy":    statuses[idx].Healthy,
+				"latency_ms": statuses[idx].Latency.Milliseconds(),
+			})
+		}
+	}
+	return ranked
+}
+
+// preferMirror moves base to the front of the mirror order so later requests in the run try it first.
+func (c *Client) preferMirror(base string) {
+	c.mirrorMu.Lock()
+	defer c.mirrorMu.Unlock()
+
+	if i := slices.Index(c.mirrorOrder, base); i > 0 {
+		c.mirrorOrder = slices.Insert(slices.Delete(c.mirrorOrder, i, i+1), 0, base)
+	}
+}
+
+// getFromMirrors performs a GET request for path against each base URL in turn, moving on to the
+// next mirror when the request fails with a connection error or a 5xx response. A mirror that serves
+// a request after an earlier one failed becomes the preferred mirror. It returns the response along
+// with the URL that served it.
+func (c *Client) getFromMirrors(ctx context.Context, path string) (*http.Response, string, error) {
+	bases := c.baseURLs(ctx)
+	var errs []error
+	for i, base := range bases {
+		url := base
+		if !isObjectURL(base) {
+			url += path
+		}
+		resp, err := c.get(ctx, base, path)
+		if err == nil {
+			if len(bases) > 1 {
+				if i > 0 {
+					c.preferMirror(base)
+				}
+				if c.logger != nil {
+					c.logger.LogAuth(ctx, "Definitions request served by mirror", map[string]any{
+						"mirror": base,
+					})
+				}
+			}
+			return resp, url, nil
+		}
+
+		if !shouldFailover(ctx, err) {
+			return nil, "", err
+		}
+		errs = append(errs, err)
+
+		if i < len(bases)-1 && c.logger != nil {
+			c.logger.LogAuth(ctx, "Definitions mirror failed, trying next mirror", map[string]any{
+				"mirror": base,
+				"error":  err.Error(),
+			})
+		}
+	}
+
+	if len(errs) == 1 {
+		return nil, "", errs[0]
+	}
+	return nil, "", fmt.Errorf("all %d definitions mirrors failed: %w", len(errs), errors.Join(errs...))
+}
+
+// shouldFailover reports whether a failed request should be attempted against the next mirror.
+// Connection errors and 5xx responses fail over; client errors such as 404 are returned as-is
+// because every mirror is expected to serve the same catalog.
+func shouldFailover(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if apiErr, ok := errors.AsType[*APIError](err); ok {
+		return apiErr.StatusCode >= http.StatusInternalServerError
+	}
+	return true
+}
@@ -0,0 +1,194 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newStatusServer starts a server that answers health probes successfully and GET requests with
+// status, counting GET requests in requests when it is not nil.
+func newStatusServer(t *testing.T, status int, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			return
+		}
+		if requests != nil {
+			requests.Add(1)
+		}
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestGetTitles_MirrorFailoverOnServerError(t *testing.T) {
+	primary := newStatusServer(t, http.StatusInternalServerError, nil)
+	mirror := newStatusServer(t, http.StatusOK, nil)
+
+	logger := &mockLogger{}
+	c := NewClient(primary.URL, "")
+	c.SetLogger(logger)
+	c.SetMirrors(mirror.URL)
+
+	titles, err := c.GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 {
+		t.Errorf("expected 1 title, got %d", len(titles))
+	}
+	if logger.messageCount == 0 {
+		t.Error("expected mirror selection to be logged")
+	}
+}
+
+func TestGetTitles_MirrorFailoverOnConnectionError(t *testing.T) {
+	closed := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	closed.Close()
+	mirror := newStatusServer(t, http.StatusOK, nil)
+
+	c := NewClient(closed.URL, "")
+	c.maxRetries = 0
+	c.SetMirrors(mirror.URL)
+
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestGetTitles_NoFailoverOnClientError(t *testing.T) {
+	primary := newStatusServer(t, http.StatusNotFound, nil)
+	var mirrorRequests atomic.Int32
+	// The mirror fails its health probe, so the primary is tried first whatever the probe latencies.
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodHead {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		mirrorRequests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	defer mirror.Close()
+
+	c := NewClient(primary.URL, "")
+	c.SetMirrors(mirror.URL)
+
+	if _, err := c.GetTitles(context.Background()); err == nil {
+		t.Fatal("expected error")
+	}
+	if mirrorRequests.Load() != 0 {
+		t.Errorf("expected mirror not to be tried, got %d requests", mirrorRequests.Load())
+	}
+}
+
+func TestGetTitles_AllMirrorsFail(t *testing.T) {
+	primary := newStatusServer(t, http.StatusInternalServerError, nil)
+	mirror := newStatusServer(t, http.StatusInternalServerError, nil)
+
+	c := NewClient(primary.URL, "")
+	c.SetMirrors(mirror.URL)
+
+	_, err := c.GetTitles(context.Background())
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "all 2 definitions mirrors failed") {
+		t.Errorf("unexpected error message: %v", err)
+	}
+	if _, ok := errors.AsType[*APIError](err); !ok {
+		t.Errorf("expected APIError in chain, got %T", err)
+	}
+}
+
+func TestGetTitles_PrefersHealthyMirror(t *testing.T) {
+	var primaryRequests, mirrorRequests atomic.Int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryRequests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+	mirror := newStatusServer(t, http.StatusOK, &mirrorRequests)
+
+	c := newFastRetryClient(primary)
+	c.SetMirrors(mirror.URL)
+
+	// Distinct reads, so the second is not served from the first.
+	for _, titleNames := range [][]string{nil, {"GoogleChrome"}} {
+		if _, err := c.GetTitles(context.Background(), titleNames...); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if primaryRequests.Load() != 1 {
+		t.Errorf("expected only the health probe to reach the unhealthy primary, got %d requests", primaryRequests.Load())
+	}
+	if mirrorRequests.Load() != 2 {
+		t.Errorf("expected 2 requests to the healthy mirror, got %d", mirrorRequests.Load())
+	}
+}
+
+func TestRankMirrors_OrdersHealthyByLatency(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(50 * time.Millisecond)
+	}))
+	defer slow.Close()
+	fast := newStatusServer(t, http.StatusOK, nil)
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+
+	c := NewClient("", "")
+	ranked := c.rankMirrors(context.Background(), []string{down.URL, slow.URL, fast.URL})
+
+	expected := []string{fast.URL, slow.URL, down.URL}
+	if !slices.Equal(ranked, expected) {
+		t.Errorf("expected %v, got %v", expected, ranked)
+	}
+}
+
+func TestPreferMirror(t *testing.T) {
+	c := NewClient("", "")
+	c.mirrorOrder = []string{"a", "b", "c"}
+
+	c.preferMirror("c")
+
+	if expected := []string{"c", "a", "b"}; !slices.Equal(c.mirrorOrder, expected) {
+		t.Errorf("expected %v, got %v", expected, c.mirrorOrder)
+	}
+}
+
+func TestShouldFailover(t *testing.T) {
+	ctx := context.Background()
+	if !shouldFailover(ctx, &APIError{StatusCode: http.StatusBadGateway}) {
+		t.Error("expected 502 to fail over")
+	}
+	if shouldFailover(ctx, &APIError{StatusCode: http.StatusForbidden}) {
+		t.Error("expected 403 not to fail over")
+	}
+	if !shouldFailover(ctx, errors.New("connection refused")) {
+		t.Error("expected connection errors to fail over")
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if shouldFailover(cancelled, errors.New("connection refused")) {
+		t.Error("expected no failover once the context is done")
+	}
+}
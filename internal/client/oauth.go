@@ -0,0 +1,147 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tokenRefreshMargin is how long before it expires a cached access token is replaced, so a token
+// does not expire while a request using it is in flight.
+const tokenRefreshMargin = 30 * time.Second
+
+// maxTokenResponseSize is the maximum number of bytes read from a token endpoint response.
+const maxTokenResponseSize = 1 << 20 // 1 MiB
+
+// tokenSource obtains OAuth2 access tokens with the client credentials grant and caches them until
+// shortly before they expire.
+type tokenSource struct {
+	tokenURL     string
+	clientID     string
+	clientSecret string
+	httpClient   *http.Client
+
+	mu     sync.Mutex
+	token  string
+	expiry time.Time
+}
+
+// tokenResponse is the successful response of an OAuth2 token endpoint.
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+}
+
+// tokenErrorResponse is the error response of an OAuth2 token endpoint.
+type tokenErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// SetOAuth2ClientCredentials configures the client to authenticate every request to the
+// definitions server with an access token obtained from tokenURL using the OAuth2 client
+// credentials grant. Tokens are cached and refreshed shortly before they expire, or after the
+// definitions server rejects one, in which case the rejected request is retried once with the new
+// token. Token requests appear in the debug dump without their response bodies, so tokens are
+// never written to it.
+func (c *Client) SetOAuth2ClientCredentials(tokenURL, clientID, clientSecret string) {
+	c.oauth = &tokenSource{
+		tokenURL:     tokenURL,
+		clientID:     clientID,
+		clientSecret: clientSecret,
+		httpClient:   &http.Client{Timeout: defaultHTTPTimeout, Transport: c.tokenTransport},
+	}
+}
+
+// accessToken returns a valid access token, requesting a new one when none is cached or the cached
+// token is about to expire.
+func (s *tokenSource) accessToken(ctx context.Context, logger Logger) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && (s.expiry.IsZero() || time.Now().Add(tokenRefreshMargin).Before(s.expiry)) {
+		return s.token, nil
+	}
+
+	if logger != nil {
+		logger.LogAuth(ctx, "Requesting OAuth2 access token", map[string]any{
+			"token_url": s.tokenURL,
+		})
+	}
+
+	token, err := s.requestToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = token.AccessToken
+	s.expiry = time.Time{}
+	if token.ExpiresIn > 0 {
+		s.expiry = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second)
+	}
+	return s.token, nil
+}
+
+// invalidate discards the cached access token, so the next request obtains a new one.
+func (s *tokenSource) invalidate() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = ""
+}
+
+// requestToken requests an access token from the token endpoint, authenticating with the client
+// credentials in the Authorization header as RFC 6749 recommends.
+func (s *tokenSource) requestToken(ctx context.Context) (tokenResponse, error) {
+	form := url.Values{"grant_type": {"client_credentials"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return tokenResponse{}, fmt.Errorf("error creating token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.SetBasicAuth(url.QueryEscape(s.clientID), url.QueryEscape(s.clientSecret))
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return tokenResponse{}, fmt.Errorf("error requesting access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxTokenResponseSize))
+	if err != nil {
+		return tokenResponse{}, fmt.Errorf("error reading token response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var tokenErr tokenErrorResponse
+		if json.Unmarshal(body, &tokenErr) == nil && tokenErr.Error != "" {
+			if tokenErr.ErrorDescription != "" {
+				return tokenResponse{}, fmt.Errorf("token endpoint responded with status code %d: %s: %s", resp.StatusCode, tokenErr.Error, tokenErr.ErrorDescription)
+			}
+			return tokenResponse{}, fmt.Errorf("token endpoint responded with status code %d: %s", resp.StatusCode, tokenErr.Error)
+		}
+		return tokenResponse{}, fmt.Errorf("token endpoint responded with status code %d", resp.StatusCode)
+	}
+
+	var token tokenResponse
+	if err := json.Unmarshal(body, &token); err != nil {
+		return tokenResponse{}, fmt.Errorf("error decoding token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return tokenResponse{}, fmt.Errorf("token response contains no access_token")
+	}
+	if token.TokenType != "" && !strings.EqualFold(token.TokenType, "bearer") {
+		return tokenResponse{}, fmt.Errorf("token endpoint issued an unsupported token type %q", token.TokenType)
+	}
+	return token, nil
+}
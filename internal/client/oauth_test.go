@@ -0,0 +1,231 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newTokenServer returns a token endpoint that issues a new numbered token on every request,
+// valid for expiresIn seconds.
+func newTokenServer(t *testing.T, expiresIn int, requests *atomic.Int32) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			t.Errorf("expected POST token request, got %s", r.Method)
+		}
+		if err := r.ParseForm(); err != nil || r.PostForm.Get("grant_type") != "client_credentials" {
+			t.Errorf("expected client_credentials grant, got %q", r.PostForm.Get("grant_type"))
+		}
+		if id, secret, ok := r.BasicAuth(); !ok || id != "terraform" || secret != "s3cr3t" {
+			t.Errorf("expected client credentials in basic auth, got %q %q", id, secret)
+		}
+		n := requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token":"token-%d","token_type":"Bearer","expires_in":%d}`, n, expiresIn)
+	}))
+}
+
+func TestSetOAuth2ClientCredentials_CachesToken(t *testing.T) {
+	var tokenRequests atomic.Int32
+	tokenServer := newTokenServer(t, 3600, &tokenRequests)
+	defer tokenServer.Close()
+
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get("Authorization"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetOAuth2ClientCredentials(tokenServer.URL, "terraform", "s3cr3t")
+
+	for range 2 {
+		if _, err := c.GetTitles(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	if got := tokenRequests.Load(); got != 1 {
+		t.Errorf("expected a single token request, got %d", got)
+	}
+	for i, header := range headers {
+		if header != "Bearer token-1" {
+			t.Errorf("request %d: expected cached bearer token, got %q", i, header)
+		}
+	}
+}
+
+func TestSetOAuth2ClientCredentials_RefreshesExpiringToken(t *testing.T) {
+	var tokenRequests atomic.Int32
+	tokenServer := newTokenServer(t, 10, &tokenRequests)
+	defer tokenServer.Close()
+
+	c := NewClient("https://example.com", "")
+	c.SetOAuth2ClientCredentials(tokenServer.URL, "terraform", "s3cr3t")
+
+	first, err := c.oauth.accessToken(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	// The token expires within the refresh margin, so it is replaced on the next request.
+	second, err := c.oauth.accessToken(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if first == second {
+		t.Errorf("expected an expiring token to be refreshed, got %q twice", first)
+	}
+}
+
+func TestSetOAuth2ClientCredentials_InvalidatedOnRejection(t *testing.T) {
+	var tokenRequests atomic.Int32
+	tokenServer := newTokenServer(t, 3600, &tokenRequests)
+	defer tokenServer.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetOAuth2ClientCredentials(tokenServer.URL, "terraform", "s3cr3t")
+
+	_, err := c.GetTitles(context.Background())
+	authErr, ok := errors.AsType[*AuthenticationError](err)
+	if !ok {
+		t.Fatalf("expected AuthenticationError, got %v", err)
+	}
+	if authErr.Mechanism != AuthMechanismOAuth2 {
+		t.Errorf("expected oauth2 mechanism, got %q", authErr.Mechanism)
+	}
+
+	// The rejected request is retried once with token-2, which is rejected as well.
+	if got := tokenRequests.Load(); got != 2 {
+		t.Errorf("expected a single retry with a new token, got %d token requests", got)
+	}
+	token, err := c.oauth.accessToken(context.Background(), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if token != "token-3" {
+		t.Errorf("expected a new token after rejection, got %q", token)
+	}
+}
+
+func TestSetOAuth2ClientCredentials_RetriesWithNewToken(t *testing.T) {
+	var tokenRequests atomic.Int32
+	tokenServer := newTokenServer(t, 3600, &tokenRequests)
+	defer tokenServer.Close()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		// The first token was revoked before it expired.
+		if r.Header.Get("Authorization") == "Bearer token-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetOAuth2ClientCredentials(tokenServer.URL, "terraform", "s3cr3t")
+
+	titles, err := c.GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 2 {
+		t.Errorf("expected 2 titles, got %d", len(titles))
+	}
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected the rejected request to be retried once, got %d requests", got)
+	}
+	if got := tokenRequests.Load(); got != 2 {
+		t.Errorf("expected a new token for the retry, got %d token requests", got)
+	}
+}
+
+func TestGet_NoTokenRetryWithoutOAuth(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetAuthToken("static")
+
+	if _, err := c.GetTitles(context.Background()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if got := requests.Load(); got != 1 {
+		t.Errorf("expected no retry for a static token, got %d requests", got)
+	}
+}
+
+func TestSetOAuth2ClientCredentials_TokenError(t *testing.T) {
+	tokenServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"error":"invalid_client","error_description":"unknown client"}`))
+	}))
+	defer tokenServer.Close()
+
+	requested := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetOAuth2ClientCredentials(tokenServer.URL, "terraform", "wrong")
+
+	_, err := c.GetTitles(context.Background())
+	if err == nil {
+		t.Fatal("expected error, got nil")
+	}
+	if !strings.Contains(err.Error(), "invalid_client: unknown client") {
+		t.Errorf("expected token endpoint error, got %v", err)
+	}
+	if requested {
+		t.Error("expected no request to the definitions server without a token")
+	}
+}
+
+func TestTokenSource_NoExpiry(t *testing.T) {
+	var tokenRequests atomic.Int32
+	tokenServer := newTokenServer(t, 0, &tokenRequests)
+	defer tokenServer.Close()
+
+	c := NewClient("https://example.com", "")
+	c.SetOAuth2ClientCredentials(tokenServer.URL, "terraform", "s3cr3t")
+
+	for range 2 {
+		if _, err := c.oauth.accessToken(context.Background(), nil); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if got := tokenRequests.Load(); got != 1 {
+		t.Errorf("expected a token without expires_in to be reused, got %d token requests", got)
+	}
+	if !c.oauth.expiry.Equal(time.Time{}) {
+		t.Errorf("expected no expiry, got %v", c.oauth.expiry)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// objectStore reads a catalog stored as a single object in cloud storage, rather than served by
+// the definitions API.
+type objectStore interface {
+	// newRequest returns an authenticated request with the given method for the catalog object.
+	newRequest(ctx context.Context, method string) (*http.Request, error)
+	// authMechanism reports how requests for the object are authenticated, one of the
+	// AuthMechanism constants.
+	authMechanism() string
+}
+
+// objectStores maps the URL schemes of cloud storage locations to the function that opens them.
+// Stores are opened for every request and take credentials cached across requests from c.
+var objectStores = map[string]func(c *Client, u *url.URL) (objectStore, error){
+	"s3":     newS3Store,
+	"gs":     newGCSStore,
+	"azblob": newAzblobStore,
+}
+
+// isObjectURL reports whether rawURL addresses a catalog object in cloud storage.
+func isObjectURL(rawURL string) bool {
+	scheme, _, ok := strings.Cut(rawURL, "://")
+	_, known := objectStores[scheme]
+	return ok && known
+}
+
+// openObjectStore returns the object store for a cloud storage URL.
+func (c *Client) openObjectStore(rawURL string) (objectStore, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid definitions URL: %w", err)
+	}
+	open, ok := objectStores[u.Scheme]
+	if !ok {
+		return nil, fmt.Errorf("unsupported definitions URL scheme %q", u.Scheme)
+	}
+	return open(c, u)
+}
+
+// newBaseRequest creates a request with the given method for path against base, which is either
+// the base URL of a definitions API or the URL of a catalog object in cloud storage, and reports
+// the authentication mechanism the request uses. An object holds the whole catalog, so path is
+// ignored for objects and callers filter the titles they need from the response.
+func (c *Client) newBaseRequest(ctx context.Context, method, base, path string) (*http.Request, string, error) {
+	if isObjectURL(base) {
+		store, err := c.openObjectStore(base)
+		if err != nil {
+			return nil, "", err
+		}
+		req, err := store.newRequest(ctx, method)
+		if err != nil {
+			return nil, "", err
+		}
+		c.setUserAgentHeader(req)
+		return req, store.authMechanism(), nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, base+path, nil)
+	if err != nil {
+		return nil, "", fmt.Errorf("error creating request: %w", err)
+	}
+	if err := c.setAuthHeader(ctx, req); err != nil {
+		return nil, "", err
+	}
+	c.setLocaleHeader(req)
+	c.setAcceptHeader(req)
+	c.setUserAgentHeader(req)
+	return req, c.authMechanism(), nil
+}
@@ -0,0 +1,28 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// ErrOffline is returned when a connection is attempted while the client is offline.
+var ErrOffline = errors.New("network access is disabled in offline mode")
+
+// SetOffline guarantees, when offline is true, that the client opens no network connections: every
+// connection attempt fails with ErrOffline before a name is resolved, and WithSource rejects
+// definitions URLs. Only definitions files and inline catalogs can be read offline.
+func (c *Client) SetOffline(offline bool) {
+	c.offline = offline
+}
+
+// dialContext dials addr with the client's dialer unless the client is offline.
+func (c *Client) dialContext(ctx context.Context, network, addr string) (net.Conn, error) {
+	if c.offline {
+		return nil, ErrOffline
+	}
+	return c.dialer.DialContext(ctx, network, addr)
+}
@@ -0,0 +1,57 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestSetOffline_RefusesConnections(t *testing.T) {
+	server, requests := newCountingServer(t, testMultipleTitlesJSON)
+
+	c := NewClient(server.URL, "")
+	c.maxRetries = 0
+	c.SetOffline(true)
+
+	if _, err := c.GetTitles(context.Background()); !errors.Is(err, ErrOffline) {
+		t.Errorf("expected ErrOffline, got %v", err)
+	}
+	if status := c.CheckHealth(context.Background()); status.Healthy {
+		t.Error("expected the health check to fail offline")
+	}
+	if n := requests.Load(); n != 0 {
+		t.Errorf("expected no requests, got %d", n)
+	}
+}
+
+func TestSetOffline_ReadsFiles(t *testing.T) {
+	c := NewClient("", writeTempFile(t, testMultipleTitlesJSON))
+	c.SetOffline(true)
+
+	titles, err := c.GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 2 {
+		t.Errorf("expected 2 titles, got %d", len(titles))
+	}
+}
+
+func TestSetOffline_WithSource(t *testing.T) {
+	c := NewClient("", writeTempFile(t, testMultipleTitlesJSON))
+	c.SetOffline(true)
+
+	if _, err := c.WithSource("https://example.com", ""); err == nil {
+		t.Error("expected an error overriding the source with a URL offline")
+	}
+	override, err := c.WithSource("", writeTempFile(t, testTitleJSON))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !override.offline {
+		t.Error("expected the override to stay offline")
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import "github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+
+// SetUninstallOverlay sets the overlay badge data sources draw onto uninstall icons. Passing nil
+// keeps the built-in overlay.
+func (c *Client) SetUninstallOverlay(o *imaging.Overlay) {
+	c.uninstallOverlay = o
+}
+
+// UninstallOverlay returns the overlay set with SetUninstallOverlay, or nil for the built-in overlay.
+func (c *Client) UninstallOverlay() *imaging.Overlay {
+	return c.uninstallOverlay
+}
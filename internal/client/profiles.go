@@ -0,0 +1,87 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"cmp"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// profileKeySuffix identifies the catalog keys that carry a configuration profile.
+const profileKeySuffix = "_profile"
+
+// knownProfileKeys lists the catalog profile keys that map onto dedicated Title fields.
+var knownProfileKeys = map[string]struct{}{
+	"content_filter_profile":      {},
+	"kernel_extension_profile":    {},
+	"managed_login_items_profile": {},
+	"notifications_profile":       {},
+	"pppcp_profile":               {},
+	"screen_recording_profile":    {},
+	"system_extension_profile":    {},
+}
+
+// UnknownProfile describes a profile key the provider does not recognise and the titles it appeared on.
+type UnknownProfile struct {
+	Key        string
+	TitleNames []string
+}
+
+// additionalProfiles returns the string valued profile keys of a raw title entry that do not map
+// onto a Title field, or nil if there are none. Entries that are not JSON objects have none.
+func additionalProfiles(raw json.RawMessage) map[string]string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+
+	var profiles map[string]string
+	for key, value := range fields {
+		if !strings.HasSuffix(key, profileKeySuffix) {
+			continue
+		}
+		if _, ok := knownProfileKeys[key]; ok {
+			continue
+		}
+		var content string
+		if err := json.Unmarshal(value, &content); err != nil {
+			continue
+		}
+		if profiles == nil {
+			profiles = make(map[string]string)
+		}
+		profiles[key] = content
+	}
+	return profiles
+}
+
+// unknownProfiles collects the additional profile keys of titles, sorted by key, along with the
+// names of the titles that carry each.
+func unknownProfiles(titles []Title) []UnknownProfile {
+	byKey := make(map[string][]string)
+	for _, title := range titles {
+		name := "unnamed title"
+		if title.TitleName != nil {
+			name = *title.TitleName
+		}
+		for key := range title.AdditionalProfiles {
+			byKey[key] = append(byKey[key], name)
+		}
+	}
+
+	if len(byKey) == 0 {
+		return nil
+	}
+
+	unknown := make([]UnknownProfile, 0, len(byKey))
+	for key, names := range byKey {
+		unknown = append(unknown, UnknownProfile{Key: key, TitleNames: names})
+	}
+	slices.SortFunc(unknown, func(a, b UnknownProfile) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return unknown
+}
@@ -0,0 +1,63 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+const testUnknownProfileJSON = `[
+	{"title_name": "GoogleChrome", "pppcp_profile": "cHBwY3A=", "dns_proxy_profile": "ZG5z", "relay_profile": "cmVsYXk="},
+	{"title_name": "Zoom", "dns_proxy_profile": "em9vbQ==", "icon_profile": 7},
+	{"title_name": "Firefox"}
+]`
+
+func TestGetTitlesWithSource_UnknownProfiles(t *testing.T) {
+	c := NewClient("", writeTempFile(t, testUnknownProfileJSON))
+
+	titles, source, err := c.GetTitlesWithSource(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if *titles[0].PPPCPProfile != "cHBwY3A=" {
+		t.Errorf("expected known profile to be decoded into its field, got %q", *titles[0].PPPCPProfile)
+	}
+	if _, ok := titles[0].AdditionalProfiles["pppcp_profile"]; ok {
+		t.Error("expected known profile not to be captured as additional")
+	}
+	if titles[0].AdditionalProfiles["dns_proxy_profile"] != "ZG5z" || titles[0].AdditionalProfiles["relay_profile"] != "cmVsYXk=" {
+		t.Errorf("expected unknown profiles to be captured, got %v", titles[0].AdditionalProfiles)
+	}
+	if len(titles[1].AdditionalProfiles) != 1 {
+		t.Errorf("expected non-string profile values to be ignored, got %v", titles[1].AdditionalProfiles)
+	}
+	if titles[2].AdditionalProfiles != nil {
+		t.Errorf("expected no additional profiles, got %v", titles[2].AdditionalProfiles)
+	}
+
+	if len(source.UnknownProfiles) != 2 {
+		t.Fatalf("expected 2 unknown profile keys, got %v", source.UnknownProfiles)
+	}
+	if got := source.UnknownProfiles[0]; got.Key != "dns_proxy_profile" || len(got.TitleNames) != 2 {
+		t.Errorf("expected dns_proxy_profile on both titles, got %v", got)
+	}
+	if got := source.UnknownProfiles[1]; got.Key != "relay_profile" || got.TitleNames[0] != "GoogleChrome" {
+		t.Errorf("expected relay_profile on GoogleChrome, got %v", got)
+	}
+}
+
+func TestGetTitlesWithSource_UnknownProfilesMalformedTitle(t *testing.T) {
+	c := NewClient("", writeTempFile(t, `[{"title_name": "Broken", "title_version": 1, "dns_proxy_profile": "ZG5z"}, {"title_name": "Zoom"}]`))
+
+	titles, _, err := c.GetTitlesWithSource(context.Background())
+	if _, ok := errors.AsType[*MalformedTitlesError](err); !ok {
+		t.Fatalf("expected MalformedTitlesError, got %v", err)
+	}
+	if len(titles) != 1 || *titles[0].TitleName != "Zoom" {
+		t.Errorf("expected the well formed title to be returned, got %d titles", len(titles))
+	}
+}
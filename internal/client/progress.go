@@ -0,0 +1,58 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+// progressInterval is the minimum time between progress log entries for long running operations.
+const progressInterval = 5 * time.Second
+
+// progressTracker rate limits progress log entries to one per interval.
+type progressTracker struct {
+	interval time.Duration
+	last     time.Time
+}
+
+// newProgressTracker returns a tracker whose first entry is due one interval from now, so
+// operations that finish quickly do not log progress at all.
+func newProgressTracker() *progressTracker {
+	return &progressTracker{interval: progressInterval, last: time.Now()}
+}
+
+// due reports whether a progress entry should be logged now, and if so starts the next interval.
+func (p *progressTracker) due() bool {
+	now := time.Now()
+	if now.Sub(p.last) < p.interval {
+		return false
+	}
+	p.last = now
+	return true
+}
+
+// progressReader is an io.ReadCloser that periodically logs the number of bytes read through it.
+type progressReader struct {
+	io.ReadCloser
+	ctx       context.Context
+	logger    Logger
+	source    string
+	bytesRead int64
+	progress  *progressTracker
+}
+
+// Read reads from the underlying reader, logging progress when an interval has elapsed.
+func (pr *progressReader) Read(p []byte) (int, error) {
+	n, err := pr.ReadCloser.Read(p)
+	pr.bytesRead += int64(n)
+	if pr.progress.due() {
+		pr.logger.LogAuth(pr.ctx, "Downloading definitions", map[string]any{
+			"source":     pr.source,
+			"bytes_read": pr.bytesRead,
+		})
+	}
+	return n, err
+}
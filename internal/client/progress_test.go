@@ -0,0 +1,49 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestProgressTracker_Due(t *testing.T) {
+	p := &progressTracker{interval: time.Hour, last: time.Now()}
+	if p.due() {
+		t.Error("expected progress not to be due before the interval elapses")
+	}
+
+	p = &progressTracker{interval: 0, last: time.Now()}
+	if !p.due() {
+		t.Error("expected progress to be due once the interval elapses")
+	}
+}
+
+func TestProgressReader_LogsBytesRead(t *testing.T) {
+	logger := &mockLogger{}
+	reader := &progressReader{
+		ReadCloser: io.NopCloser(strings.NewReader("0123456789")),
+		ctx:        context.Background(),
+		logger:     logger,
+		source:     "test",
+		progress:   &progressTracker{interval: 0, last: time.Now()},
+	}
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != "0123456789" {
+		t.Errorf("expected content to pass through unchanged, got %q", content)
+	}
+	if reader.bytesRead != 10 {
+		t.Errorf("expected 10 bytes read, got %d", reader.bytesRead)
+	}
+	if logger.messageCount == 0 {
+		t.Error("expected progress to be logged")
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"slices"
+
+	"golang.org/x/net/http/httpproxy"
+)
+
+// proxySchemes lists the URL schemes accepted by SetProxyURL.
+var proxySchemes = []string{"http", "https", "socks5"}
+
+// SetProxyURL routes requests to the definitions server and token endpoint through the proxy at
+// proxyURL instead of the proxy named by the HTTPS_PROXY and HTTP_PROXY environment variables,
+// which are used by default. Hosts listed in NO_PROXY are still reached directly.
+func (c *Client) SetProxyURL(proxyURL string) error {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return fmt.Errorf("proxy URL is not a valid URL: %w", err)
+	}
+	if !slices.Contains(proxySchemes, u.Scheme) || u.Host == "" {
+		return fmt.Errorf("proxy URL must be an absolute http, https or socks5 URL, got: %q", u.Redacted())
+	}
+
+	config := &httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    noProxyFromEnvironment(),
+	}
+	proxyFunc := config.ProxyFunc()
+	c.transport.Proxy = func(req *http.Request) (*url.URL, error) {
+		return proxyFunc(req.URL)
+	}
+	return nil
+}
+
+// noProxyFromEnvironment returns the value of the NO_PROXY environment variable, or its lowercase
+// form, as net/http reads it.
+func noProxyFromEnvironment() string {
+	if v := os.Getenv("NO_PROXY"); v != "" {
+		return v
+	}
+	return os.Getenv("no_proxy")
+}
@@ -0,0 +1,70 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSetProxyURL(t *testing.T) {
+	var requested string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = r.URL.Host
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer proxy.Close()
+	t.Setenv("NO_PROXY", "")
+	t.Setenv("no_proxy", "")
+
+	c := NewClient("http://definitions.example.com", "")
+	if err := c.SetProxyURL(proxy.URL); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requested != "definitions.example.com" {
+		t.Errorf("expected the request to be sent through the proxy, got a request for %q", requested)
+	}
+}
+
+func TestSetProxyURL_NoProxy(t *testing.T) {
+	t.Setenv("NO_PROXY", "definitions.example.com")
+
+	c := NewClient("http://definitions.example.com", "")
+	if err := c.SetProxyURL("http://proxy.example.com:3128"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for target, want := range map[string]string{
+		"https://definitions.example.com/titles": "",
+		"https://other.example.com/titles":       "http://proxy.example.com:3128",
+	} {
+		req := httptest.NewRequest(http.MethodGet, target, nil)
+		proxyURL, err := c.transport.Proxy(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		got := ""
+		if proxyURL != nil {
+			got = proxyURL.String()
+		}
+		if got != want {
+			t.Errorf("%s: expected proxy %q, got %q", target, want, got)
+		}
+	}
+}
+
+func TestSetProxyURL_Invalid(t *testing.T) {
+	for _, proxyURL := range []string{"proxy.example.com:3128", "ftp://proxy.example.com", "http://"} {
+		if err := NewClient("https://example.com", "").SetProxyURL(proxyURL); err == nil {
+			t.Errorf("%q: expected error, got nil", proxyURL)
+		}
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import "context"
+
+// refreshKeyContextKey is the context key under which WithRefreshKey stores its key.
+type refreshKeyContextKey struct{}
+
+// WithRefreshKey returns a context whose reads bypass responses cached for any other refresh key,
+// both those shared within the client and those in the on-disk response cache. Changing key
+// therefore forces the titles to be downloaded again, while reads repeating the same key keep
+// reusing their cached responses. An empty key leaves ctx unchanged.
+func WithRefreshKey(ctx context.Context, key string) context.Context {
+	if key == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, refreshKeyContextKey{}, key)
+}
+
+// refreshKey returns the refresh key of ctx, or an empty string when none is set.
+func refreshKey(ctx context.Context) string {
+	key, _ := ctx.Value(refreshKeyContextKey{}).(string)
+	return key
+}
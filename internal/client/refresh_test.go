@@ -0,0 +1,64 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestWithRefreshKey(t *testing.T) {
+	ctx := context.Background()
+	if got := WithRefreshKey(ctx, ""); got != ctx {
+		t.Error("expected an empty key to leave the context unchanged")
+	}
+	if got := refreshKey(WithRefreshKey(ctx, "2026-10-16")); got != "2026-10-16" {
+		t.Errorf("expected refresh key 2026-10-16, got %q", got)
+	}
+	if got := refreshKey(ctx); got != "" {
+		t.Errorf("expected no refresh key, got %q", got)
+	}
+}
+
+func TestGetTitles_RefreshKeyBypassesCaches(t *testing.T) {
+	server, requests := newCountingServer(t, testMultipleTitlesJSON)
+	dir := t.TempDir()
+
+	read := func(c *Client, key string) {
+		t.Helper()
+		if _, err := c.GetTitles(WithRefreshKey(context.Background(), key)); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+
+	c := NewClient(server.URL, "")
+	if err := c.SetCache(dir, time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	read(c, "one")
+	read(c, "one")
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected reads with the same refresh key to share one request, got %d", n)
+	}
+
+	read(c, "two")
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("expected a changed refresh key to bypass the shared read, got %d requests", n)
+	}
+
+	// A new client, as in a later plan, only has the on-disk cache to reuse.
+	later := NewClient(server.URL, "")
+	if err := later.SetCache(dir, time.Hour); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	read(later, "two")
+	if n := requests.Load(); n != 2 {
+		t.Fatalf("expected an unchanged refresh key to reuse the cached response, got %d requests", n)
+	}
+	read(later, "three")
+	if n := requests.Load(); n != 3 {
+		t.Errorf("expected a changed refresh key to bypass the response cache, got %d requests", n)
+	}
+}
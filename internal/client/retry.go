@@ -0,0 +1,187 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// Default retry policy for transient request failures.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryMinWait = 1 * time.Second
+	defaultRetryMaxWait = 30 * time.Second
+)
+
+// SetMaxRetries sets how many times a request that failed transiently is retried. Zero disables retries.
+func (c *Client) SetMaxRetries(n int) error {
+	if n < 0 {
+		return fmt.Errorf("must not be negative, got %d", n)
+	}
+	c.maxRetries = n
+	return nil
+}
+
+// SetRetryWait sets the bounds of the exponential backoff between retries: the first retry waits
+// up to minWait, and the wait doubles on each further retry up to maxWait. A nil bound keeps its
+// current value.
+func (c *Client) SetRetryWait(minWait, maxWait *time.Duration) error {
+	newMin, newMax := c.retryMinWait, c.retryMaxWait
+	if minWait != nil {
+		newMin = *minWait
+	}
+	if maxWait != nil {
+		newMax = *maxWait
+	}
+	if newMin < 0 || newMax < 0 {
+		return fmt.Errorf("retry waits must not be negative")
+	}
+	if newMin > newMax {
+		return fmt.Errorf("minimum wait %s must not be greater than maximum wait %s", newMin, newMax)
+	}
+	c.retryMinWait, c.retryMaxWait = newMin, newMax
+	return nil
+}
+
+// doWithRetry sends req, retrying transient failures with exponential backoff. Throttled requests
+// wait at least as long as the server's Retry-After header asks. Retries share the deadline of ctx
+// as their budget: a retry is only attempted if waiting for it would not pass the deadline, so
+// retries never extend a read beyond its configured timeout.
+func (c *Client) doWithRetry(ctx context.Context, req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.do(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+
+		if attempt >= c.maxRetries || !isRetryable(ctx, err) {
+			return nil, err
+		}
+
+		wait := c.backoff(attempt)
+		if rateLimited, ok := errors.AsType[*RateLimitedError](err); ok {
+			wait = max(wait, rateLimited.RetryAfter)
+		}
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			return nil, fmt.Errorf("%w (not retrying: the next attempt in %s would exceed the read timeout)", err, wait)
+		}
+
+		if c.logger != nil {
+			c.logger.LogAuth(ctx, "Retrying request after transient failure", map[string]any{
+				"attempt": attempt + 1,
+				"wait":    wait.String(),
+				"error":   err.Error(),
+			})
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, fmt.Errorf("%w (retry interrupted: %w)", err, ctx.Err())
+		case <-timer.C:
+		}
+	}
+}
+
+// backoff returns the wait before the retry following the given zero-based attempt. It applies
+// full jitter, choosing a random wait between zero and the exponential cap, so many clients
+// recovering from the same outage do not retry in lockstep.
+func (c *Client) backoff(attempt int) time.Duration {
+	ceiling := c.backoffCap(attempt)
+	if ceiling <= 0 {
+		return 0
+	}
+	return rand.N(ceiling + 1)
+}
+
+// backoffCap returns the exponential upper bound of the wait following the given zero-based attempt.
+func (c *Client) backoffCap(attempt int) time.Duration {
+	wait := c.retryMinWait
+	for range attempt {
+		wait *= 2
+		if wait >= c.retryMaxWait {
+			return c.retryMaxWait
+		}
+	}
+	return min(wait, c.retryMaxWait)
+}
+
+// parseRetryAfter returns the wait requested by a Retry-After header value, given either as a
+// number of seconds or as an HTTP date. Zero is returned for a missing, invalid or past value.
+func parseRetryAfter(value string, now time.Time) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return max(time.Duration(seconds)*time.Second, 0)
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(date.Sub(now), 0)
+	}
+	return 0
+}
+
+// isRetryable reports whether err is a transient failure worth retrying.
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	if _, ok := errors.AsType[*RateLimitedError](err); ok {
+		return true
+	}
+
+	if apiErr, ok := errors.AsType[*APIError](err); ok {
+		switch apiErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		default:
+			return false
+		}
+	}
+
+	return isTransientNetworkError(err)
+}
+
+// isTransientNetworkError reports whether err is a network failure or timeout that a later attempt
+// may not hit. Certificate verification failures, unresolvable hosts and invalid requests fail the
+// same way every time, so they are not retried.
+func isTransientNetworkError(err error) bool {
+	if _, ok := errors.AsType[*tls.CertificateVerificationError](err); ok {
+		return false
+	}
+	if _, ok := errors.AsType[x509.UnknownAuthorityError](err); ok {
+		return false
+	}
+	if _, ok := errors.AsType[x509.HostnameError](err); ok {
+		return false
+	}
+	if _, ok := errors.AsType[x509.CertificateInvalidError](err); ok {
+		return false
+	}
+
+	if dnsErr, ok := errors.AsType[*net.DNSError](err); ok {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	if netErr, ok := errors.AsType[net.Error](err); ok && netErr.Timeout() {
+		return true
+	}
+	if _, ok := errors.AsType[*net.OpError](err); ok {
+		return true
+	}
+
+	// The server closed the connection before or while sending the response.
+	return errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF)
+}
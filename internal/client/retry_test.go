@@ -0,0 +1,313 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// newFastRetryClient returns a client for server whose retry waits are short enough for tests.
+func newFastRetryClient(server *httptest.Server) *Client {
+	c := NewClient(server.URL, "")
+	c.retryMinWait = time.Millisecond
+	c.retryMaxWait = 5 * time.Millisecond
+	return c
+}
+
+func TestGetTitles_RetriesTransientFailures(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	defer server.Close()
+
+	c := newFastRetryClient(server)
+	titles, err := c.GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 {
+		t.Errorf("expected 1 title, got %d", len(titles))
+	}
+	if requests.Load() != 3 {
+		t.Errorf("expected 3 requests, got %d", requests.Load())
+	}
+}
+
+func TestGetTitles_StopsAfterMaxRetries(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer server.Close()
+
+	c := newFastRetryClient(server)
+	_, err := c.GetTitles(context.Background())
+	if err == nil {
+		t.Fatal("expected error after exhausting retries")
+	}
+	if got := requests.Load(); got != defaultMaxRetries+1 {
+		t.Errorf("expected %d requests, got %d", defaultMaxRetries+1, got)
+	}
+	apiErr, ok := errors.AsType[*APIError](err)
+	if !ok || apiErr.StatusCode != http.StatusBadGateway {
+		t.Errorf("expected APIError with status 502, got %v", err)
+	}
+}
+
+func TestGetTitles_DoesNotRetryClientErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := newFastRetryClient(server)
+	if _, err := c.GetTitles(context.Background()); err == nil {
+		t.Fatal("expected error for HTTP 500")
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", requests.Load())
+	}
+}
+
+func TestGetTitles_RetryRespectsDeadline(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.retryMinWait = 1000 * time.Hour
+	c.retryMaxWait = 1000 * time.Hour
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := c.GetTitles(ctx)
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if time.Since(start) > 4*time.Second {
+		t.Error("expected retry to be skipped rather than waiting past the deadline")
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected 1 request, got %d", requests.Load())
+	}
+	if !strings.Contains(err.Error(), "would exceed the read timeout") {
+		t.Errorf("expected error to explain the skipped retry, got %v", err)
+	}
+}
+
+func TestBackoffCap(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	c.retryMinWait = time.Second
+	c.retryMaxWait = 5 * time.Second
+
+	expected := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second, 5 * time.Second}
+	for attempt, want := range expected {
+		if got := c.backoffCap(attempt); got != want {
+			t.Errorf("attempt %d: expected %s, got %s", attempt, want, got)
+		}
+	}
+}
+
+func TestBackoff_FullJitter(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	c.retryMinWait = time.Second
+	c.retryMaxWait = 8 * time.Second
+
+	distinct := make(map[time.Duration]struct{})
+	for range 100 {
+		wait := c.backoff(2)
+		if wait < 0 || wait > 4*time.Second {
+			t.Fatalf("expected wait between 0 and 4s, got %s", wait)
+		}
+		distinct[wait] = struct{}{}
+	}
+	if len(distinct) < 2 {
+		t.Error("expected jittered waits to vary")
+	}
+}
+
+func TestBackoff_ZeroCap(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	c.retryMinWait = 0
+	if wait := c.backoff(3); wait != 0 {
+		t.Errorf("expected zero wait, got %s", wait)
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		err  error
+		want bool
+	}{
+		{"bad gateway", context.Background(), &APIError{StatusCode: http.StatusBadGateway}, true},
+		{"service unavailable", context.Background(), &APIError{StatusCode: http.StatusServiceUnavailable}, true},
+		{"gateway timeout", context.Background(), &APIError{StatusCode: http.StatusGatewayTimeout}, true},
+		{"not found", context.Background(), &APIError{StatusCode: http.StatusNotFound}, false},
+		{"rate limited", context.Background(), &RateLimitedError{Err: &APIError{StatusCode: http.StatusTooManyRequests}}, true},
+		{"unauthorized", context.Background(), &AuthenticationError{Mechanism: AuthMechanismNone, Err: &APIError{StatusCode: http.StatusUnauthorized}}, false},
+		{"connection reset", context.Background(), urlError(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}), true},
+		{"connection refused", context.Background(), urlError(&net.OpError{Op: "dial", Net: "tcp", Err: syscall.ECONNREFUSED}), true},
+		{"timeout", context.Background(), urlError(context.DeadlineExceeded), true},
+		{"connection closed", context.Background(), urlError(io.EOF), true},
+		{"temporary dns failure", context.Background(), urlError(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "server misbehaving", Name: "example.com", IsTemporary: true}}), true},
+		{"unknown host", context.Background(), urlError(&net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "example.com", IsNotFound: true}}), false},
+		{"untrusted certificate", context.Background(), urlError(&tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}), false},
+		{"hostname mismatch", context.Background(), urlError(x509.HostnameError{Host: "example.com"}), false},
+		{"expired certificate", context.Background(), urlError(x509.CertificateInvalidError{Reason: x509.Expired}), false},
+		{"unsupported scheme", context.Background(), urlError(errors.New(`unsupported protocol scheme "ftp"`)), false},
+		{"other error", context.Background(), errors.New("invalid request"), false},
+		{"cancelled context", cancelled, urlError(&net.OpError{Op: "read", Net: "tcp", Err: syscall.ECONNRESET}), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isRetryable(tt.ctx, tt.err); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// urlError wraps err the way http.Client.Do and Client.do report a failed request.
+func urlError(err error) error {
+	return fmt.Errorf("error making request: %w", &url.Error{Op: "Get", URL: "https://example.com/titles", Err: err})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		value string
+		want  time.Duration
+	}{
+		{"empty", "", 0},
+		{"seconds", "120", 2 * time.Minute},
+		{"negative seconds", "-5", 0},
+		{"http date", now.Add(30 * time.Second).Format(http.TimeFormat), 30 * time.Second},
+		{"past date", now.Add(-time.Hour).Format(http.TimeFormat), 0},
+		{"invalid", "soon", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value, now); got != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestGetTitles_RetriesRateLimitedRequests(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	defer server.Close()
+
+	c := newFastRetryClient(server)
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if requests.Load() != 2 {
+		t.Errorf("expected 2 requests, got %d", requests.Load())
+	}
+}
+
+func TestGetTitles_RateLimitedRetryAfterExceedsDeadline(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Retry-After", "3600")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	c := newFastRetryClient(server)
+	_, err := c.GetTitles(ctx)
+
+	rateLimited, ok := errors.AsType[*RateLimitedError](err)
+	if !ok {
+		t.Fatalf("expected RateLimitedError, got %v", err)
+	}
+	if rateLimited.RetryAfter != time.Hour {
+		t.Errorf("expected retry after 1h, got %s", rateLimited.RetryAfter)
+	}
+	if requests.Load() != 1 {
+		t.Errorf("expected no retry past the deadline, got %d requests", requests.Load())
+	}
+}
+
+func TestSetMaxRetries(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	if err := c.SetMaxRetries(0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.maxRetries != 0 {
+		t.Errorf("expected retries to be disabled, got %d", c.maxRetries)
+	}
+	if err := c.SetMaxRetries(-1); err == nil {
+		t.Error("expected error for negative retries, got nil")
+	}
+}
+
+func TestSetRetryWait(t *testing.T) {
+	c := NewClient("https://example.com", "")
+
+	if err := c.SetRetryWait(new(200*time.Millisecond), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.retryMinWait != 200*time.Millisecond || c.retryMaxWait != defaultRetryMaxWait {
+		t.Errorf("expected only the minimum wait to change, got %s and %s", c.retryMinWait, c.retryMaxWait)
+	}
+
+	if err := c.SetRetryWait(nil, new(100*time.Millisecond)); err == nil {
+		t.Error("expected error for a maximum wait below the minimum, got nil")
+	}
+	if c.retryMaxWait != defaultRetryMaxWait {
+		t.Errorf("expected a rejected wait to leave the policy unchanged, got %s", c.retryMaxWait)
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestNewS3Store(t *testing.T) {
+	clearAWSEnv(t)
+	t.Setenv("AWS_REGION", "eu-west-1")
+
+	tests := map[string]string{
+		"s3://catalogs/definitions.json":                     "https://catalogs.s3.eu-west-1.amazonaws.com/definitions.json",
+		"s3://catalogs/v1/definitions.json?region=us-west-2": "https://catalogs.s3.us-west-2.amazonaws.com/v1/definitions.json",
+		"s3://catalogs.example.com/definitions.json":         "https://s3.eu-west-1.amazonaws.com/catalogs.example.com/definitions.json",
+	}
+	for rawURL, want := range tests {
+		store, err := NewClient("", "").openObjectStore(rawURL)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", rawURL, err)
+		}
+		u, err := store.(*s3Store).objectURL()
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", rawURL, err)
+		}
+		if u.String() != want {
+			t.Errorf("%s: expected %s, got %s", rawURL, want, u)
+		}
+	}
+
+	for _, rawURL := range []string{"s3://catalogs", "s3:///definitions.json"} {
+		if _, err := NewClient("", "").openObjectStore(rawURL); err == nil {
+			t.Errorf("%s: expected error, got nil", rawURL)
+		}
+	}
+}
+
+func TestGetTitles_S3(t *testing.T) {
+	clearAWSEnv(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	var path, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.EscapedPath()
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_S3", server.URL)
+
+	c := NewClient("s3://catalogs/snapshots/definitions.json", "")
+	c.SetAuthToken("not-sent-to-s3")
+
+	titles, err := c.GetTitles(context.Background(), "GoogleChrome")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 || *titles[0].TitleName != "GoogleChrome" {
+		t.Errorf("expected the requested title to be filtered from the object, got %v", titles)
+	}
+	if path != "/catalogs/snapshots/definitions.json" {
+		t.Errorf("expected a path style request for the object, got %q", path)
+	}
+	if !strings.HasPrefix(authorization, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/") {
+		t.Errorf("expected a SigV4 signed request, got %q", authorization)
+	}
+}
+
+func TestGetTitles_S3Unsigned(t *testing.T) {
+	clearAWSEnv(t)
+
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authorization = r.Header.Get("Authorization")
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL", server.URL)
+
+	if _, err := NewClient("s3://catalogs/definitions.json", "").GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if authorization != "" {
+		t.Errorf("expected an unsigned request without credentials, got %q", authorization)
+	}
+}
+
+func TestGetTitles_S3Forbidden(t *testing.T) {
+	clearAWSEnv(t)
+	t.Setenv("AWS_ACCESS_KEY_ID", "AKIDEXAMPLE")
+	t.Setenv("AWS_SECRET_ACCESS_KEY", "secret")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_S3", server.URL)
+
+	_, err := NewClient("s3://catalogs/definitions.json", "").GetTitles(context.Background())
+	authErr, ok := errors.AsType[*AuthenticationError](err)
+	if !ok {
+		t.Fatalf("expected AuthenticationError, got %v", err)
+	}
+	if authErr.Mechanism != AuthMechanismAWSSigV4 {
+		t.Errorf("expected aws_sigv4 mechanism, got %q", authErr.Mechanism)
+	}
+}
+
+func TestCheckHealth_S3(t *testing.T) {
+	clearAWSEnv(t)
+
+	var method string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+	}))
+	defer server.Close()
+	t.Setenv("AWS_ENDPOINT_URL_S3", server.URL)
+
+	status := NewClient("s3://catalogs/definitions.json", "").CheckHealth(context.Background())
+	if !status.Healthy {
+		t.Errorf("expected healthy status, got %q", status.Message)
+	}
+	if method != http.MethodHead {
+		t.Errorf("expected a HEAD request for the object, got %s", method)
+	}
+}
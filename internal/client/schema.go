@@ -0,0 +1,164 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// DefaultSchemaVersion is the catalog schema version assumed for catalogs that do not declare one,
+// such as catalogs published as a bare JSON array of titles.
+const DefaultSchemaVersion = 2
+
+// titleDecoder decodes the next title entry of a catalog from decoder into title. Like
+// json.Decoder.Decode, it consumes the whole entry before reporting a *json.UnmarshalTypeError.
+type titleDecoder func(decoder *json.Decoder, title *Title) error
+
+// titleDecoders maps each catalog schema version the client can parse to the decoder for its title
+// entries. Support for a new schema version is added by registering its decoder here.
+var titleDecoders = map[int]titleDecoder{
+	2: decodeTitleV2,
+}
+
+// decodeTitleV2 decodes a schema version 2 title entry, whose fields map directly onto Title.
+// Profile keys without a dedicated field are kept in AdditionalProfiles rather than dropped.
+func decodeTitleV2(decoder *json.Decoder, title *Title) error {
+	var raw json.RawMessage
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+
+	err := json.Unmarshal(raw, title)
+	title.AdditionalProfiles = additionalProfiles(raw)
+	return err
+}
+
+// SupportedSchemaVersions returns the catalog schema versions the client can parse, in ascending order.
+func SupportedSchemaVersions() []int {
+	return slices.Sorted(maps.Keys(titleDecoders))
+}
+
+// titleDecoderFor returns the title decoder for a catalog schema version, or an
+// UnsupportedSchemaVersionError if the client cannot parse it.
+func titleDecoderFor(version int) (titleDecoder, error) {
+	decode, ok := titleDecoders[version]
+	if !ok {
+		return nil, &UnsupportedSchemaVersionError{Version: version, Supported: SupportedSchemaVersions()}
+	}
+	return decode, nil
+}
+
+// UnsupportedSchemaVersionError is returned when a catalog declares a schema version the client
+// cannot parse, rather than risk mapping its fields incorrectly.
+type UnsupportedSchemaVersionError struct {
+	Version   int
+	Supported []int
+}
+
+// Error returns a formatted string naming the declared and supported schema versions.
+func (e *UnsupportedSchemaVersionError) Error() string {
+	return fmt.Sprintf("catalog schema version %d is not supported (supported versions: %s)", e.Version, joinVersions(e.Supported))
+}
+
+// joinVersions formats versions as a comma-separated list.
+func joinVersions(versions []int) string {
+	formatted := make([]string, 0, len(versions))
+	for _, version := range versions {
+		formatted = append(formatted, strconv.Itoa(version))
+	}
+	return strings.Join(formatted, ", ")
+}
+
+// CatalogSchemaVersion reports the schema version declared by the catalog. Catalogs wrapped in an
+// object declare it in a top-level "schema_version" key; bare arrays of titles and wrapper objects
+// without the key report DefaultSchemaVersion. When the definitions file setting matches several
+// files, the lowest version declared by any of them is reported.
+func (c *Client) CatalogSchemaVersion(ctx context.Context) (int, error) {
+	if c.definitionsJSON != "" {
+		return readSchemaVersion(json.NewDecoder(strings.NewReader(c.definitionsJSON)))
+	}
+	if c.definitionsFile != "" {
+		paths, err := definitionsFilePaths(c.definitionsFile)
+		if err != nil {
+			return 0, err
+		}
+		lowest := 0
+		for i, path := range paths {
+			version, err := c.fileSchemaVersion(ctx, path)
+			if err != nil {
+				return 0, err
+			}
+			if i == 0 || version < lowest {
+				lowest = version
+			}
+		}
+		return lowest, nil
+	}
+
+	resp, _, err := c.getFromMirrors(ctx, "")
+	if err != nil {
+		return 0, err
+	}
+	defer c.closeWithLog(ctx, resp.Body, "catalog")
+
+	return readSchemaVersion(json.NewDecoder(resp.Body))
+}
+
+// fileSchemaVersion reports the schema version declared by the definitions file at path.
+func (c *Client) fileSchemaVersion(ctx context.Context, path string) (int, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return 0, fmt.Errorf("error opening definitions file: %w", err)
+	}
+	defer c.closeWithLog(ctx, file, "definitions file")
+
+	return readSchemaVersion(json.NewDecoder(file))
+}
+
+// readSchemaVersion reads the schema version from the start of a catalog document.
+func readSchemaVersion(decoder *json.Decoder) (int, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return 0, fmt.Errorf("error reading catalog: %w", err)
+	}
+
+	delim, ok := token.(json.Delim)
+	if !ok || (delim != '[' && delim != '{') {
+		return 0, fmt.Errorf("catalog must contain a JSON array or object")
+	}
+	if delim == '[' {
+		return DefaultSchemaVersion, nil
+	}
+
+	for decoder.More() {
+		keyToken, err := decoder.Token()
+		if err != nil {
+			return 0, fmt.Errorf("error reading catalog: %w", err)
+		}
+
+		key, _ := keyToken.(string)
+		if key != "schema_version" {
+			var skipped json.RawMessage
+			if err := decoder.Decode(&skipped); err != nil {
+				return 0, fmt.Errorf("error reading catalog: %w", err)
+			}
+			continue
+		}
+
+		var version int
+		if err := decoder.Decode(&version); err != nil {
+			return 0, fmt.Errorf("error decoding catalog schema_version: %w", err)
+		}
+		return version, nil
+	}
+
+	return DefaultSchemaVersion, nil
+}
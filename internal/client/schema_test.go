@@ -0,0 +1,95 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCatalogSchemaVersion_BareArray(t *testing.T) {
+	path := writeTempFile(t, testMultipleTitlesJSON)
+	c := NewClient("", path)
+	version, err := c.CatalogSchemaVersion(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != DefaultSchemaVersion {
+		t.Errorf("expected %d, got %d", DefaultSchemaVersion, version)
+	}
+}
+
+func TestCatalogSchemaVersion_WrapperObject(t *testing.T) {
+	path := writeTempFile(t, `{"titles":`+testMultipleTitlesJSON+`,"schema_version":3}`)
+	c := NewClient("", path)
+	version, err := c.CatalogSchemaVersion(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != 3 {
+		t.Errorf("expected 3, got %d", version)
+	}
+}
+
+func TestCatalogSchemaVersion_WrapperWithoutVersion(t *testing.T) {
+	path := writeTempFile(t, `{"titles":[]}`)
+	c := NewClient("", path)
+	version, err := c.CatalogSchemaVersion(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != DefaultSchemaVersion {
+		t.Errorf("expected %d, got %d", DefaultSchemaVersion, version)
+	}
+}
+
+func TestCatalogSchemaVersion_InvalidVersion(t *testing.T) {
+	path := writeTempFile(t, `{"schema_version":"three"}`)
+	c := NewClient("", path)
+	_, err := c.CatalogSchemaVersion(context.Background())
+	if err == nil {
+		t.Fatal("expected error for non-numeric schema_version")
+	}
+}
+
+func TestCatalogSchemaVersion_NotJSONContainer(t *testing.T) {
+	path := writeTempFile(t, `"catalog"`)
+	c := NewClient("", path)
+	_, err := c.CatalogSchemaVersion(context.Background())
+	if err == nil {
+		t.Fatal("expected error for scalar catalog")
+	}
+}
+
+func TestCatalogSchemaVersion_HTTP(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"schema_version":2,"titles":[]}`))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	version, err := c.CatalogSchemaVersion(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if version != 2 {
+		t.Errorf("expected 2, got %d", version)
+	}
+}
+
+func TestCatalogSchemaVersion_HTTPError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	_, err := c.CatalogSchemaVersion(context.Background())
+	if err == nil {
+		t.Fatal("expected error for HTTP 500")
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// titlesCall is a read of titles shared by every caller requesting the same titles. done is
+// closed once titles, source and err are set.
+type titlesCall struct {
+	done   chan struct{}
+	titles []Title
+	source Source
+	err    error
+}
+
+// sharedTitles holds the reads of a client, so data sources reading the same titles during one
+// plan share a single request. Completed reads stay cached for the lifetime of the client unless
+// they failed, separately for each refresh key.
+type sharedTitles struct {
+	mu    sync.Mutex
+	calls map[string]*titlesCall
+}
+
+// fetchTitlesShared behaves like fetchTitles, but waits for an identical read that is already in
+// flight instead of starting another one, and reuses the result of an earlier successful read with
+// the same refresh key.
+func (c *Client) fetchTitlesShared(ctx context.Context, titleNames []string) ([]Title, Source, error) {
+	key := refreshKey(ctx) + "\x00" + strings.Join(titleNames, "\n")
+	for {
+		c.shared.mu.Lock()
+		call, ok := c.shared.calls[key]
+		if !ok {
+			call = &titlesCall{done: make(chan struct{})}
+			if c.shared.calls == nil {
+				c.shared.calls = make(map[string]*titlesCall)
+			}
+			c.shared.calls[key] = call
+			c.shared.mu.Unlock()
+
+			call.titles, call.source, call.err = c.fetchTitles(ctx, titleNames)
+			if !cacheableDecodeError(call.err) {
+				c.shared.mu.Lock()
+				delete(c.shared.calls, key)
+				c.shared.mu.Unlock()
+			}
+			close(call.done)
+			return slices.Clone(call.titles), call.source, call.err
+		}
+		c.shared.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return nil, Source{}, ctx.Err()
+		}
+		// A read abandoned by its own caller says nothing about this one, so try again.
+		if ctx.Err() == nil && (errors.Is(call.err, context.Canceled) || errors.Is(call.err, context.DeadlineExceeded)) {
+			continue
+		}
+		return slices.Clone(call.titles), call.source, call.err
+	}
+}
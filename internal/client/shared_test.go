@@ -0,0 +1,137 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestGetTitles_CoalescesConcurrentReads(t *testing.T) {
+	var requests atomic.Int32
+	started := make(chan struct{}, 1)
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+
+	var wg sync.WaitGroup
+	errs := make(chan error, 10)
+	for range 10 {
+		wg.Go(func() {
+			titles, err := c.GetTitles(context.Background())
+			if err == nil && len(titles) != 2 {
+				t.Errorf("expected 2 titles, got %d", len(titles))
+			}
+			errs <- err
+		})
+	}
+	// Hold the response until a read reaches the server, so the others wait on it.
+	<-started
+	close(release)
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("expected one request, got %d", n)
+	}
+}
+
+func TestGetTitles_ReusesSuccessfulRead(t *testing.T) {
+	server, requests := newCountingServer(t, testMultipleTitlesJSON)
+	c := NewClient(server.URL, "")
+
+	for range 2 {
+		if _, err := c.GetTitles(context.Background()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := c.GetTitles(context.Background(), "Firefox"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected one request per distinct read, got %d", n)
+	}
+}
+
+func TestGetTitles_RefreshKeyBypassesSuccessfulRead(t *testing.T) {
+	server, requests := newCountingServer(t, testMultipleTitlesJSON)
+	c := NewClient(server.URL, "")
+
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for range 2 {
+		if _, err := c.GetTitles(WithRefreshKey(context.Background(), "refresh")); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected one request per refresh key, got %d", n)
+	}
+}
+
+func TestGetTitles_RetriesFailedRead(t *testing.T) {
+	server, requests := newCountingServer(t, "{not json")
+	c := NewClient(server.URL, "")
+
+	for range 2 {
+		if _, err := c.GetTitles(context.Background()); err == nil {
+			t.Fatal("expected a decode error")
+		}
+	}
+
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected failed reads not to be reused, got %d requests", n)
+	}
+}
+
+func TestGetTitles_WithSourceDoesNotShareReads(t *testing.T) {
+	first, firstRequests := newCountingServer(t, testMultipleTitlesJSON)
+	second, secondRequests := newCountingServer(t, testTitleJSON)
+
+	c := NewClient(first.URL, "")
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	override, err := c.WithSource(second.URL, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	titles, err := override.GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(titles) != 1 {
+		t.Errorf("expected the title of the overriding source, got %d titles", len(titles))
+	}
+	if firstRequests.Load() != 1 || secondRequests.Load() != 1 {
+		t.Errorf("expected one request per source, got %d and %d", firstRequests.Load(), secondRequests.Load())
+	}
+}
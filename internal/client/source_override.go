@@ -0,0 +1,58 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"strings"
+)
+
+// WithSource returns a client that reads titles from baseURL or, when definitionsFile is not empty,
+// from definitionsFile, and otherwise shares the configuration and connections of c. Mirrors are not
+// carried over. Unix socket URLs are not supported, since sockets are registered on the transport
+// shared with c.
+func (c *Client) WithSource(baseURL, definitionsFile string) (*Client, error) {
+	if baseURL == "" && definitionsFile == "" {
+		return nil, errors.New("a definitions URL or file is required to override the definitions source")
+	}
+	if c.offline && definitionsFile == "" {
+		return nil, errors.New("definitions URLs cannot be used to override the definitions source in offline mode")
+	}
+	if strings.HasPrefix(baseURL, unixScheme) {
+		return nil, errors.New("unix socket URLs cannot be used to override the definitions source")
+	}
+
+	return &Client{
+		baseURL:          baseURL,
+		definitionsFile:  definitionsFile,
+		httpClient:       c.httpClient,
+		transport:        c.transport,
+		tokenTransport:   c.tokenTransport,
+		dialer:           c.dialer,
+		logger:           c.logger,
+		maxRetries:       c.maxRetries,
+		retryMinWait:     c.retryMinWait,
+		retryMaxWait:     c.retryMaxWait,
+		maxResponseSize:  c.maxResponseSize,
+		auditLogPath:     c.auditLogPath,
+		duplicatePolicy:  c.duplicatePolicy,
+		locale:           c.locale,
+		channel:          c.channel,
+		catalogVersion:   c.catalogVersion,
+		offline:          c.offline,
+		apiVersion:       c.apiVersion,
+		strictDecoding:   c.strictDecoding,
+		authToken:        c.authToken,
+		basicUsername:    c.basicUsername,
+		basicPassword:    c.basicPassword,
+		oauth:            c.oauth,
+		userAgent:        c.userAgent,
+		cache:            c.cache,
+		requestSlots:     c.requestSlots,
+		uninstallOverlay: c.uninstallOverlay,
+		awsCredentials:   c.awsCredentials,
+		googleTokens:     c.googleTokens,
+		azureTokens:      c.azureTokens,
+	}, nil
+}
@@ -0,0 +1,90 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+)
+
+func TestWithSource_File(t *testing.T) {
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	if err := c.SetDuplicateTitlesPolicy(DuplicateTitlesPolicyWarn); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	overlay := &imaging.Overlay{}
+	c.SetUninstallOverlay(overlay)
+
+	path := writeTempFile(t, testMultipleTitlesJSON)
+	override, err := c.WithSource("", path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	_, source, err := override.GetTitlesWithSource(context.Background(), "Firefox")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if source.Type != SourceTypeFile || source.Location != path {
+		t.Errorf("expected file source %s, got %s %s", path, source.Type, source.Location)
+	}
+	if requests != 0 {
+		t.Errorf("expected no requests to the provider's source, got %d", requests)
+	}
+	if override.duplicatePolicy != DuplicateTitlesPolicyWarn {
+		t.Errorf("expected duplicate titles policy to be shared, got %q", override.duplicatePolicy)
+	}
+	if override.UninstallOverlay() != overlay {
+		t.Error("expected uninstall overlay to be shared")
+	}
+}
+
+func TestWithSource_URL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient("", writeTempFile(t, "[]"))
+	c.SetMirrors("https://mirror.example.com")
+
+	override, err := c.WithSource(server.URL, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	titles, source, err := override.GetTitlesWithSource(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 2 {
+		t.Errorf("expected 2 titles, got %d", len(titles))
+	}
+	if source.Type != SourceTypeURL {
+		t.Errorf("expected url source, got %s", source.Type)
+	}
+}
+
+func TestWithSource_Invalid(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	if _, err := c.WithSource("unix:///var/run/definitions.sock", ""); err == nil {
+		t.Error("expected error for unix socket URL")
+	}
+	if _, err := c.WithSource("", ""); err == nil {
+		t.Error("expected error for empty source")
+	}
+}
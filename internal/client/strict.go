@@ -0,0 +1,111 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// SetStrictDecoding makes reads fail with an UnknownFieldsError when the returned titles carry
+// catalog fields the provider does not read, so additions to the upstream schema are noticed
+// instead of silently dropped. Profile keys are never unknown, since they are kept in
+// Title.AdditionalProfiles. Decoding is lenient unless strict is true.
+func (c *Client) SetStrictDecoding(strict bool) {
+	c.strictDecoding = strict
+}
+
+// UnknownFieldsError is returned by strict decoding when titles carry catalog fields the provider
+// does not read.
+type UnknownFieldsError struct {
+	// Fields maps the path of each unknown field, such as patch_definition.killApps, to the names
+	// of the titles carrying it.
+	Fields map[string][]string
+}
+
+// Error returns a formatted string listing the unknown fields, sorted by path.
+func (e *UnknownFieldsError) Error() string {
+	descriptions := make([]string, 0, len(e.Fields))
+	for _, path := range slices.Sorted(maps.Keys(e.Fields)) {
+		descriptions = append(descriptions, fmt.Sprintf("%s (%s)", path, strings.Join(e.Fields[path], ", ")))
+	}
+	return fmt.Sprintf("catalog contains fields the provider does not read: %s", strings.Join(descriptions, "; "))
+}
+
+// Field names the provider reads at each level of a title entry.
+var (
+	titleFieldNames           = jsonFieldNames(reflect.TypeFor[Title]())
+	patchDefinitionFieldNames = jsonFieldNames(reflect.TypeFor[PatchDefinition]())
+	requirementFieldNames     = jsonFieldNames(reflect.TypeFor[Requirement]())
+)
+
+// jsonFieldNames returns the JSON names of the fields of the struct type t.
+func jsonFieldNames(t reflect.Type) map[string]struct{} {
+	names := make(map[string]struct{}, t.NumField())
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		names[name] = struct{}{}
+	}
+	return names
+}
+
+// decodeTitleStrict decodes the next title entry of a catalog with decode and also returns the
+// paths of the entry's fields that do not map onto Title.
+func decodeTitleStrict(decoder *json.Decoder, decode titleDecoder, title *Title) ([]string, error) {
+	var raw json.RawMessage
+	if err := decoder.Decode(&raw); err != nil {
+		return nil, err
+	}
+	if err := decode(json.NewDecoder(bytes.NewReader(raw)), title); err != nil {
+		return nil, err
+	}
+	return unknownFields(raw), nil
+}
+
+// unknownFields returns the paths of the fields of a raw title entry that do not map onto Title.
+func unknownFields(raw json.RawMessage) []string {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &fields); err != nil {
+		return nil
+	}
+
+	var unknown []string
+	for key, value := range fields {
+		if _, ok := titleFieldNames[key]; ok && key != "additional_profiles" {
+			continue
+		}
+		var profile string
+		if strings.HasSuffix(key, profileKeySuffix) && json.Unmarshal(value, &profile) == nil {
+			continue
+		}
+		unknown = append(unknown, key)
+	}
+
+	var patchDefinition map[string]json.RawMessage
+	if json.Unmarshal(fields["patch_definition"], &patchDefinition) == nil {
+		for key := range patchDefinition {
+			if _, ok := patchDefinitionFieldNames[key]; !ok {
+				unknown = append(unknown, "patch_definition."+key)
+			}
+		}
+	}
+	var requirements []map[string]json.RawMessage
+	if json.Unmarshal(patchDefinition["requirements"], &requirements) == nil {
+		for _, requirement := range requirements {
+			for key := range requirement {
+				if _, ok := requirementFieldNames[key]; !ok {
+					unknown = append(unknown, "patch_definition.requirements."+key)
+				}
+			}
+		}
+	}
+
+	slices.Sort(unknown)
+	return slices.Compact(unknown)
+}
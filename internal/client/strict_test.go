@@ -0,0 +1,72 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+const testUnknownFieldsJSON = `[
+	{"title_name":"GoogleChrome","title_version":"1.0","release_notes":"x","dns_proxy_profile":"<plist/>","patch_definition":{"requirements":[{"name":"Application Bundle ID","value":"com.google.Chrome","comment":"x"}]}},
+	{"title_name":"Firefox","title_version":"2.0","release_notes":"y","patch_definition":{"requirements":[],"kill_apps":[]}}
+]`
+
+func TestSetStrictDecoding_UnknownFields(t *testing.T) {
+	c := NewClient("", writeTempFile(t, testUnknownFieldsJSON))
+	c.SetStrictDecoding(true)
+
+	_, err := c.GetTitles(context.Background())
+	unknownErr, ok := errors.AsType[*UnknownFieldsError](err)
+	if !ok {
+		t.Fatalf("expected UnknownFieldsError, got %v", err)
+	}
+	want := map[string][]string{
+		"release_notes":                         {"GoogleChrome", "Firefox"},
+		"patch_definition.requirements.comment": {"GoogleChrome"},
+		"patch_definition.kill_apps":            {"Firefox"},
+	}
+	if !reflect.DeepEqual(unknownErr.Fields, want) {
+		t.Errorf("expected unknown fields %v, got %v", want, unknownErr.Fields)
+	}
+}
+
+func TestSetStrictDecoding_OnlyReturnedTitles(t *testing.T) {
+	c := NewClient("", writeTempFile(t, `[
+		{"title_name":"GoogleChrome","title_version":"1.0","patch_definition":{"requirements":[]}},
+		{"title_name":"Firefox","title_version":"2.0","release_notes":"y","patch_definition":{"requirements":[]}}
+	]`))
+	c.SetStrictDecoding(true)
+
+	titles, err := c.GetTitles(context.Background(), "GoogleChrome")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 {
+		t.Errorf("expected 1 title, got %d", len(titles))
+	}
+}
+
+func TestSetStrictDecoding_Lenient(t *testing.T) {
+	titles, err := NewClient("", writeTempFile(t, testUnknownFieldsJSON)).GetTitles(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 2 {
+		t.Errorf("expected 2 titles, got %d", len(titles))
+	}
+}
+
+func TestUnknownFieldsError_Error(t *testing.T) {
+	err := &UnknownFieldsError{Fields: map[string][]string{
+		"release_notes":              {"GoogleChrome", "Firefox"},
+		"patch_definition.kill_apps": {"Firefox"},
+	}}
+	want := "catalog contains fields the provider does not read: patch_definition.kill_apps (Firefox); release_notes (GoogleChrome, Firefox)"
+	if err.Error() != want {
+		t.Errorf("expected %q, got %q", want, err.Error())
+	}
+}
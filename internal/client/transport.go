@@ -0,0 +1,84 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Default transport tuning. Requests go to a single definitions server, so idle connections are
+// pooled per host up to the overall limit rather than the standard library's two per host.
+const (
+	defaultConnectTimeout      = 30 * time.Second
+	defaultTLSHandshakeTimeout = 10 * time.Second
+	defaultKeepAlive           = 30 * time.Second
+	defaultMaxIdleConns        = 100
+)
+
+// newTransport returns the transport of a new client, dialing with dial.
+func newTransport(dial func(ctx context.Context, network, addr string) (net.Conn, error)) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = dial
+	transport.TLSHandshakeTimeout = defaultTLSHandshakeTimeout
+	transport.MaxIdleConns = defaultMaxIdleConns
+	transport.MaxIdleConnsPerHost = defaultMaxIdleConns
+	return transport
+}
+
+// SetTimeouts sets how long the client waits to establish a connection, including resolving the
+// server's name, to complete the TLS handshake and to receive response headers once a request is
+// sent. Zero disables a limit, and a nil timeout keeps its current value. Reads remain bounded by
+// the overall request timeout.
+func (c *Client) SetTimeouts(connect, tlsHandshake, responseHeader *time.Duration) error {
+	for _, timeout := range []*time.Duration{connect, tlsHandshake, responseHeader} {
+		if timeout != nil && *timeout < 0 {
+			return fmt.Errorf("timeouts must not be negative, got %s", *timeout)
+		}
+	}
+	if connect != nil {
+		c.dialer.Timeout = *connect
+	}
+	if tlsHandshake != nil {
+		c.transport.TLSHandshakeTimeout = *tlsHandshake
+	}
+	if responseHeader != nil {
+		c.transport.ResponseHeaderTimeout = *responseHeader
+	}
+	return nil
+}
+
+// unixScheme is the URL prefix used to address a definitions server listening on a Unix domain socket,
+// for example unix:///var/run/definitions.sock.
+const unixScheme = "unix://"
+
+// resolveBaseURL returns the HTTP URL requests should be sent to for baseURL. Unix socket URLs are
+// registered with the transport and rewritten to a placeholder host that dials the socket.
+func (c *Client) resolveBaseURL(baseURL string) string {
+	socketPath, ok := strings.CutPrefix(baseURL, unixScheme)
+	if !ok {
+		return baseURL
+	}
+
+	if c.unixSockets == nil {
+		c.unixSockets = make(map[string]string)
+		dial := c.transport.DialContext
+		c.transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			if host, _, err := net.SplitHostPort(addr); err == nil {
+				if path, ok := c.unixSockets[host]; ok {
+					return c.dialContext(ctx, "unix", path)
+				}
+			}
+			return dial(ctx, network, addr)
+		}
+	}
+
+	host := fmt.Sprintf("unix-socket-%d", len(c.unixSockets))
+	c.unixSockets[host] = socketPath
+	return "http://" + host
+}
@@ -0,0 +1,118 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestResolveBaseURL_HTTPUnchanged(t *testing.T) {
+	c := NewClient("https://example.com/definitions", "")
+	if c.baseURL != "https://example.com/definitions" {
+		t.Errorf("expected base URL to be unchanged, got %s", c.baseURL)
+	}
+	if c.unixSockets != nil {
+		t.Error("expected no unix sockets to be registered")
+	}
+}
+
+func TestGetTitles_UnixSocket(t *testing.T) {
+	socketPath := filepath.Join(t.TempDir(), "definitions.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Skipf("unix sockets not supported: %v", err)
+	}
+
+	var requestPath string
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testTitleJSON))
+	}))
+	server.Listener = listener
+	server.Start()
+	defer server.Close()
+
+	c := NewClient("unix://"+socketPath, "")
+	titles, err := c.GetTitles(context.Background(), "GoogleChrome")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(titles) != 1 || titles[0].TitleName == nil || *titles[0].TitleName != "GoogleChrome" {
+		t.Errorf("unexpected titles: %+v", titles)
+	}
+	if requestPath != "/GoogleChrome" {
+		t.Errorf("expected request path /GoogleChrome, got %s", requestPath)
+	}
+}
+
+func TestGetTitles_UnixSocketMissing(t *testing.T) {
+	c := NewClient("unix://"+filepath.Join(t.TempDir(), "missing.sock"), "")
+	c.maxRetries = 0
+	if _, err := c.GetTitles(context.Background()); err == nil {
+		t.Fatal("expected error for missing socket")
+	}
+}
+
+func TestNewClient_TransportTuning(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	if c.dialer.Timeout != defaultConnectTimeout {
+		t.Errorf("expected connect timeout %s, got %s", defaultConnectTimeout, c.dialer.Timeout)
+	}
+	if c.transport.TLSHandshakeTimeout != defaultTLSHandshakeTimeout {
+		t.Errorf("expected TLS handshake timeout %s, got %s", defaultTLSHandshakeTimeout, c.transport.TLSHandshakeTimeout)
+	}
+	if c.transport.MaxIdleConnsPerHost != defaultMaxIdleConns {
+		t.Errorf("expected %d idle connections per host, got %d", defaultMaxIdleConns, c.transport.MaxIdleConnsPerHost)
+	}
+}
+
+func TestSetTimeouts(t *testing.T) {
+	c := NewClient("https://example.com", "")
+	connect, responseHeader := 5*time.Second, 15*time.Second
+	if err := c.SetTimeouts(&connect, nil, &responseHeader); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.dialer.Timeout != connect {
+		t.Errorf("expected connect timeout %s, got %s", connect, c.dialer.Timeout)
+	}
+	if c.transport.TLSHandshakeTimeout != defaultTLSHandshakeTimeout {
+		t.Errorf("expected TLS handshake timeout to be kept, got %s", c.transport.TLSHandshakeTimeout)
+	}
+	if c.transport.ResponseHeaderTimeout != responseHeader {
+		t.Errorf("expected response header timeout %s, got %s", responseHeader, c.transport.ResponseHeaderTimeout)
+	}
+
+	negative := -time.Second
+	if err := c.SetTimeouts(nil, &negative, nil); err == nil {
+		t.Error("expected an error for a negative timeout")
+	}
+}
+
+func TestSetTimeouts_ResponseHeader(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	c := NewClient(server.URL, "")
+	c.maxRetries = 0
+	timeout := 20 * time.Millisecond
+	if err := c.SetTimeouts(nil, nil, &timeout); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := c.GetTitles(context.Background()); err == nil || !strings.Contains(err.Error(), "timeout awaiting response headers") {
+		t.Errorf("expected a response header timeout, got %v", err)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 )
 
 // Logger is an interface for logging HTTP requests and responses.
@@ -27,6 +28,10 @@ type Title struct {
 	MaximumOS                *string         `json:"maximum_os"`
 	IconHiRes                *string         `json:"icon_hires"`
 	ExtensionAttribute       *string         `json:"extension_attribute"`
+	UninstallScript          *string         `json:"uninstall_script"`
+	EULAURL                  *string         `json:"eula_url"`
+	LicenseType              *string         `json:"license_type"`
+	EstimatedDownloadSizeMB  *float64        `json:"estimated_download_size_mb"`
 	ContentFilterProfile     *string         `json:"content_filter_profile"`
 	KernelExtensionProfile   *string         `json:"kernel_extension_profile"`
 	ManagedLoginItemsProfile *string         `json:"managed_login_items_profile"`
@@ -35,17 +40,87 @@ type Title struct {
 	ScreenRecordingProfile   *string         `json:"screen_recording_profile"`
 	SystemExtensionProfile   *string         `json:"system_extension_profile"`
 	PatchDefinition          PatchDefinition `json:"patch_definition"`
+	// AdditionalProfiles holds catalog profiles the provider has no dedicated field for, keyed by
+	// their catalog key, such as dns_proxy_profile.
+	AdditionalProfiles map[string]string `json:"additional_profiles,omitempty"`
 }
 
-// PatchDefinition represents the patch definition of a title.
+// PatchDefinition represents the patch definition of a title, in the software title format Jamf Pro
+// reads from external patch sources.
 type PatchDefinition struct {
 	Requirements []Requirement `json:"requirements"`
+	// Capabilities are the criteria a device must meet to install the title.
+	Capabilities []Requirement `json:"capabilities,omitempty"`
+	// Components describe the installed software that makes up the title.
+	Components []Component `json:"components,omitempty"`
+	// KillApps lists the applications that must be quit before the title is updated.
+	KillApps []KillApp `json:"killApps,omitempty"`
 }
 
-// Requirement represents a requirement in the patch definition.
+// bundleIDRequirementName is the name of the patch definition requirement holding the application bundle identifier.
+const bundleIDRequirementName = "Application Bundle ID"
+
+// BundleID returns the Application Bundle ID from the patch definition requirements, or nil if none is present.
+func (p PatchDefinition) BundleID() *string {
+	for _, req := range p.Requirements {
+		if req.Name != nil && *req.Name == bundleIDRequirementName {
+			return req.Value
+		}
+	}
+	return nil
+}
+
+// Requirement represents a requirement in the patch definition. Capabilities and component criteria
+// share its shape.
 type Requirement struct {
-	Name  *string `json:"name"`
-	Value *string `json:"value"`
+	Name     *string `json:"name"`
+	Operator *string `json:"operator,omitempty"`
+	Value    *string `json:"value"`
+	Type     *string `json:"type,omitempty"`
+	// And reports whether the criterion is combined with the next one using and rather than or.
+	And *bool `json:"and,omitempty"`
+}
+
+// Component represents a piece of installed software in the patch definition and the criteria
+// that identify it on a device.
+type Component struct {
+	Name     *string       `json:"name"`
+	Version  *string       `json:"version"`
+	Criteria []Requirement `json:"criteria"`
+}
+
+// KillApp represents an application that must be quit before a title is updated.
+type KillApp struct {
+	BundleID *string `json:"bundleId"`
+	AppName  *string `json:"appName"`
+}
+
+// Source types reported by Source.Type.
+const (
+	SourceTypeURL  = "url"
+	SourceTypeFile = "file"
+	// SourceTypeInline is reported for titles read from a catalog passed to NewInlineClient.
+	SourceTypeInline = "inline"
+)
+
+// Source describes where and when titles were read from.
+type Source struct {
+	// Type is SourceTypeURL for the definitions API, SourceTypeFile for a definitions file or
+	// SourceTypeInline for an inline catalog.
+	Type string
+	// Location is the URL, with credentials redacted, or the path of the file that served the titles.
+	Location string
+	// FetchedAt is when the titles were read.
+	FetchedAt time.Time
+	// Duplicates lists the titles that appeared more than once and were resolved by keeping the
+	// first entry. It is only populated under DuplicateTitlesPolicyWarn.
+	Duplicates []DuplicateTitle
+	// UnknownProfiles lists the profile keys the provider does not recognise, which were kept in
+	// the AdditionalProfiles of the titles that carry them.
+	UnknownProfiles []UnknownProfile
+	// FallbackReason describes why the definitions API could not be read when the titles were
+	// read from the fallback definitions file instead. It is empty otherwise.
+	FallbackReason string
 }
 
 // TitlesNotFoundError is returned when one or more requested titles are not found.
@@ -57,3 +132,128 @@ type TitlesNotFoundError struct {
 func (e *TitlesNotFoundError) Error() string {
 	return fmt.Sprintf("The following titles were not found: %s", strings.Join(e.MissingTitles, ", "))
 }
+
+// BundleIDsNotFoundError is returned when no title has one or more of the requested bundle IDs.
+type BundleIDsNotFoundError struct {
+	MissingBundleIDs []string
+}
+
+// Error returns a formatted string listing the bundle IDs that were not found.
+func (e *BundleIDsNotFoundError) Error() string {
+	return fmt.Sprintf("No titles were found with the following bundle IDs: %s", strings.Join(e.MissingBundleIDs, ", "))
+}
+
+// MalformedTitle describes a catalog entry that could not be decoded into a Title.
+type MalformedTitle struct {
+	// TitleName is the name of the title, or empty if the name itself could not be decoded.
+	TitleName string
+	// Index is the zero-based position of the entry in the catalog.
+	Index int
+	Err   error
+}
+
+// MalformedTitlesError is returned alongside the successfully decoded titles when one or more
+// catalog entries have fields of an unexpected type. Callers that can tolerate missing titles may
+// use the returned titles; all others should treat it as a failure.
+type MalformedTitlesError struct {
+	Titles []MalformedTitle
+}
+
+// Error returns a formatted string describing the malformed entries.
+func (e *MalformedTitlesError) Error() string {
+	descriptions := make([]string, 0, len(e.Titles))
+	for _, title := range e.Titles {
+		name := title.TitleName
+		if name == "" {
+			name = fmt.Sprintf("entry %d", title.Index)
+		}
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", name, title.Err))
+	}
+	return fmt.Sprintf("The following titles could not be decoded: %s", strings.Join(descriptions, "; "))
+}
+
+// APIError is returned when the definitions API responds with an unexpected status code.
+// Message and Code are populated when the response carries a structured JSON error payload.
+type APIError struct {
+	StatusCode int
+	Message    string
+	Code       string
+}
+
+// Error returns a formatted string describing the failed request.
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API request failed with status code: %d", e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+	if e.Code != "" {
+		msg += fmt.Sprintf(" (code %s)", e.Code)
+	}
+	return msg
+}
+
+// Authentication mechanisms reported by AuthenticationError.
+const (
+	// AuthMechanismNone is reported when the client sends no credentials.
+	AuthMechanismNone = "none"
+	// AuthMechanismBearer is reported when the client sends a bearer token set with SetAuthToken.
+	AuthMechanismBearer = "bearer"
+	// AuthMechanismBasic is reported when the client sends a username and password set with
+	// SetBasicAuth.
+	AuthMechanismBasic = "basic"
+	// AuthMechanismOAuth2 is reported when the client sends access tokens obtained with
+	// SetOAuth2ClientCredentials.
+	AuthMechanismOAuth2 = "oauth2"
+	// AuthMechanismAWSSigV4 is reported when requests for a catalog in S3 are signed with AWS
+	// credentials.
+	AuthMechanismAWSSigV4 = "aws_sigv4"
+	// AuthMechanismGoogleAccessToken is reported when requests for a catalog in Cloud Storage
+	// carry a Google access token.
+	AuthMechanismGoogleAccessToken = "google_access_token"
+	// AuthMechanismAzureSAS is reported when requests for a catalog in Azure Blob Storage carry
+	// a SAS token.
+	AuthMechanismAzureSAS = "azure_sas"
+	// AuthMechanismAzureSharedKey is reported when requests for a catalog in Azure Blob Storage
+	// are signed with the storage account key.
+	AuthMechanismAzureSharedKey = "azure_shared_key"
+	// AuthMechanismAzureEntraID is reported when requests for a catalog in Azure Blob Storage
+	// carry a Microsoft Entra ID token of a service principal or managed identity.
+	AuthMechanismAzureEntraID = "azure_entra_id"
+)
+
+// AuthenticationError is returned when the definitions API rejects a request with 401 Unauthorized
+// or 403 Forbidden. Mechanism names the authentication the client used for the request.
+type AuthenticationError struct {
+	Mechanism string
+	Err       *APIError
+}
+
+// Error returns a formatted string describing the rejected request.
+func (e *AuthenticationError) Error() string {
+	return fmt.Sprintf("%s (authentication: %s)", e.Err.Error(), e.Mechanism)
+}
+
+// Unwrap returns the underlying APIError.
+func (e *AuthenticationError) Unwrap() error {
+	return e.Err
+}
+
+// RateLimitedError is returned when the definitions API responds with 429 Too Many Requests.
+// RetryAfter holds the wait requested by the server's Retry-After header, or zero if none was given.
+type RateLimitedError struct {
+	RetryAfter time.Duration
+	Err        *APIError
+}
+
+// Error returns a formatted string describing the throttled request.
+func (e *RateLimitedError) Error() string {
+	if e.RetryAfter > 0 {
+		return fmt.Sprintf("%s (rate limited, retry after %s)", e.Err.Error(), e.RetryAfter)
+	}
+	return fmt.Sprintf("%s (rate limited)", e.Err.Error())
+}
+
+// Unwrap returns the underlying APIError.
+func (e *RateLimitedError) Unwrap() error {
+	return e.Err
+}
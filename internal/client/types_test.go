@@ -0,0 +1,71 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPatchDefinitionBundleID_Found(t *testing.T) {
+	reqs := []Requirement{
+		{Name: new("Application Bundle ID"), Value: new("com.example.app")},
+	}
+	result := PatchDefinition{Requirements: reqs}.BundleID()
+	if result == nil || *result != "com.example.app" {
+		t.Errorf("expected com.example.app, got %v", result)
+	}
+}
+
+func TestPatchDefinitionBundleID_NotFound(t *testing.T) {
+	reqs := []Requirement{
+		{Name: new("OS Version"), Value: new("12.0")},
+	}
+	result := PatchDefinition{Requirements: reqs}.BundleID()
+	if result != nil {
+		t.Errorf("expected nil, got %s", *result)
+	}
+}
+
+func TestPatchDefinitionBundleID_EmptySlice(t *testing.T) {
+	result := PatchDefinition{Requirements: []Requirement{}}.BundleID()
+	if result != nil {
+		t.Errorf("expected nil, got %s", *result)
+	}
+}
+
+func TestPatchDefinitionBundleID_NilSlice(t *testing.T) {
+	result := PatchDefinition{Requirements: nil}.BundleID()
+	if result != nil {
+		t.Errorf("expected nil, got %s", *result)
+	}
+}
+
+func TestAPIError_Error(t *testing.T) {
+	tests := []struct {
+		err  APIError
+		want string
+	}{
+		{APIError{StatusCode: 500}, "API request failed with status code: 500"},
+		{APIError{StatusCode: 503, Message: "catalog rebuilding"}, "API request failed with status code: 503: catalog rebuilding"},
+		{APIError{StatusCode: 400, Message: "bad request", Code: "4001"}, "API request failed with status code: 400: bad request (code 4001)"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.err.Error(); got != tt.want {
+			t.Errorf("expected %q, got %q", tt.want, got)
+		}
+	}
+}
+
+func TestAuthenticationError(t *testing.T) {
+	err := &AuthenticationError{Mechanism: AuthMechanismNone, Err: &APIError{StatusCode: 401}}
+
+	if got := err.Error(); got != "API request failed with status code: 401 (authentication: none)" {
+		t.Errorf("unexpected message: %s", got)
+	}
+	if apiErr, ok := errors.AsType[*APIError](err); !ok || apiErr.StatusCode != 401 {
+		t.Errorf("expected wrapped APIError, got %v", apiErr)
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import "net/http"
+
+// defaultUserAgent is the User-Agent sent until SetUserAgent sets one that includes the provider version.
+const defaultUserAgent = "terraform-provider-jamfautoupdate"
+
+// SetUserAgent sets the User-Agent header sent with every request for the catalog.
+func (c *Client) SetUserAgent(userAgent string) {
+	c.userAgent = userAgent
+}
+
+// setUserAgentHeader adds the configured User-Agent header to req.
+func (c *Client) setUserAgentHeader(req *http.Request) {
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestSetUserAgent(t *testing.T) {
+	var mu sync.Mutex
+	var userAgents []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		userAgents = append(userAgents, r.UserAgent())
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "")
+	c.SetUserAgent("terraform-provider-jamfautoupdate/1.2.3 acme-it")
+
+	if _, err := c.GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	c.CheckHealth(context.Background())
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(userAgents) < 2 {
+		t.Fatalf("expected a catalog and a health request, got %d requests", len(userAgents))
+	}
+	for i, userAgent := range userAgents {
+		if userAgent != "terraform-provider-jamfautoupdate/1.2.3 acme-it" {
+			t.Errorf("request %d: unexpected User-Agent %q", i, userAgent)
+		}
+	}
+}
+
+func TestNewClient_DefaultUserAgent(t *testing.T) {
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userAgent = r.UserAgent()
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(testMultipleTitlesJSON))
+	}))
+	defer server.Close()
+
+	if _, err := NewClient(server.URL, "").GetTitles(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if userAgent != defaultUserAgent {
+		t.Errorf("expected the default User-Agent, got %q", userAgent)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/profiles"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &CanonicalizePlistFunction{}
+
+// NewCanonicalizePlistFunction returns a new instance of the canonicalize_plist function.
+func NewCanonicalizePlistFunction() function.Function {
+	return &CanonicalizePlistFunction{}
+}
+
+// CanonicalizePlistFunction defines the canonicalize_plist function implementation.
+type CanonicalizePlistFunction struct{}
+
+func (f *CanonicalizePlistFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "canonicalize_plist"
+}
+
+func (f *CanonicalizePlistFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a property list for comparison",
+		MarkdownDescription: "Returns a property list, such as a configuration profile, as XML in the canonical form the titles data source uses when `profile_format` is `xml`: " +
+			"dictionary keys sorted, one tab of indentation per level and data written as a single line of base64. " +
+			"Use it to normalize profiles from other sources, such as Jamf Pro exports, before comparing them against catalog profiles. " +
+			"The property list may be base64 encoded or raw XML; for signed profiles the embedded property list is returned.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "plist",
+				MarkdownDescription: "The property list, base64 encoded or as XML",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *CanonicalizePlistFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	canonical, err := profiles.Canonical(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to read property list: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, string(canonical)))
+}
@@ -0,0 +1,54 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestCanonicalizePlistFunction_Run(t *testing.T) {
+	reordered := `<plist version="1.0"><dict><key>PayloadType</key><string>Configuration</string>
+<key>PayloadContent</key><array><dict><key>PayloadType</key><string>com.apple.TCC.configuration-profile-policy</string></dict></array></dict></plist>`
+
+	var results []string
+	for _, content := range []string{testProfile, base64.StdEncoding.EncodeToString([]byte(reordered))} {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(content)}),
+		}
+		resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+		NewCanonicalizePlistFunction().Run(context.Background(), req, resp)
+
+		if resp.Error != nil {
+			t.Fatalf("unexpected error: %v", resp.Error)
+		}
+		results = append(results, resp.Result.Value().(types.String).ValueString())
+	}
+
+	if results[0] != results[1] {
+		t.Errorf("expected identical canonical forms:\n%s\n%s", results[0], results[1])
+	}
+}
+
+func TestCanonicalizePlistFunction_InvalidPlist(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("<plist><dict><key>A</key></dict></plist>")}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewCanonicalizePlistFunction().Run(context.Background(), req, resp)
+
+	if resp.Error == nil {
+		t.Fatal("expected an error")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("expected the error to refer to the plist argument, got %v", resp.Error)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/versions"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &LatestVersionFunction{}
+
+// NewLatestVersionFunction returns a new instance of the latest_version function.
+func NewLatestVersionFunction() function.Function {
+	return &LatestVersionFunction{}
+}
+
+// LatestVersionFunction defines the latest_version function implementation.
+type LatestVersionFunction struct{}
+
+func (f *LatestVersionFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "latest_version"
+}
+
+func (f *LatestVersionFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Return the newest of a list of version strings",
+		MarkdownDescription: "Returns the newest of a list of version strings using the same comparison rules as the Jamf Auto Update catalog and `semver_sort`, " +
+			"avoiding string comparisons that rank `1.9` above `1.10`. When equivalent versions are the newest, the first of them is returned. " +
+			"The list must not be empty.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "versions",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The version strings to choose from",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *LatestVersionFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	if len(input) == 0 {
+		resp.Error = function.NewArgumentFuncError(0, "versions must contain at least one version")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, slices.MaxFunc(input, versions.Compare)))
+}
@@ -0,0 +1,63 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestLatestVersionFunction_Metadata(t *testing.T) {
+	resp := &function.MetadataResponse{}
+	NewLatestVersionFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "latest_version" {
+		t.Errorf("expected name latest_version, got %s", resp.Name)
+	}
+}
+
+func TestLatestVersionFunction_Run(t *testing.T) {
+	tests := []struct {
+		versions []string
+		want     string
+	}{
+		{[]string{"1.9", "1.10", "1.10b1", "1.2"}, "1.10"},
+		{[]string{"2.0b1", "1.9.9"}, "2.0b1"},
+		{[]string{"1.02", "1.2"}, "1.02"},
+		{[]string{"120.0.6099.109"}, "120.0.6099.109"},
+	}
+
+	for _, tt := range tests {
+		req := function.RunRequest{
+			Arguments: function.NewArgumentsData([]attr.Value{stringList(tt.versions...)}),
+		}
+		resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+		NewLatestVersionFunction().Run(context.Background(), req, resp)
+
+		if resp.Error != nil {
+			t.Fatalf("%v: unexpected error: %v", tt.versions, resp.Error)
+		}
+		if want := types.StringValue(tt.want); !resp.Result.Value().Equal(want) {
+			t.Errorf("%v: expected %v, got %v", tt.versions, want, resp.Result.Value())
+		}
+	}
+}
+
+func TestLatestVersionFunction_RunEmpty(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{stringList()}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewLatestVersionFunction().Run(context.Background(), req, resp)
+
+	if resp.Error == nil {
+		t.Fatal("expected an error for an empty list")
+	}
+}
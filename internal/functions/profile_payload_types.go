@@ -0,0 +1,63 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/profiles"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &ProfilePayloadTypesFunction{}
+
+// NewProfilePayloadTypesFunction returns a new instance of the profile_payload_types function.
+func NewProfilePayloadTypesFunction() function.Function {
+	return &ProfilePayloadTypesFunction{}
+}
+
+// ProfilePayloadTypesFunction defines the profile_payload_types function implementation.
+type ProfilePayloadTypesFunction struct{}
+
+func (f *ProfilePayloadTypesFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "profile_payload_types"
+}
+
+func (f *ProfilePayloadTypesFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "List the payload types in a configuration profile",
+		MarkdownDescription: "Returns the distinct `PayloadType` values of the payloads in a configuration profile (mobileconfig), in the order they appear, " +
+			"so modules can check a catalog profile contains the expected payload before deploying it. " +
+			"The profile may be base64 encoded, as the catalog's profile attributes are, or raw XML; signed profiles are supported. " +
+			"A profile without `PayloadContent` returns its own `PayloadType`.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "profile",
+				MarkdownDescription: "The configuration profile, base64 encoded or as XML",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *ProfilePayloadTypesFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var content string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &content))
+	if resp.Error != nil {
+		return
+	}
+
+	profile, err := profiles.Decode(content)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, fmt.Sprintf("Unable to read configuration profile: %s", err))
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, profiles.PayloadTypes(profile)))
+}
@@ -0,0 +1,64 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testProfile = `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>PayloadType</key>
+			<string>com.apple.TCC.configuration-profile-policy</string>
+		</dict>
+	</array>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+</dict>
+</plist>`
+
+func TestProfilePayloadTypesFunction_Run(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(base64.StdEncoding.EncodeToString([]byte(testProfile))),
+		}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	NewProfilePayloadTypesFunction().Run(context.Background(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	want := stringList("com.apple.TCC.configuration-profile-policy")
+	if !resp.Result.Value().Equal(want) {
+		t.Errorf("expected %v, got %v", want, resp.Result.Value())
+	}
+}
+
+func TestProfilePayloadTypesFunction_InvalidProfile(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{types.StringValue("not a profile!")}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	NewProfilePayloadTypesFunction().Run(context.Background(), req, resp)
+
+	if resp.Error == nil {
+		t.Fatal("expected an error")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("expected the error to refer to the profile argument, got %v", resp.Error)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"slices"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/versions"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ function.Function = &SemverSortFunction{}
+
+// NewSemverSortFunction returns a new instance of the semver_sort function.
+func NewSemverSortFunction() function.Function {
+	return &SemverSortFunction{}
+}
+
+// SemverSortFunction defines the semver_sort function implementation.
+type SemverSortFunction struct{}
+
+func (f *SemverSortFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "semver_sort"
+}
+
+func (f *SemverSortFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Sort version strings from oldest to newest",
+		MarkdownDescription: "Sorts a list of version strings from oldest to newest using the same comparison rules as the Jamf Auto Update catalog. " +
+			"Numeric components are compared numerically (`1.10` is newer than `1.9`), and a trailing letter component marks a pre-release (`1.0b1` is older than `1.0`). " +
+			"Equivalent versions keep their original order.",
+		Parameters: []function.Parameter{
+			function.ListParameter{
+				Name:                "versions",
+				ElementType:         types.StringType,
+				MarkdownDescription: "The version strings to sort",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.StringType,
+		},
+	}
+}
+
+func (f *SemverSortFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var input []string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &input))
+	if resp.Error != nil {
+		return
+	}
+
+	sorted := slices.Clone(input)
+	versions.Sort(sorted)
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, sorted))
+}
@@ -0,0 +1,64 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// stringList builds a list value of strings for function arguments and results.
+func stringList(values ...string) types.List {
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
+
+func TestSemverSortFunction_Metadata(t *testing.T) {
+	resp := &function.MetadataResponse{}
+	NewSemverSortFunction().Metadata(context.Background(), function.MetadataRequest{}, resp)
+
+	if resp.Name != "semver_sort" {
+		t.Errorf("expected name semver_sort, got %s", resp.Name)
+	}
+}
+
+func TestSemverSortFunction_Run(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{stringList("1.10", "1.0", "1.0b1", "1.9")}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	NewSemverSortFunction().Run(context.Background(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	want := stringList("1.0b1", "1.0", "1.9", "1.10")
+	if !resp.Result.Value().Equal(want) {
+		t.Errorf("expected %v, got %v", want, resp.Result.Value())
+	}
+}
+
+func TestSemverSortFunction_RunEmpty(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{stringList()}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.StringType))}
+
+	NewSemverSortFunction().Run(context.Background(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	if !resp.Result.Value().Equal(stringList()) {
+		t.Errorf("expected an empty list, got %v", resp.Result.Value())
+	}
+}
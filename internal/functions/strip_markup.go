@@ -0,0 +1,53 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/markup"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+var _ function.Function = &StripMarkupFunction{}
+
+// NewStripMarkupFunction returns a new instance of the strip_markup function.
+func NewStripMarkupFunction() function.Function {
+	return &StripMarkupFunction{}
+}
+
+// StripMarkupFunction defines the strip_markup function implementation.
+type StripMarkupFunction struct{}
+
+func (f *StripMarkupFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "strip_markup"
+}
+
+func (f *StripMarkupFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Remove HTML and Markdown markup from text",
+		MarkdownDescription: "Returns text with HTML tags and Markdown formatting removed, as the titles data source does for `title_description_plain`, " +
+			"so user-supplied descriptions and other catalog text can be cleaned for fields that display raw text. " +
+			"Block elements and line breaks become newlines, list items become `- ` bullets, links are replaced by their text and HTML entities are decoded. " +
+			"Runs of spaces are collapsed and at most one blank line is kept between paragraphs.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "text",
+				MarkdownDescription: "The text to clean",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *StripMarkupFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var text string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &text))
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, markup.Strip(text)))
+}
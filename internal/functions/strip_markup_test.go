@@ -0,0 +1,32 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package functions
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestStripMarkupFunction_Run(t *testing.T) {
+	req := function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue("<p>A <b>fast</b> &amp; **secure** [browser](https://example.com).</p>"),
+		}),
+	}
+	resp := &function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+
+	NewStripMarkupFunction().Run(context.Background(), req, resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+	want := types.StringValue("A fast & secure browser.")
+	if !resp.Result.Value().Equal(want) {
+		t.Errorf("expected %v, got %v", want, resp.Result.Value())
+	}
+}
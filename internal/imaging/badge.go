@@ -0,0 +1,177 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	"golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/gofont/gobold"
+	"golang.org/x/image/font/opentype"
+	"golang.org/x/image/math/fixed"
+)
+
+// Supported badge positions.
+const (
+	BadgePositionTopLeft     = "top_left"
+	BadgePositionTopRight    = "top_right"
+	BadgePositionBottomLeft  = "bottom_left"
+	BadgePositionBottomRight = "bottom_right"
+)
+
+// BadgePositions lists the supported badge positions.
+var BadgePositions = []string{BadgePositionTopLeft, BadgePositionTopRight, BadgePositionBottomLeft, BadgePositionBottomRight}
+
+// Badge describes a text badge drawn onto an icon.
+type Badge struct {
+	Text      string
+	Color     color.RGBA
+	TextColor color.RGBA
+	// Position is the corner the badge is drawn in, one of BadgePositions.
+	Position string
+}
+
+var (
+	badgeFontOnce sync.Once
+	badgeFont     *opentype.Font
+	badgeFontErr  error
+)
+
+// getBadgeFont parses the embedded Go Bold font once and caches the result.
+func getBadgeFont() (*opentype.Font, error) {
+	badgeFontOnce.Do(func() {
+		badgeFont, badgeFontErr = opentype.Parse(gobold.TTF)
+		if badgeFontErr != nil {
+			badgeFontErr = fmt.Errorf("error parsing badge font: %w", badgeFontErr)
+		}
+	})
+	return badgeFont, badgeFontErr
+}
+
+// ParseHexColor parses a color in #RRGGBB or #RRGGBBAA notation.
+func ParseHexColor(s string) (color.RGBA, error) {
+	hex := strings.TrimPrefix(s, "#")
+	if len(hex) != 6 && len(hex) != 8 {
+		return color.RGBA{}, fmt.Errorf("color must be in #RRGGBB or #RRGGBBAA format, got: %q", s)
+	}
+	if len(hex) == 6 {
+		hex += "ff"
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return color.RGBA{}, fmt.Errorf("color must be in #RRGGBB or #RRGGBBAA format, got: %q", s)
+	}
+
+	// Colors are drawn as premultiplied RGBA.
+	a := uint8(value)
+	premultiply := func(c uint8) uint8 { return uint8(uint32(c) * uint32(a) / 0xff) }
+	return color.RGBA{
+		R: premultiply(uint8(value >> 24)),
+		G: premultiply(uint8(value >> 16)),
+		B: premultiply(uint8(value >> 8)),
+		A: a,
+	}, nil
+}
+
+// DrawBadge draws a pill-shaped badge containing badge.Text into the configured corner of dst.
+// The badge is sized relative to dst so it looks the same at every icon size.
+func DrawBadge(dst *image.RGBA, badge Badge) error {
+	ttf, err := getBadgeFont()
+	if err != nil {
+		return err
+	}
+
+	size := dst.Bounds().Dx()
+	margin := size / 32
+	height := size / 5
+	maxWidth := size - 2*margin
+
+	// Shrink the text until the badge fits within the icon.
+	var face font.Face
+	var textWidth int
+	for fontSize := float64(height) * 0.6; ; fontSize *= 0.9 {
+		face, err = opentype.NewFace(ttf, &opentype.FaceOptions{Size: fontSize, DPI: 72, Hinting: font.HintingFull})
+		if err != nil {
+			return fmt.Errorf("error creating badge font face: %w", err)
+		}
+		textWidth = font.MeasureString(face, badge.Text).Ceil()
+		if textWidth+height/2 <= maxWidth || fontSize < 4 {
+			break
+		}
+		_ = face.Close()
+	}
+	defer func() { _ = face.Close() }()
+
+	width := min(max(textWidth+height/2, height), maxWidth)
+
+	var origin image.Point
+	switch badge.Position {
+	case BadgePositionTopRight:
+		origin = image.Pt(size-margin-width, margin)
+	case BadgePositionBottomLeft:
+		origin = image.Pt(margin, size-margin-height)
+	case BadgePositionBottomRight:
+		origin = image.Pt(size-margin-width, size-margin-height)
+	default:
+		origin = image.Pt(margin, margin)
+	}
+	rect := image.Rectangle{Min: origin, Max: origin.Add(image.Pt(width, height))}
+
+	draw.DrawMask(dst, rect, image.NewUniform(badge.Color), image.Point{}, &pillMask{rect: rect}, rect.Min, draw.Over)
+
+	metrics := face.Metrics()
+	baseline := rect.Min.Y + (height+metrics.Ascent.Ceil()-metrics.Descent.Ceil())/2
+	drawer := &font.Drawer{
+		Dst:  dst,
+		Src:  image.NewUniform(badge.TextColor),
+		Face: face,
+		Dot:  fixed.P(rect.Min.X+(width-textWidth)/2, baseline),
+	}
+	drawer.DrawString(badge.Text)
+
+	return nil
+}
+
+// pillMask is an anti-aliased alpha mask of a rectangle with fully rounded ends.
+type pillMask struct {
+	rect image.Rectangle
+}
+
+func (m *pillMask) ColorModel() color.Model { return color.AlphaModel }
+
+func (m *pillMask) Bounds() image.Rectangle { return m.rect }
+
+func (m *pillMask) At(x, y int) color.Color {
+	radius := float64(m.rect.Dy()) / 2
+	px, py := float64(x)+0.5, float64(y)+0.5
+	cx := math.Max(float64(m.rect.Min.X)+radius, math.Min(px, float64(m.rect.Max.X)-radius))
+	cy := float64(m.rect.Min.Y) + radius
+
+	coverage := radius - math.Hypot(px-cx, py-cy) + 0.5
+	return color.Alpha{A: uint8(math.Max(0, math.Min(1, coverage)) * 0xff)}
+}
+
+// BadgedIcon draws the badge onto a copy of the canvas and returns the result as a base64 encoded
+// PNG.
+func BadgedIcon(c *Canvas, badge Badge) (*string, error) {
+	rgba := c.prepared(1)
+	if err := DrawBadge(rgba, badge); err != nil {
+		return nil, err
+	}
+
+	encoded, err := Encode(rgba)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encoded, nil
+}
@@ -0,0 +1,86 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"image/color"
+	"testing"
+)
+
+func TestParseHexColor(t *testing.T) {
+	tests := []struct {
+		input string
+		want  color.RGBA
+	}{
+		{"#FF9500", color.RGBA{R: 0xff, G: 0x95, B: 0x00, A: 0xff}},
+		{"ffffff", color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}},
+		{"#FF000080", color.RGBA{R: 0x80, G: 0x00, B: 0x00, A: 0x80}},
+		{"#00000000", color.RGBA{}},
+	}
+
+	for _, tt := range tests {
+		got, err := ParseHexColor(tt.input)
+		if err != nil {
+			t.Errorf("ParseHexColor(%q) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseHexColor(%q) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestParseHexColor_Invalid(t *testing.T) {
+	for _, input := range []string{"", "#FFF", "#GGGGGG", "red", "#FF950012FF"} {
+		if _, err := ParseHexColor(input); err == nil {
+			t.Errorf("ParseHexColor(%q) expected error", input)
+		}
+	}
+}
+
+func TestBadgedIcon_Positions(t *testing.T) {
+	input := newTestCanvas(t, createTestPNG(t, 64, 64), Options{})
+	blue := color.RGBA{B: 0xff, A: 0xff}
+	red := color.RGBA{R: 0xff, A: 0xff}
+
+	// A pixel near the middle of the top and bottom edges is covered by a badge drawn on that
+	// edge's side of the icon, while the opposite corner keeps the base colour.
+	tests := []struct {
+		position      string
+		badged, plain [2]int
+	}{
+		{BadgePositionTopLeft, [2]int{40, 40}, [2]int{BaseImageSize - 40, BaseImageSize - 40}},
+		{BadgePositionTopRight, [2]int{BaseImageSize - 40, 40}, [2]int{40, BaseImageSize - 40}},
+		{BadgePositionBottomLeft, [2]int{40, BaseImageSize - 40}, [2]int{BaseImageSize - 40, 40}},
+		{BadgePositionBottomRight, [2]int{BaseImageSize - 40, BaseImageSize - 40}, [2]int{40, 40}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.position, func(t *testing.T) {
+			// Transparent text leaves the badge background visible at the sampled pixel.
+			result, err := BadgedIcon(input, Badge{Text: "BETA", Color: blue, Position: tt.position})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			img := decodeTestPNG(t, result)
+			if got := color.RGBAModel.Convert(img.At(tt.badged[0], tt.badged[1])); got != blue {
+				t.Errorf("expected badge colour at %v, got %v", tt.badged, got)
+			}
+			if got := color.RGBAModel.Convert(img.At(tt.plain[0], tt.plain[1])); got != red {
+				t.Errorf("expected base colour at %v, got %v", tt.plain, got)
+			}
+		})
+	}
+}
+
+func BenchmarkBadgedIcon(b *testing.B) {
+	input := createTestPNG(b, 1024, 1024)
+	badge := Badge{Text: "PILOT", Color: color.RGBA{R: 0xff, G: 0x95, A: 0xff}, TextColor: color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}}
+	for b.Loop() {
+		if _, err := BadgedIcon(newTestCanvas(b, input, Options{}), badge); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,49 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+
+	// Register the decoders for the artwork formats in fileFormats.
+	_ "image/gif"
+	_ "image/jpeg"
+
+	_ "golang.org/x/image/bmp"
+	_ "golang.org/x/image/webp"
+)
+
+// FileFormat describes how encoded artwork is named and labelled when it is written out as is.
+type FileFormat struct {
+	// Extension is the file extension, including the leading dot.
+	Extension string
+	// ContentType is the MIME type.
+	ContentType string
+}
+
+// fileFormats maps the format names reported by image.DecodeConfig to their file formats.
+var fileFormats = map[string]FileFormat{
+	"png":  {Extension: ".png", ContentType: "image/png"},
+	"jpeg": {Extension: ".jpg", ContentType: "image/jpeg"},
+	"gif":  {Extension: ".gif", ContentType: "image/gif"},
+	"webp": {Extension: ".webp", ContentType: "image/webp"},
+	"bmp":  {Extension: ".bmp", ContentType: "image/bmp"},
+}
+
+// DetectFormat returns the file format of the encoded image in data, as identified by the decoder
+// that accepts it, so the extension and content type always describe the same format.
+func DetectFormat(data []byte) (FileFormat, error) {
+	_, name, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return FileFormat{}, fmt.Errorf("error detecting image format: %w", err)
+	}
+
+	format, ok := fileFormats[name]
+	if !ok {
+		return FileFormat{}, fmt.Errorf("unsupported image format %q", name)
+	}
+	return format, nil
+}
@@ -0,0 +1,50 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"bytes"
+	"image"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	img := solidImage(16, 16)
+
+	tests := []struct {
+		name   string
+		encode func(*bytes.Buffer, image.Image) error
+		want   FileFormat
+	}{
+		{"png", func(w *bytes.Buffer, m image.Image) error { return png.Encode(w, m) }, FileFormat{Extension: ".png", ContentType: "image/png"}},
+		{"jpeg", func(w *bytes.Buffer, m image.Image) error { return jpeg.Encode(w, m, nil) }, FileFormat{Extension: ".jpg", ContentType: "image/jpeg"}},
+		{"gif", func(w *bytes.Buffer, m image.Image) error { return gif.Encode(w, m, nil) }, FileFormat{Extension: ".gif", ContentType: "image/gif"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := tt.encode(&buf, img); err != nil {
+				t.Fatalf("failed to encode test image: %v", err)
+			}
+
+			got, err := DetectFormat(buf.Bytes())
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestDetectFormat_InvalidImage(t *testing.T) {
+	if _, err := DetectFormat([]byte("not an image")); err == nil {
+		t.Fatal("expected error for invalid image data")
+	}
+}
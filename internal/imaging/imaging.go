@@ -0,0 +1,162 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package imaging renders title icons: it decodes catalog artwork, scales it with a single
+// Catmull-Rom backend, applies masks, padding, badges and the uninstall overlay, and encodes the
+// results as base64 PNGs.
+package imaging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+
+	"golang.org/x/image/draw"
+)
+
+// BaseImageSize is the size in pixels to which base images are resized before compositing.
+const BaseImageSize = 512
+
+// SelfServiceIconSize is the size in pixels of the square icons Jamf Pro recommends uploading for
+// Self Service, which it scales down for display.
+const SelfServiceIconSize = 512
+
+// IconSizes are the standard square sizes, in pixels, at which title icons are rendered.
+var IconSizes = []int{32, 64, 128, 256, 512}
+
+// Options controls how artwork is prepared before badges and overlays are drawn onto it, and which
+// overlay is drawn onto uninstall icons.
+type Options struct {
+	// Mask is the shape the artwork is clipped to, one of Masks. An empty mask leaves the artwork
+	// unchanged.
+	Mask string
+	// Padding is the transparent margin around the artwork, as a percentage of the icon size per
+	// side, between 0 and MaxPadding.
+	Padding int
+	// Background, when set, is the solid color transparent areas of the icon are flattened onto.
+	Background *color.RGBA
+	// Overlay, when set, replaces the built-in badge drawn onto uninstall icons.
+	Overlay *Overlay
+}
+
+// MaxPadding is the largest supported padding, as a percentage of the icon size per side.
+const MaxPadding = 25
+
+// Decode decodes a base64 encoded image.
+func Decode(imageB64 string) (image.Image, error) {
+	imageBytes, err := base64.StdEncoding.DecodeString(imageB64)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding base image: %w", err)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(imageBytes))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding base image bytes: %w", err)
+	}
+
+	return img, nil
+}
+
+// Scale resizes img to a size x size square.
+func Scale(img image.Image, size int) *image.RGBA {
+	scaled := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.CatmullRom.Scale(scaled, scaled.Bounds(), img, img.Bounds(), draw.Over, nil)
+	return scaled
+}
+
+// Prepare scales img to fit a size x size square inset by the configured padding, applies the
+// configured mask and flattens it onto the configured background, ready for badges and overlays to
+// be drawn onto it.
+func Prepare(img image.Image, size int, opts Options) *image.RGBA {
+	inset := size * opts.Padding / 100
+	artwork := ApplyMask(Scale(img, size-2*inset), opts.Mask)
+	if inset == 0 && opts.Background == nil {
+		return artwork
+	}
+
+	canvas := image.NewRGBA(image.Rect(0, 0, size, size))
+	if opts.Background != nil {
+		draw.Draw(canvas, canvas.Bounds(), image.NewUniform(*opts.Background), image.Point{}, draw.Src)
+	}
+	draw.Draw(canvas, artwork.Bounds().Add(image.Pt(inset, inset)), artwork, image.Point{}, draw.Over)
+	return canvas
+}
+
+// Encode encodes img as a base64 encoded PNG.
+func Encode(img image.Image) (string, error) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", fmt.Errorf("error encoding processed image: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// Canvas is catalog artwork decoded and prepared at BaseImageSize once, so every icon variant of a
+// title is rendered from the same preparation.
+type Canvas struct {
+	source image.Image
+	base   *image.RGBA
+	opts   Options
+}
+
+// NewCanvas decodes a base64 encoded image and prepares it at BaseImageSize with opts.
+func NewCanvas(imageB64 string, opts Options) (*Canvas, error) {
+	img, err := Decode(imageB64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Canvas{source: img, base: Prepare(img, BaseImageSize, opts), opts: opts}, nil
+}
+
+// prepared returns a copy of the artwork prepared at BaseImageSize multiplied by scale that badges
+// and overlays can be drawn onto. The source is only prepared again for scales other than 1.
+func (c *Canvas) prepared(scale int) *image.RGBA {
+	if scale != 1 {
+		return Prepare(c.source, BaseImageSize*scale, c.opts)
+	}
+
+	rgba := image.NewRGBA(c.base.Bounds())
+	copy(rgba.Pix, c.base.Pix)
+	return rgba
+}
+
+// RenderSizes renders the canvas at each of the given sizes and returns the base64 encoded PNGs
+// keyed by size in pixels. Each size is prepared from the source artwork with the canvas options.
+func RenderSizes(c *Canvas, sizes []int) (map[string]string, error) {
+	icons := make(map[string]string, len(sizes))
+	for _, size := range sizes {
+		img := c.base
+		if size != BaseImageSize {
+			img = Prepare(c.source, size, c.opts)
+		}
+
+		encoded, err := Encode(img)
+		if err != nil {
+			return nil, err
+		}
+		icons[strconv.Itoa(size)] = encoded
+	}
+
+	return icons, nil
+}
+
+// SelfServiceIcon returns the canvas at SelfServiceIconSize as a base64 encoded PNG.
+func SelfServiceIcon(c *Canvas) (*string, error) {
+	img := c.base
+	if SelfServiceIconSize != BaseImageSize {
+		img = Prepare(c.source, SelfServiceIconSize, c.opts)
+	}
+
+	encoded, err := Encode(img)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encoded, nil
+}
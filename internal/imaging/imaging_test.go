@@ -0,0 +1,212 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"strconv"
+	"testing"
+)
+
+// createTestPNG generates a solid red PNG image of the given dimensions and returns it as a base64 string.
+func createTestPNG(tb testing.TB, width, height int) string {
+	tb.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, solidImage(width, height)); err != nil {
+		tb.Fatalf("failed to encode test PNG: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+// solidImage returns a solid red image of the given dimensions.
+func solidImage(width, height int) *image.RGBA {
+	return solidColorImage(width, height, color.RGBA{R: 255, A: 255})
+}
+
+// solidColorImage returns an image of the given dimensions filled with c.
+func solidColorImage(width, height int, c color.RGBA) *image.RGBA {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.SetRGBA(x, y, c)
+		}
+	}
+	return img
+}
+
+// decodeTestPNG decodes a base64 encoded PNG produced by the icon pipeline.
+// newTestCanvas prepares a base64 encoded image as a Canvas, failing the test on error.
+func newTestCanvas(tb testing.TB, imageB64 string, opts Options) *Canvas {
+	tb.Helper()
+	c, err := NewCanvas(imageB64, opts)
+	if err != nil {
+		tb.Fatalf("unexpected error: %v", err)
+	}
+	return c
+}
+
+func decodeTestPNG(t *testing.T, encoded *string) image.Image {
+	t.Helper()
+	if encoded == nil {
+		t.Fatal("expected non-nil result")
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(*encoded)
+	if err != nil {
+		t.Fatalf("result is not valid base64: %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(decoded))
+	if err != nil {
+		t.Fatalf("result is not valid PNG: %v", err)
+	}
+	return img
+}
+
+func TestDecode_Invalid(t *testing.T) {
+	if _, err := Decode("not-valid-base64!!!"); err == nil {
+		t.Error("expected error for invalid base64")
+	}
+	if _, err := Decode(base64.StdEncoding.EncodeToString([]byte("not an image"))); err == nil {
+		t.Error("expected error for invalid image data")
+	}
+}
+
+func TestScale(t *testing.T) {
+	scaled := Scale(solidImage(64, 32), 100)
+	if got := scaled.Bounds(); got != image.Rect(0, 0, 100, 100) {
+		t.Errorf("expected 100x100 image, got %v", got)
+	}
+}
+
+func TestPrepare_Padding(t *testing.T) {
+	padded := Prepare(solidImage(64, 64), 100, Options{Padding: 10})
+	if got := padded.Bounds(); got != image.Rect(0, 0, 100, 100) {
+		t.Fatalf("expected 100x100 icon, got %v", got)
+	}
+	if a := padded.RGBAAt(5, 5).A; a != 0 {
+		t.Errorf("expected transparent margin, got alpha %d", a)
+	}
+	if got := padded.RGBAAt(50, 50); got != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("expected opaque artwork, got %v", got)
+	}
+	if a := padded.RGBAAt(10, 10).A; a == 0 {
+		t.Error("expected artwork to start at the padding inset")
+	}
+}
+
+func TestSelfServiceIcon(t *testing.T) {
+	icon, err := SelfServiceIcon(newTestCanvas(t, createTestPNG(t, 1024, 1024), Options{Mask: MaskSquircle}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	img := decodeTestPNG(t, icon)
+	if bounds := img.Bounds(); bounds.Dx() != SelfServiceIconSize || bounds.Dy() != SelfServiceIconSize {
+		t.Errorf("expected %dx%d icon, got %dx%d", SelfServiceIconSize, SelfServiceIconSize, bounds.Dx(), bounds.Dy())
+	}
+	if _, _, _, a := img.At(0, 0).RGBA(); a != 0 {
+		t.Errorf("expected mask to be applied, got corner alpha %d", a)
+	}
+}
+
+func TestNewCanvas_InvalidBase64(t *testing.T) {
+	if _, err := NewCanvas("not-valid-base64!!!", Options{}); err == nil {
+		t.Fatal("expected error for invalid base64")
+	}
+}
+
+func TestNewCanvas_InvalidImageData(t *testing.T) {
+	input := base64.StdEncoding.EncodeToString([]byte("not an image"))
+	if _, err := NewCanvas(input, Options{}); err == nil {
+		t.Fatal("expected error for invalid image data")
+	}
+}
+
+func TestCanvas_RendersDoNotShareArtwork(t *testing.T) {
+	c := newTestCanvas(t, createTestPNG(t, 64, 64), Options{})
+	if _, err := BadgedIcon(c, Badge{Text: "BETA", Color: color.RGBA{B: 0xff, A: 0xff}, Position: BadgePositionBottomRight}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, _, err := UninstallIcons(c); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	icon, err := SelfServiceIcon(c)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	red := color.RGBA{R: 255, A: 255}
+	if got := color.RGBAModel.Convert(decodeTestPNG(t, icon).At(BaseImageSize-1, BaseImageSize-1)); got != red {
+		t.Errorf("expected badges and overlays to leave the canvas unchanged, got %v", got)
+	}
+}
+
+func TestPrepare_Background(t *testing.T) {
+	white := color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}
+	flattened := Prepare(solidImage(64, 64), 100, Options{Mask: MaskSquircle, Padding: 10, Background: &white})
+
+	if got := flattened.RGBAAt(2, 2); got != white {
+		t.Errorf("expected background in the padding, got %v", got)
+	}
+	if got := flattened.RGBAAt(11, 11); got.A != 0xff {
+		t.Errorf("expected masked corner to be opaque, got %v", got)
+	}
+	if got := flattened.RGBAAt(50, 50); got != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("expected artwork in the centre, got %v", got)
+	}
+}
+
+func TestRenderSizes(t *testing.T) {
+	icons, err := RenderSizes(newTestCanvas(t, createTestPNG(t, 100, 100), Options{}), IconSizes)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(icons) != len(IconSizes) {
+		t.Fatalf("expected %d icons, got %d", len(IconSizes), len(icons))
+	}
+
+	for _, size := range IconSizes {
+		key := strconv.Itoa(size)
+		encoded, ok := icons[key]
+		if !ok {
+			t.Fatalf("missing icon for size %s", key)
+		}
+
+		if bounds := decodeTestPNG(t, &encoded).Bounds(); bounds.Dx() != size || bounds.Dy() != size {
+			t.Errorf("expected %dx%d icon, got %dx%d", size, size, bounds.Dx(), bounds.Dy())
+		}
+	}
+}
+
+func TestRenderSizes_AppliesOptions(t *testing.T) {
+	background := color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	canvas := newTestCanvas(t, createTestPNG(t, 100, 100), Options{Mask: MaskSquircle, Padding: 10, Background: &background})
+	icons, err := RenderSizes(canvas, []int{64, BaseImageSize})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, size := range []int{64, BaseImageSize} {
+		encoded := icons[strconv.Itoa(size)]
+		img := decodeTestPNG(t, &encoded)
+		if got := color.RGBAModel.Convert(img.At(0, 0)); got != background {
+			t.Errorf("size %d: expected padded corner flattened onto the background, got %v", size, got)
+		}
+	}
+}
+
+func BenchmarkRenderSizes(b *testing.B) {
+	canvas := newTestCanvas(b, createTestPNG(b, 1024, 1024), Options{})
+	for b.Loop() {
+		if _, err := RenderSizes(canvas, IconSizes); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
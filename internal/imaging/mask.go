@@ -0,0 +1,67 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"math"
+	"sync"
+
+	"golang.org/x/image/draw"
+)
+
+// Supported masks.
+const (
+	MaskNone     = "none"
+	MaskSquircle = "squircle"
+)
+
+// Masks lists the supported masks.
+var Masks = []string{MaskNone, MaskSquircle}
+
+// squircleExponent is the superellipse exponent that approximates the continuous-corner shape of
+// macOS app icons.
+const squircleExponent = 5
+
+// squircleMasks caches rendered squircle masks by size, since every title is masked at the same
+// few sizes.
+var squircleMasks sync.Map
+
+// squircleMask returns an anti-aliased alpha mask of a superellipse filling a size x size square.
+func squircleMask(size int) *image.Alpha {
+	if cached, ok := squircleMasks.Load(size); ok {
+		return cached.(*image.Alpha)
+	}
+
+	mask := image.NewAlpha(image.Rect(0, 0, size, size))
+	half := float64(size) / 2
+	for y := range size {
+		v := math.Abs((float64(y) + 0.5 - half) / half)
+		for x := range size {
+			u := math.Abs((float64(x) + 0.5 - half) / half)
+			// The superellipse norm is 1 on the edge, so scaling the distance from the edge by the
+			// half size gives an approximate distance in pixels for one pixel of anti-aliasing.
+			norm := math.Pow(math.Pow(u, squircleExponent)+math.Pow(v, squircleExponent), 1.0/squircleExponent)
+			coverage := (1-norm)*half + 0.5
+			mask.SetAlpha(x, y, color.Alpha{A: uint8(math.Max(0, math.Min(1, coverage)) * 0xff)})
+		}
+	}
+
+	actual, _ := squircleMasks.LoadOrStore(size, mask)
+	return actual.(*image.Alpha)
+}
+
+// ApplyMask returns img clipped to the named mask. The image is returned unchanged for MaskNone
+// or an empty mask name.
+func ApplyMask(img *image.RGBA, mask string) *image.RGBA {
+	if mask != MaskSquircle {
+		return img
+	}
+
+	bounds := img.Bounds()
+	masked := image.NewRGBA(bounds)
+	draw.DrawMask(masked, bounds, img, bounds.Min, squircleMask(bounds.Dx()), image.Point{}, draw.Src)
+	return masked
+}
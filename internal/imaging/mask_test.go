@@ -0,0 +1,32 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestApplyMask_Squircle(t *testing.T) {
+	masked := ApplyMask(solidImage(64, 64), MaskSquircle)
+	if a := masked.RGBAAt(0, 0).A; a != 0 {
+		t.Errorf("expected transparent corner, got alpha %d", a)
+	}
+	if got := masked.RGBAAt(32, 32); got != (color.RGBA{R: 255, A: 255}) {
+		t.Errorf("expected opaque center, got %v", got)
+	}
+	if a := masked.RGBAAt(32, 0).A; a == 0 {
+		t.Error("expected edge midpoint to be covered by the mask")
+	}
+}
+
+func TestApplyMask_None(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 8, 8))
+	for _, mask := range []string{MaskNone, ""} {
+		if got := ApplyMask(img, mask); got != img {
+			t.Errorf("expected image to be returned unchanged for mask %q", mask)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"bytes"
+	_ "embed"
+	"fmt"
+	"image"
+	"os"
+	"sync"
+
+	"golang.org/x/image/draw"
+)
+
+// OverlaySize is the size in pixels to which the overlay badge is resized before compositing.
+const OverlaySize = 128
+
+// uninstallOverlayPNG is the built-in overlay badge used to generate uninstall icons.
+//
+//go:embed assets/uninstall_overlay.png
+var uninstallOverlayPNG []byte
+
+// Overlay is a badge image composited onto icons. Scaled renderings are cached, so an Overlay
+// should be reused for every icon it is drawn onto.
+type Overlay struct {
+	image image.Image
+
+	// scaled caches the overlay scaled to each size it is composited at, so it is rescaled once
+	// per size rather than once per title.
+	scaled sync.Map
+}
+
+// NewOverlay decodes an overlay badge from image data in any registered format.
+func NewOverlay(data []byte) (*Overlay, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error decoding overlay image: %w", err)
+	}
+	return &Overlay{image: img}, nil
+}
+
+// LoadOverlayFile reads and decodes an overlay badge from the image file at path.
+func LoadOverlayFile(path string) (*Overlay, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading overlay image: %w", err)
+	}
+	return NewOverlay(data)
+}
+
+// scaledTo returns the overlay scaled to a size x size square. The result is shared between
+// callers and must not be modified.
+func (o *Overlay) scaledTo(size int) *image.RGBA {
+	if cached, ok := o.scaled.Load(size); ok {
+		return cached.(*image.RGBA)
+	}
+
+	actual, _ := o.scaled.LoadOrStore(size, Scale(o.image, size))
+	return actual.(*image.RGBA)
+}
+
+var (
+	defaultUninstallOverlayOnce sync.Once
+	defaultUninstallOverlay     *Overlay
+	defaultUninstallOverlayErr  error
+)
+
+// uninstallOverlay returns the overlay set in opts, decoding the built-in one on first use when
+// none is set.
+func uninstallOverlay(opts Options) (*Overlay, error) {
+	if opts.Overlay != nil {
+		return opts.Overlay, nil
+	}
+
+	defaultUninstallOverlayOnce.Do(func() {
+		defaultUninstallOverlay, defaultUninstallOverlayErr = NewOverlay(uninstallOverlayPNG)
+	})
+	return defaultUninstallOverlay, defaultUninstallOverlayErr
+}
+
+// UninstallIcons generates uninstall icons from the canvas by adding an uninstall overlay to the
+// bottom right corner. It returns base64 encoded PNGs at the standard size and at double resolution
+// for retina displays, with the overlay scaled to match.
+func UninstallIcons(c *Canvas) (icon, icon2x *string, err error) {
+	overlay, err := uninstallOverlay(c.opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	icon, err = renderUninstallIcon(c.prepared(1), overlay, 1)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	icon2x, err = renderUninstallIcon(c.prepared(2), overlay, 2)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return icon, icon2x, nil
+}
+
+// renderUninstallIcon composites the overlay badge at OverlaySize multiplied by scale onto the
+// bottom right corner of rgba and returns the result as a base64 encoded PNG.
+func renderUninstallIcon(rgba *image.RGBA, overlay *Overlay, scale int) (*string, error) {
+	bounds := rgba.Bounds()
+
+	resizedOverlay := overlay.scaledTo(OverlaySize * scale)
+	overlayBounds := resizedOverlay.Bounds()
+
+	x := bounds.Max.X - overlayBounds.Dx()
+	y := bounds.Max.Y - overlayBounds.Dy()
+
+	offset := image.Point{X: x, Y: y}
+
+	draw.Draw(rgba, image.Rectangle{
+		Min: offset,
+		Max: offset.Add(overlayBounds.Size()),
+	}, resizedOverlay, image.Point{}, draw.Over)
+
+	encoded, err := Encode(rgba)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encoded, nil
+}
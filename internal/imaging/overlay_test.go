@@ -0,0 +1,153 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package imaging
+
+import (
+	"bytes"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestUninstallIcons_ValidPNG(t *testing.T) {
+	input := createTestPNG(t, 64, 64)
+	icon, icon2x, err := UninstallIcons(newTestCanvas(t, input, Options{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	bounds := decodeTestPNG(t, icon).Bounds()
+	if bounds.Dx() != BaseImageSize || bounds.Dy() != BaseImageSize {
+		t.Errorf("expected %dx%d image, got %dx%d", BaseImageSize, BaseImageSize, bounds.Dx(), bounds.Dy())
+	}
+
+	bounds2x := decodeTestPNG(t, icon2x).Bounds()
+	if bounds2x.Dx() != 2*BaseImageSize || bounds2x.Dy() != 2*BaseImageSize {
+		t.Errorf("expected %dx%d retina image, got %dx%d", 2*BaseImageSize, 2*BaseImageSize, bounds2x.Dx(), bounds2x.Dy())
+	}
+}
+
+func TestUninstallIcons_RetinaOverlayScaled(t *testing.T) {
+	input := createTestPNG(t, 64, 64)
+	_, icon2x, err := UninstallIcons(newTestCanvas(t, input, Options{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	img := decodeTestPNG(t, icon2x)
+
+	// The overlay covers the bottom right OverlaySize*2 square, so a pixel just inside that square
+	// differs from the solid red base while one just outside it does not.
+	inside := img.At(2*BaseImageSize-OverlaySize, 2*BaseImageSize-OverlaySize)
+	outside := img.At(2*BaseImageSize-2*OverlaySize-1, 2*BaseImageSize-2*OverlaySize-1)
+	red := color.RGBAModel.Convert(color.RGBA{R: 255, A: 255})
+	if color.RGBAModel.Convert(outside) != red {
+		t.Errorf("expected base colour outside the overlay, got %v", outside)
+	}
+	if color.RGBAModel.Convert(inside) == red {
+		t.Error("expected overlay to cover the bottom right corner")
+	}
+}
+
+func TestUninstallIcons_SquircleMask(t *testing.T) {
+	input := createTestPNG(t, 64, 64)
+	icon, _, err := UninstallIcons(newTestCanvas(t, input, Options{Mask: MaskSquircle}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	img := decodeTestPNG(t, icon)
+	if _, _, _, a := img.At(0, 0).RGBA(); a != 0 {
+		t.Errorf("expected transparent top left corner, got alpha %d", a)
+	}
+}
+
+func TestUninstallOverlay_Embedded(t *testing.T) {
+	img, err := png.Decode(bytes.NewReader(uninstallOverlayPNG))
+	if err != nil {
+		t.Fatalf("embedded overlay is not valid PNG: %v", err)
+	}
+
+	bounds := img.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 {
+		t.Error("overlay image has zero dimensions")
+	}
+}
+
+func TestOverlay_ScaledCached(t *testing.T) {
+	overlay, err := uninstallOverlay(Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	first := overlay.scaledTo(OverlaySize)
+	if first.Bounds().Dx() != OverlaySize {
+		t.Errorf("expected %dpx overlay, got %d", OverlaySize, first.Bounds().Dx())
+	}
+	if second := overlay.scaledTo(OverlaySize); first != second {
+		t.Error("expected the scaled overlay to be reused")
+	}
+}
+
+func TestUninstallIcons_CustomOverlay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "overlay.png")
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, solidColorImage(16, 16, color.RGBA{G: 255, A: 255})); err != nil {
+		t.Fatalf("failed to encode overlay: %v", err)
+	}
+	if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+		t.Fatalf("failed to write overlay: %v", err)
+	}
+
+	overlay, err := LoadOverlayFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	icon, _, err := UninstallIcons(newTestCanvas(t, createTestPNG(t, 64, 64), Options{Overlay: overlay}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	img := decodeTestPNG(t, icon)
+	green := color.RGBA{G: 255, A: 255}
+	if got := color.RGBAModel.Convert(img.At(BaseImageSize-1, BaseImageSize-1)); got != green {
+		t.Errorf("expected custom overlay colour in the bottom right corner, got %v", got)
+	}
+}
+
+func TestLoadOverlayFile_Invalid(t *testing.T) {
+	if _, err := LoadOverlayFile(filepath.Join(t.TempDir(), "missing.png")); err == nil {
+		t.Error("expected error for missing file")
+	}
+
+	path := filepath.Join(t.TempDir(), "overlay.png")
+	if err := os.WriteFile(path, []byte("not an image"), 0o600); err != nil {
+		t.Fatalf("failed to write overlay: %v", err)
+	}
+	if _, err := LoadOverlayFile(path); err == nil {
+		t.Error("expected error for invalid image data")
+	}
+}
+
+func BenchmarkUninstallIcons(b *testing.B) {
+	input := createTestPNG(b, 1024, 1024)
+	for b.Loop() {
+		if _, _, err := UninstallIcons(newTestCanvas(b, input, Options{})); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkUninstallIcons_SquirclePadded(b *testing.B) {
+	input := createTestPNG(b, 1024, 1024)
+	opts := Options{Mask: MaskSquircle, Padding: 10}
+	for b.Loop() {
+		if _, _, err := UninstallIcons(newTestCanvas(b, input, opts)); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package logging defines the tflog subsystems used by the provider, so the verbosity of HTTP
+// client, icon processing and state building logs can be controlled independently.
+package logging
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Subsystem names. Logs from a subsystem are tagged with the module provider.<name>, such as
+// provider.jamfautoupdate.icons, and the subsystem's level can be set with the
+// TF_LOG_PROVIDER_JAMFAUTOUPDATE_<NAME> environment variable, for example
+// TF_LOG_PROVIDER_JAMFAUTOUPDATE_ICONS=TRACE.
+const (
+	SubsystemClient = "jamfautoupdate.client"
+	SubsystemIcons  = "jamfautoupdate.icons"
+	SubsystemState  = "jamfautoupdate.state"
+)
+
+// envLevelPrefix is the prefix of the environment variables that set subsystem log levels.
+const envLevelPrefix = "TF_LOG_PROVIDER_JAMFAUTOUPDATE"
+
+// subsystems lists every subsystem with the suffix of its level environment variable.
+var subsystems = map[string]string{
+	SubsystemClient: "CLIENT",
+	SubsystemIcons:  "ICONS",
+	SubsystemState:  "STATE",
+}
+
+// WithSubsystems returns ctx with the provider's logging subsystems initialised. It must be called
+// at the start of every provider, resource and data source operation before logging to a subsystem.
+func WithSubsystems(ctx context.Context) context.Context {
+	for name, envSuffix := range subsystems {
+		ctx = tflog.NewSubsystem(ctx, name, tflog.WithLevelFromEnv(envLevelPrefix, envSuffix))
+	}
+	return ctx
+}
+
+// ClientDebug logs a message from the HTTP client subsystem at DEBUG level.
+func ClientDebug(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemDebug(ctx, SubsystemClient, msg, fields...)
+}
+
+// IconsDebug logs a message from the icon processing subsystem at DEBUG level.
+func IconsDebug(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemDebug(ctx, SubsystemIcons, msg, fields...)
+}
+
+// IconsTrace logs a message from the icon processing subsystem at TRACE level.
+func IconsTrace(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemTrace(ctx, SubsystemIcons, msg, fields...)
+}
+
+// StateDebug logs a message from the state building subsystem at DEBUG level.
+func StateDebug(ctx context.Context, msg string, fields ...map[string]any) {
+	tflog.SubsystemDebug(ctx, SubsystemState, msg, fields...)
+}
@@ -0,0 +1,66 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package logging
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestWithSubsystems_LogsToEachSubsystem(t *testing.T) {
+	var output bytes.Buffer
+	ctx := WithSubsystems(tflogtest.RootLogger(context.Background(), &output))
+
+	ClientDebug(ctx, "client message")
+	IconsDebug(ctx, "icons message")
+	IconsTrace(ctx, "icons trace")
+	StateDebug(ctx, "state message")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	modules := map[string]string{}
+	for _, entry := range entries {
+		modules[entry["@message"].(string)] = entry["@module"].(string)
+	}
+
+	for message, subsystem := range map[string]string{
+		"client message": SubsystemClient,
+		"icons message":  SubsystemIcons,
+		"icons trace":    SubsystemIcons,
+		"state message":  SubsystemState,
+	} {
+		module, ok := modules[message]
+		if !ok {
+			t.Errorf("expected %q to be logged", message)
+			continue
+		}
+		if module != "provider."+subsystem {
+			t.Errorf("expected %q to be logged by the %s subsystem, got module %q", message, subsystem, module)
+		}
+	}
+}
+
+func TestWithSubsystems_LevelFromEnv(t *testing.T) {
+	t.Setenv(envLevelPrefix+"_ICONS", "ERROR")
+
+	var output bytes.Buffer
+	ctx := WithSubsystems(tflogtest.RootLogger(context.Background(), &output))
+
+	IconsDebug(ctx, "suppressed")
+	StateDebug(ctx, "kept")
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 || entries[0]["@message"] != "kept" {
+		t.Errorf("expected only the state message, got %v", entries)
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package markup converts the HTML and Markdown found in catalog text fields to plain text.
+package markup
+
+import (
+	"html"
+	"regexp"
+	"strings"
+)
+
+var (
+	// HTML patterns, applied in order.
+	autolinkPattern    = regexp.MustCompile(`<((?:https?|mailto):[^>\s]+)>`)
+	commentPattern     = regexp.MustCompile(`(?s)<!--.*?-->`)
+	scriptPattern      = regexp.MustCompile(`(?is)<(script|style)\b[^>]*>.*?</(?:script|style)\s*>`)
+	blockTagPattern    = regexp.MustCompile(`(?i)</?(?:p|br|div|h[1-6]|li|ul|ol|tr|table|blockquote|pre)\b[^>]*>`)
+	sourceNewline      = regexp.MustCompile(`\s*\n\s*`)
+	lineBreakPattern   = regexp.MustCompile(`(?i)<br\s*/?>`)
+	paragraphEnd       = regexp.MustCompile(`(?i)</(?:p|h[1-6]|ul|ol|table|blockquote|pre)\s*>`)
+	lineEnd            = regexp.MustCompile(`(?i)</(?:div|tr)\s*>`)
+	listItemPattern    = regexp.MustCompile(`(?i)<li\b[^>]*>`)
+	tagPattern         = regexp.MustCompile(`</?[a-zA-Z][a-zA-Z0-9-]*(?:\s[^>]*)?/?>`)
+	horizontalSpace    = regexp.MustCompile(`[ \t\f\v]+`)
+	repeatedBlankLines = regexp.MustCompile(`\n{3,}`)
+
+	// Markdown block patterns, applied to each line.
+	fencePattern          = regexp.MustCompile("^\\s*(```|~~~)")
+	headingPattern        = regexp.MustCompile(`^\s{0,3}#{1,6}\s+(.*?)(?:\s+#+)?\s*$`)
+	blockquotePattern     = regexp.MustCompile(`^\s*(?:>\s?)+`)
+	bulletPattern         = regexp.MustCompile(`^(\s*)[*+-]\s+`)
+	horizontalRulePattern = regexp.MustCompile(`^\s*(?:[-*_]\s*){3,}$`)
+
+	// Markdown inline patterns, applied in order.
+	imagePattern       = regexp.MustCompile(`!\[([^\]]*)\]\([^)]*\)`)
+	linkPattern        = regexp.MustCompile(`\[([^\]]+)\]\([^)]*\)`)
+	codePattern        = regexp.MustCompile("`([^`]+)`")
+	boldStarPattern    = regexp.MustCompile(`\*\*(\S(?:[^*]*\S)?)\*\*`)
+	boldUnderPattern   = regexp.MustCompile(`__(\S(?:[^_]*\S)?)__`)
+	italicStarPattern  = regexp.MustCompile(`\*(\S(?:[^*]*\S)?)\*`)
+	italicUnderPattern = regexp.MustCompile(`(^|[^\p{L}\p{N}_])_(\S(?:[^_]*\S)?)_([^\p{L}\p{N}_]|$)`)
+	strikePattern      = regexp.MustCompile(`~~(\S(?:[^~]*\S)?)~~`)
+)
+
+// Strip returns s with HTML tags and Markdown formatting removed. Block elements and line breaks
+// become newlines, list items are written as "- " bullets, links are replaced by their text and
+// HTML entities are decoded. Runs of spaces are collapsed and at most one blank line is kept
+// between paragraphs.
+func Strip(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", "\n")
+	s = stripHTML(s)
+	s = stripMarkdown(s)
+	return tidy(s)
+}
+
+// stripHTML removes HTML tags, turning block elements into line breaks, and decodes entities.
+// Text laid out with HTML block elements has its source line breaks collapsed, as a browser would.
+func stripHTML(s string) string {
+	s = autolinkPattern.ReplaceAllString(s, "$1")
+	s = commentPattern.ReplaceAllString(s, "")
+	s = scriptPattern.ReplaceAllString(s, "")
+	if blockTagPattern.MatchString(s) {
+		s = sourceNewline.ReplaceAllString(s, " ")
+	}
+	s = lineBreakPattern.ReplaceAllString(s, "\n")
+	s = paragraphEnd.ReplaceAllString(s, "\n\n")
+	s = lineEnd.ReplaceAllString(s, "\n")
+	s = listItemPattern.ReplaceAllString(s, "\n- ")
+	s = tagPattern.ReplaceAllString(s, "")
+	return html.UnescapeString(s)
+}
+
+// stripMarkdown removes Markdown block and inline formatting, line by line.
+func stripMarkdown(s string) string {
+	lines := strings.Split(s, "\n")
+	kept := make([]string, 0, len(lines))
+	inFence := false
+
+	for _, line := range lines {
+		if fencePattern.MatchString(line) {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			kept = append(kept, line)
+			continue
+		}
+		if horizontalRulePattern.MatchString(line) {
+			kept = append(kept, "")
+			continue
+		}
+
+		line = headingPattern.ReplaceAllString(line, "$1")
+		line = blockquotePattern.ReplaceAllString(line, "")
+		line = bulletPattern.ReplaceAllString(line, "$1- ")
+		kept = append(kept, stripInline(line))
+	}
+
+	return strings.Join(kept, "\n")
+}
+
+// stripInline removes Markdown inline formatting from a single line.
+func stripInline(line string) string {
+	line = imagePattern.ReplaceAllString(line, "$1")
+	line = linkPattern.ReplaceAllString(line, "$1")
+	line = codePattern.ReplaceAllString(line, "$1")
+	line = boldStarPattern.ReplaceAllString(line, "$1")
+	line = boldUnderPattern.ReplaceAllString(line, "$1")
+	line = italicStarPattern.ReplaceAllString(line, "$1")
+	line = italicUnderPattern.ReplaceAllString(line, "$1$2$3")
+	return strikePattern.ReplaceAllString(line, "$1")
+}
+
+// tidy collapses runs of spaces, trims each line and keeps at most one blank line in a row.
+func tidy(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimSpace(horizontalSpace.ReplaceAllString(line, " "))
+	}
+	s = strings.Join(lines, "\n")
+	s = repeatedBlankLines.ReplaceAllString(s, "\n\n")
+	return strings.TrimSpace(s)
+}
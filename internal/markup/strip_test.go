@@ -0,0 +1,66 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package markup
+
+import "testing"
+
+func TestStrip(t *testing.T) {
+	tests := map[string]struct {
+		input string
+		want  string
+	}{
+		"plain text": {
+			"Google Chrome is a fast web browser.",
+			"Google Chrome is a fast web browser.",
+		},
+		"html paragraphs": {
+			"<p>Fast &amp; <b>secure</b>.</p><p>Second<br/>line</p>",
+			"Fast & secure.\n\nSecond\nline",
+		},
+		"html list": {
+			"<p>Features:</p>\n<ul>\n  <li>One</li>\n  <li>Two</li>\n</ul>\n<p>Done</p>",
+			"Features:\n\n- One\n- Two\n\nDone",
+		},
+		"html script and comment": {
+			"Safe<script>alert(1)</script><!-- hidden --> text",
+			"Safe text",
+		},
+		"comparison is not a tag": {
+			"Requires macOS < 15 & RAM > 8 GB",
+			"Requires macOS < 15 & RAM > 8 GB",
+		},
+		"markdown heading and emphasis": {
+			"## Overview\n\nA **bold**, *italic*, __strong__ and _emphasised_ ~~old~~ `cli` tool.",
+			"Overview\n\nA bold, italic, strong and emphasised old cli tool.",
+		},
+		"snake case is kept": {
+			"Reads the my_app_config file.",
+			"Reads the my_app_config file.",
+		},
+		"markdown links and images": {
+			"See [the docs](https://example.com/docs) ![logo](logo.png) or <https://example.com>.",
+			"See the docs logo or https://example.com.",
+		},
+		"markdown lists and quotes": {
+			"* One\n+ Two\n> Quoted\n\n---\n\n1. Numbered",
+			"- One\n- Two\nQuoted\n\n1. Numbered",
+		},
+		"code fence": {
+			"Install:\n```sh\nbrew install app\n```",
+			"Install:\nbrew install app",
+		},
+		"whitespace": {
+			"  Too   many\t spaces \r\n\n\n\nand lines  ",
+			"Too many spaces\n\nand lines",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Strip(tt.input); got != tt.want {
+				t.Errorf("Strip(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,251 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package plist decodes and encodes XML property lists, the format of Apple configuration profiles.
+//
+// Values are represented as map[string]any for dict, []any for array, string, int64 for integer,
+// float64 for real, bool, []byte for data and time.Time for date.
+package plist
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Decode parses an XML property list and returns its root value.
+func Decode(data []byte) (any, error) {
+	decoder := xml.NewDecoder(bytes.NewReader(data))
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil, errors.New("property list has no root element")
+			}
+			return nil, fmt.Errorf("error reading property list: %w", err)
+		}
+
+		start, ok := token.(xml.StartElement)
+		if !ok {
+			continue
+		}
+		if start.Name.Local != "plist" {
+			return nil, fmt.Errorf("expected a plist root element, found <%s>", start.Name.Local)
+		}
+
+		value, err := decodeContainer(decoder, "plist")
+		if err != nil {
+			return nil, err
+		}
+		if err := expectEOF(decoder); err != nil {
+			return nil, err
+		}
+		return value, nil
+	}
+}
+
+// decodeContainer decodes the single value inside the <plist> element.
+func decodeContainer(decoder *xml.Decoder, name string) (any, error) {
+	var value any
+	found := false
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("error reading property list: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if found {
+				return nil, fmt.Errorf("<%s> must contain a single value", name)
+			}
+			value, err = decodeValue(decoder, t)
+			if err != nil {
+				return nil, err
+			}
+			found = true
+		case xml.EndElement:
+			if !found {
+				return nil, fmt.Errorf("<%s> is empty", name)
+			}
+			return value, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return nil, fmt.Errorf("unexpected text in <%s>", name)
+			}
+		}
+	}
+}
+
+// decodeValue decodes the value introduced by start.
+func decodeValue(decoder *xml.Decoder, start xml.StartElement) (any, error) {
+	switch start.Name.Local {
+	case "dict":
+		return decodeDict(decoder)
+	case "array":
+		return decodeArray(decoder)
+	case "true", "false":
+		if err := decoder.Skip(); err != nil {
+			return nil, fmt.Errorf("error reading <%s>: %w", start.Name.Local, err)
+		}
+		return start.Name.Local == "true", nil
+	}
+
+	text, err := readText(decoder, start.Name.Local)
+	if err != nil {
+		return nil, err
+	}
+
+	switch start.Name.Local {
+	case "string":
+		return text, nil
+	case "integer":
+		n, err := strconv.ParseInt(strings.TrimSpace(text), 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid <integer> %q", text)
+		}
+		return n, nil
+	case "real":
+		f, err := strconv.ParseFloat(strings.TrimSpace(text), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid <real> %q", text)
+		}
+		return f, nil
+	case "data":
+		data, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(text), ""))
+		if err != nil {
+			return nil, fmt.Errorf("invalid <data>: %w", err)
+		}
+		return data, nil
+	case "date":
+		date, err := time.Parse(time.RFC3339, strings.TrimSpace(text))
+		if err != nil {
+			return nil, fmt.Errorf("invalid <date> %q", text)
+		}
+		return date.UTC(), nil
+	default:
+		return nil, fmt.Errorf("unsupported property list element <%s>", start.Name.Local)
+	}
+}
+
+// decodeDict decodes the contents of a <dict> element.
+func decodeDict(decoder *xml.Decoder) (map[string]any, error) {
+	dict := map[string]any{}
+	var key *string
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("error reading <dict>: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if key == nil {
+				if t.Name.Local != "key" {
+					return nil, fmt.Errorf("expected <key> in <dict>, found <%s>", t.Name.Local)
+				}
+				text, err := readText(decoder, "key")
+				if err != nil {
+					return nil, err
+				}
+				key = &text
+				continue
+			}
+
+			value, err := decodeValue(decoder, t)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", *key, err)
+			}
+			dict[*key] = value
+			key = nil
+		case xml.EndElement:
+			if key != nil {
+				return nil, fmt.Errorf("<key> %q in <dict> has no value", *key)
+			}
+			return dict, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return nil, errors.New("unexpected text in <dict>")
+			}
+		}
+	}
+}
+
+// decodeArray decodes the contents of an <array> element.
+func decodeArray(decoder *xml.Decoder) ([]any, error) {
+	array := []any{}
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, fmt.Errorf("error reading <array>: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			value, err := decodeValue(decoder, t)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", len(array), err)
+			}
+			array = append(array, value)
+		case xml.EndElement:
+			return array, nil
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return nil, errors.New("unexpected text in <array>")
+			}
+		}
+	}
+}
+
+// readText reads the character data of a simple element up to its end tag.
+func readText(decoder *xml.Decoder, name string) (string, error) {
+	var text strings.Builder
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return "", fmt.Errorf("error reading <%s>: %w", name, err)
+		}
+
+		switch t := token.(type) {
+		case xml.CharData:
+			text.Write(t)
+		case xml.EndElement:
+			return text.String(), nil
+		case xml.StartElement:
+			return "", fmt.Errorf("unexpected <%s> in <%s>", t.Name.Local, name)
+		}
+	}
+}
+
+// expectEOF checks that nothing but whitespace, comments and processing instructions follow the root element.
+func expectEOF(decoder *xml.Decoder) error {
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("error reading property list: %w", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			return fmt.Errorf("unexpected <%s> after the plist root element", t.Name.Local)
+		case xml.CharData:
+			if len(bytes.TrimSpace(t)) > 0 {
+				return errors.New("unexpected text after the plist root element")
+			}
+		}
+	}
+}
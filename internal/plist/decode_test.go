@@ -0,0 +1,115 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plist
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testProfile = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>PayloadType</key>
+			<string>com.apple.TCC.configuration-profile-policy</string>
+			<key>PayloadVersion</key>
+			<integer>1</integer>
+			<key>Enabled</key>
+			<true/>
+		</dict>
+	</array>
+	<key>PayloadDisplayName</key>
+	<string>PPPC &amp; Notifications</string>
+	<key>PayloadRemovalDisallowed</key>
+	<false/>
+	<key>Ratio</key>
+	<real>0.5</real>
+	<key>Blob</key>
+	<data>
+	aGVs
+	bG8=
+	</data>
+	<key>Created</key>
+	<date>2026-01-02T03:04:05Z</date>
+</dict>
+</plist>`
+
+func TestDecode_Profile(t *testing.T) {
+	value, err := Decode([]byte(testProfile))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{
+		"PayloadContent": []any{
+			map[string]any{
+				"PayloadType":    "com.apple.TCC.configuration-profile-policy",
+				"PayloadVersion": int64(1),
+				"Enabled":        true,
+			},
+		},
+		"PayloadDisplayName":       "PPPC & Notifications",
+		"PayloadRemovalDisallowed": false,
+		"Ratio":                    0.5,
+		"Blob":                     []byte("hello"),
+		"Created":                  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+	if !reflect.DeepEqual(value, want) {
+		t.Errorf("unexpected value:\n got: %#v\nwant: %#v", value, want)
+	}
+}
+
+func TestDecode_EmptyContainers(t *testing.T) {
+	value, err := Decode([]byte(`<plist><dict><key>A</key><array/><key>B</key><dict/><key>C</key><string/></dict></plist>`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := map[string]any{"A": []any{}, "B": map[string]any{}, "C": ""}
+	if !reflect.DeepEqual(value, want) {
+		t.Errorf("unexpected value: %#v", value)
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	tests := map[string]string{
+		"not xml":              "hello",
+		"empty":                "",
+		"wrong root":           "<dict></dict>",
+		"empty plist":          "<plist></plist>",
+		"two roots":            "<plist><string>a</string><string>b</string></plist>",
+		"key without value":    "<plist><dict><key>A</key></dict></plist>",
+		"value without key":    "<plist><dict><string>a</string></dict></plist>",
+		"invalid integer":      "<plist><integer>one</integer></plist>",
+		"invalid real":         "<plist><real>half</real></plist>",
+		"invalid data":         "<plist><data>!!!</data></plist>",
+		"invalid date":         "<plist><date>yesterday</date></plist>",
+		"unknown element":      "<plist><uid>1</uid></plist>",
+		"unterminated":         "<plist><dict><key>A</key><string>a</string>",
+		"trailing element":     "<plist><string>a</string></plist><plist/>",
+		"text in dict":         "<plist><dict>oops</dict></plist>",
+		"element inside value": "<plist><string><b>a</b></string></plist>",
+	}
+
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Decode([]byte(input)); err == nil {
+				t.Errorf("expected error for %q", input)
+			}
+		})
+	}
+}
+
+func TestDecode_ErrorIncludesKeyPath(t *testing.T) {
+	_, err := Decode([]byte(`<plist><dict><key>PayloadVersion</key><integer>x</integer></dict></plist>`))
+	if err == nil || !strings.Contains(err.Error(), "PayloadVersion") {
+		t.Errorf("expected error to name the key, got %v", err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plist
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// header is the XML declaration and document type written before every encoded property list.
+const header = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+`
+
+// textEscaper escapes the characters that cannot appear literally in XML character data.
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\r", "&#xD;")
+
+// Encode renders value, in the representation returned by Decode, as an XML property list in a
+// canonical form: dictionary keys are sorted, nesting is indented with one tab per level and data
+// is written as a single line of base64. Values that decode to the same property list therefore
+// always encode to the same bytes, whatever the layout of the document they were read from.
+func Encode(value any) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString(header)
+	if err := encodeValue(&buf, value, 0); err != nil {
+		return nil, err
+	}
+	buf.WriteString("</plist>\n")
+	return buf.Bytes(), nil
+}
+
+// encodeValue writes value as an element indented by depth tabs, followed by a newline.
+func encodeValue(buf *bytes.Buffer, value any, depth int) error {
+	indent := strings.Repeat("\t", depth)
+
+	switch v := value.(type) {
+	case map[string]any:
+		if len(v) == 0 {
+			buf.WriteString(indent + "<dict/>\n")
+			return nil
+		}
+		buf.WriteString(indent + "<dict>\n")
+		for _, key := range slices.Sorted(maps.Keys(v)) {
+			writeElement(buf, depth+1, "key", key)
+			if err := encodeValue(buf, v[key], depth+1); err != nil {
+				return fmt.Errorf("%s: %w", key, err)
+			}
+		}
+		buf.WriteString(indent + "</dict>\n")
+	case []any:
+		if len(v) == 0 {
+			buf.WriteString(indent + "<array/>\n")
+			return nil
+		}
+		buf.WriteString(indent + "<array>\n")
+		for i, item := range v {
+			if err := encodeValue(buf, item, depth+1); err != nil {
+				return fmt.Errorf("[%d]: %w", i, err)
+			}
+		}
+		buf.WriteString(indent + "</array>\n")
+	case string:
+		writeElement(buf, depth, "string", v)
+	case int64:
+		writeElement(buf, depth, "integer", strconv.FormatInt(v, 10))
+	case float64:
+		if math.IsInf(v, 0) || math.IsNaN(v) {
+			return fmt.Errorf("unsupported <real> %v", v)
+		}
+		writeElement(buf, depth, "real", strconv.FormatFloat(v, 'g', -1, 64))
+	case bool:
+		buf.WriteString(indent + "<" + strconv.FormatBool(v) + "/>\n")
+	case []byte:
+		writeElement(buf, depth, "data", base64.StdEncoding.EncodeToString(v))
+	case time.Time:
+		writeElement(buf, depth, "date", v.UTC().Format(time.RFC3339))
+	default:
+		return fmt.Errorf("unsupported property list value of type %T", value)
+	}
+	return nil
+}
+
+// writeElement writes a simple element holding text, indented by depth tabs.
+func writeElement(buf *bytes.Buffer, depth int, name, text string) {
+	fmt.Fprintf(buf, "%s<%s>%s</%s>\n", strings.Repeat("\t", depth), name, textEscaper.Replace(text), name)
+}
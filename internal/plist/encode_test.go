@@ -0,0 +1,85 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package plist
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEncode_Canonical(t *testing.T) {
+	value, err := Decode([]byte(testProfile))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	got, err := Encode(value)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>Blob</key>
+	<data>aGVsbG8=</data>
+	<key>Created</key>
+	<date>2026-01-02T03:04:05Z</date>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>Enabled</key>
+			<true/>
+			<key>PayloadType</key>
+			<string>com.apple.TCC.configuration-profile-policy</string>
+			<key>PayloadVersion</key>
+			<integer>1</integer>
+		</dict>
+	</array>
+	<key>PayloadDisplayName</key>
+	<string>PPPC &amp; Notifications</string>
+	<key>PayloadRemovalDisallowed</key>
+	<false/>
+	<key>Ratio</key>
+	<real>0.5</real>
+</dict>
+</plist>
+`
+	if string(got) != want {
+		t.Errorf("unexpected encoding:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestEncode_RoundTrip(t *testing.T) {
+	compact := `<plist><dict><key>B</key><array/><key>A</key><dict/><key>C</key><string> spaced
+text </string></dict></plist>`
+
+	value, err := Decode([]byte(compact))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	encoded, err := Encode(value)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, err := Decode(encoded)
+	if err != nil {
+		t.Fatalf("unexpected error decoding encoded plist: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, value) {
+		t.Errorf("round trip changed value:\n got: %#v\nwant: %#v", decoded, value)
+	}
+	if !strings.Contains(string(encoded), "\t<key>A</key>\n\t<dict/>\n") {
+		t.Errorf("expected empty containers to be self-closing, got:\n%s", encoded)
+	}
+}
+
+func TestEncode_UnsupportedValue(t *testing.T) {
+	_, err := Encode(map[string]any{"Count": 1})
+	if err == nil || !strings.Contains(err.Error(), "Count") {
+		t.Errorf("expected error naming the key, got %v", err)
+	}
+}
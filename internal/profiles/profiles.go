@@ -0,0 +1,121 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package profiles reads the configuration profiles (mobileconfig files) published in the catalog.
+package profiles
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/plist"
+)
+
+// Bytes returns the mobileconfig file content of a profile, base64 decoded if necessary. Signed
+// profiles are returned with their signature envelope intact.
+func Bytes(content string) ([]byte, error) {
+	data := []byte(strings.TrimSpace(content))
+	if len(data) == 0 {
+		return nil, errors.New("profile is empty")
+	}
+
+	if data[0] != '<' {
+		decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(content), ""))
+		if err != nil {
+			return nil, fmt.Errorf("profile is neither XML nor base64 encoded: %w", err)
+		}
+		data = decoded
+	}
+	return data, nil
+}
+
+// XML returns the property list XML of a profile. The content may be base64 encoded, as profiles
+// are in the catalog, or raw XML. For signed profiles the plist embedded in the signature envelope
+// is returned.
+func XML(content string) ([]byte, error) {
+	data, err := Bytes(content)
+	if err != nil {
+		return nil, err
+	}
+
+	start := bytes.Index(data, []byte("<?xml"))
+	if start < 0 {
+		start = bytes.Index(data, []byte("<plist"))
+	}
+	end := bytes.LastIndex(data, []byte("</plist>"))
+	if start < 0 || end < start {
+		return nil, errors.New("profile does not contain a property list")
+	}
+
+	return data[start : end+len("</plist>")], nil
+}
+
+// Decode decodes a profile, given as for XML, and returns its top-level dictionary.
+func Decode(content string) (map[string]any, error) {
+	data, err := XML(content)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := plist.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("profile is not a valid property list: %w", err)
+	}
+
+	profile, ok := value.(map[string]any)
+	if !ok {
+		return nil, errors.New("profile property list must contain a dictionary")
+	}
+	return profile, nil
+}
+
+// Canonical returns the property list XML of a profile, given as for XML, re-encoded in the
+// canonical form of plist.Encode, so functionally identical profiles compare equal regardless of
+// whitespace or key order.
+func Canonical(content string) ([]byte, error) {
+	data, err := XML(content)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := plist.Decode(data)
+	if err != nil {
+		return nil, fmt.Errorf("profile is not a valid property list: %w", err)
+	}
+	return plist.Encode(value)
+}
+
+// PayloadTypes returns the distinct PayloadType values of the payloads in a profile's
+// PayloadContent, in the order they appear. A profile without PayloadContent is itself a single
+// payload, so its own PayloadType is returned.
+func PayloadTypes(profile map[string]any) []string {
+	content, ok := profile["PayloadContent"].([]any)
+	if !ok {
+		if payloadType, ok := profile["PayloadType"].(string); ok {
+			return []string{payloadType}
+		}
+		return []string{}
+	}
+
+	types := []string{}
+	seen := map[string]struct{}{}
+	for _, item := range content {
+		payload, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+		payloadType, ok := payload["PayloadType"].(string)
+		if !ok {
+			continue
+		}
+		if _, dup := seen[payloadType]; dup {
+			continue
+		}
+		seen[payloadType] = struct{}{}
+		types = append(types, payloadType)
+	}
+	return types
+}
@@ -0,0 +1,139 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package profiles
+
+import (
+	"encoding/base64"
+	"slices"
+	"testing"
+)
+
+const testProfile = `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>PayloadContent</key>
+	<array>
+		<dict>
+			<key>PayloadType</key>
+			<string>com.apple.TCC.configuration-profile-policy</string>
+		</dict>
+		<dict>
+			<key>PayloadType</key>
+			<string>com.apple.notificationsettings</string>
+		</dict>
+		<dict>
+			<key>PayloadType</key>
+			<string>com.apple.TCC.configuration-profile-policy</string>
+		</dict>
+	</array>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+</dict>
+</plist>`
+
+func TestXML_Formats(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(testProfile))
+	signed := "\x30\x82\x01\x00garbage" + testProfile + "\x00\xa0trailer"
+
+	for name, content := range map[string]string{
+		"raw":        testProfile,
+		"base64":     encoded,
+		"wrapped":    encoded[:40] + "\n" + encoded[40:],
+		"signed":     base64.StdEncoding.EncodeToString([]byte(signed)),
+		"whitespace": "\n  " + testProfile + "\n",
+	} {
+		t.Run(name, func(t *testing.T) {
+			data, err := XML(content)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != testProfile {
+				t.Errorf("unexpected XML: %q", data)
+			}
+		})
+	}
+}
+
+func TestBytes_KeepsSignature(t *testing.T) {
+	signed := "\x30\x82\x01\x00garbage" + testProfile + "\x00\xa0trailer"
+
+	data, err := Bytes(base64.StdEncoding.EncodeToString([]byte(signed)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(data) != signed {
+		t.Errorf("expected the signed envelope to be kept, got %q", data)
+	}
+}
+
+func TestCanonical_IgnoresLayout(t *testing.T) {
+	reordered := `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict><key>PayloadType</key><string>Configuration</string>
+    <key>PayloadContent</key><array>
+        <dict><key>PayloadType</key><string>com.apple.TCC.configuration-profile-policy</string></dict>
+        <dict><key>PayloadType</key><string>com.apple.notificationsettings</string></dict>
+        <dict><key>PayloadType</key><string>com.apple.TCC.configuration-profile-policy</string></dict>
+    </array></dict></plist>`
+
+	want, err := Canonical(base64.StdEncoding.EncodeToString([]byte(testProfile)))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got, err := Canonical(reordered)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("expected identical canonical forms:\n got: %s\nwant: %s", got, want)
+	}
+}
+
+func TestCanonical_InvalidPlist(t *testing.T) {
+	if _, err := Canonical("<plist><dict><key>A</key></dict></plist>"); err == nil {
+		t.Error("expected error for invalid property list")
+	}
+}
+
+func TestXML_Errors(t *testing.T) {
+	for name, content := range map[string]string{
+		"empty":      "  ",
+		"not base64": "not a profile!",
+		"no plist":   base64.StdEncoding.EncodeToString([]byte("hello world")),
+	} {
+		t.Run(name, func(t *testing.T) {
+			if _, err := XML(content); err == nil {
+				t.Errorf("expected error for %q", content)
+			}
+		})
+	}
+}
+
+func TestDecode_NotADictionary(t *testing.T) {
+	if _, err := Decode(`<plist><array/></plist>`); err == nil {
+		t.Error("expected error for a profile without a dictionary")
+	}
+}
+
+func TestPayloadTypes(t *testing.T) {
+	profile, err := Decode(testProfile)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"com.apple.TCC.configuration-profile-policy", "com.apple.notificationsettings"}
+	if got := PayloadTypes(profile); !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestPayloadTypes_SinglePayload(t *testing.T) {
+	got := PayloadTypes(map[string]any{"PayloadType": "com.apple.system-extension-policy"})
+	if !slices.Equal(got, []string{"com.apple.system-extension-policy"}) {
+		t.Errorf("unexpected payload types %v", got)
+	}
+
+	if got := PayloadTypes(map[string]any{}); got == nil || len(got) != 0 {
+		t.Errorf("expected an empty list, got %#v", got)
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 )
 
 // testAccProtoV6ProviderFactories returns the provider factories for acceptance tests.
@@ -72,17 +73,107 @@ func TestAccTitlesDataSource_VerifyAttributes(t *testing.T) {
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("data.jamfautoupdate_titles.test", "titles.#", "1"),
 					resource.TestCheckResourceAttr("data.jamfautoupdate_titles.test", "titles.0.title_name", "GoogleChrome"),
+					resource.TestCheckResourceAttr("data.jamfautoupdate_titles.test", "source_type", "url"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "source_location"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "fetched_at"),
 					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.title_display_name"),
 					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.title_version"),
 					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.icon_base64"),
 					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.uninstall_icon_base64"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.uninstall_icon_2x_base64"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.self_service_icon_base64"),
 					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.app_bundle_id"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.suggested_category"),
 				),
 			},
 		},
 	})
 }
 
+func TestAccTitlesDataSource_RequiresPrivacyApproval(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  requires_privacy_approval = true
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.#"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "fetched_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTitlesDataSource_BundleIDs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  bundle_ids = ["com.google.Chrome"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jamfautoupdate_titles.test", "titles.0.app_bundle_id", "com.google.Chrome"),
+				),
+			},
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  title_names = ["GoogleChrome"]
+  bundle_ids  = ["com.google.Chrome"]
+}`,
+				ExpectError: regexp.MustCompile(`Conflicting title selectors`),
+			},
+		},
+	})
+}
+
+func TestAccTitlesDataSource_IconBadge(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  title_names = ["GoogleChrome"]
+
+  icon_badge = {
+    text     = "BETA"
+    position = "top_right"
+  }
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.0.badged_icon_base64"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTitlesDataSource_InvalidIconBadge(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  title_names = ["GoogleChrome"]
+
+  icon_badge = {
+    text     = "BETA"
+    position = "middle"
+  }
+}`,
+				ExpectError: regexp.MustCompile(`Invalid icon badge`),
+			},
+		},
+	})
+}
+
 func TestAccTitlesDataSource_EmptyList(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -109,7 +200,213 @@ func TestAccTitlesDataSource_InvalidTitle(t *testing.T) {
 				Config: `data "jamfautoupdate_titles" "bad" {
   title_names = ["NonExistentTitle12345"]
 }`,
-				ExpectError: regexp.MustCompile(`(titles do not exist|status code: 404)`),
+				ExpectError: regexp.MustCompile(`titles do not exist`),
+			},
+		},
+	})
+}
+
+func TestAccTitleDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_title" "test" {
+  title_name = "GoogleChrome"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jamfautoupdate_title.test", "title_name", "GoogleChrome"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_title.test", "title_version"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_title.test", "app_bundle_id"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccPatchDefinitionDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_patch_definition" "test" {
+  title_name = "GoogleChrome"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_patch_definition.test", "requirements.#"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_patch_definition.test", "kill_apps.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccExtensionAttributeDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_extension_attribute" "test" {
+  title_name = "GoogleChrome"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_extension_attribute.test", "script"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTitleIconDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_title_icon" "test" {
+  title_name = "GoogleChrome"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jamfautoupdate_title_icon.test", "icons.%", "5"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_title_icon.test", "icons.32"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_title_icon.test", "icons.512"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTitleProfilesDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_title_profiles" "test" {
+  title_name = "GoogleChrome"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_title_profiles.test", "profiles.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTitleNamesDataSource_Filtered(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_title_names" "test" {
+  prefix  = "Google"
+  pattern = "Chrome$"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jamfautoupdate_title_names.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.jamfautoupdate_title_names.test", "names.0", "GoogleChrome"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTitleNamesDataSource_InvalidPattern(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_title_names" "test" {
+  pattern = "(unclosed"
+}`,
+				ExpectError: regexp.MustCompile(`Invalid title name pattern`),
+			},
+		},
+	})
+}
+
+func TestAccServiceStatusDataSource_Basic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_service_status" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jamfautoupdate_service_status.test", "healthy", "true"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_service_status.test", "latency_ms"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_service_status.test", "checked_at"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAuditManifestResource_Basic(t *testing.T) {
+	manifestPath := t.TempDir() + "/manifest.csv"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "jamfautoupdate_audit_manifest" "test" {
+  path        = "` + manifestPath + `"
+  format      = "csv"
+  title_names = ["GoogleChrome"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfautoupdate_audit_manifest.test", "titles.#", "1"),
+					resource.TestCheckResourceAttr("jamfautoupdate_audit_manifest.test", "titles.0.title_name", "GoogleChrome"),
+					resource.TestCheckResourceAttrSet("jamfautoupdate_audit_manifest.test", "manifest_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAssetExportResource_Basic(t *testing.T) {
+	exportDir := t.TempDir() + "/export"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "jamfautoupdate_asset_export" "test" {
+  directory   = "` + exportDir + `"
+  title_names = ["GoogleChrome"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("jamfautoupdate_asset_export.test", "files.#"),
+					resource.TestCheckResourceAttrSet("jamfautoupdate_asset_export.test", "manifest_sha256"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDefinitionsMirrorResource_Basic(t *testing.T) {
+	mirrorPath := t.TempDir() + "/catalog.json"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `resource "jamfautoupdate_definitions_mirror" "test" {
+  path = "` + mirrorPath + `"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("jamfautoupdate_definitions_mirror.test", "id", mirrorPath),
+					resource.TestCheckResourceAttrSet("jamfautoupdate_definitions_mirror.test", "catalog_sha256"),
+					resource.TestCheckResourceAttrSet("jamfautoupdate_definitions_mirror.test", "source"),
+				),
 			},
 		},
 	})
@@ -129,7 +426,541 @@ provider "jamfautoupdate" {
 data "jamfautoupdate_titles" "test" {
   title_names = []
 }`,
-				ExpectError: regexp.MustCompile(`Exactly one of definitions_url or definitions_file must be set`),
+				ExpectError: regexp.MustCompile(`Exactly one of environment, definitions_url, definitions_urls, definitions_file or definitions_json must`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_RequireCatalogSchema(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  require_catalog_schema = 99
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Incompatible catalog schema version`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_MissingUninstallOverlay(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  uninstall_overlay_path = "/nonexistent/overlay.png"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Unable to load uninstall overlay`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_InvalidDuplicateTitles(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  duplicate_titles = "ignore"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Invalid duplicate_titles`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_InvalidLocale(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  locale = "en_US"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Invalid locale`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_EnvironmentConflict(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  environment     = "staging"
+  definitions_url = "https://definitions.example.com"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Only one of environment, definitions_url`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_IncompleteOAuth2(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  client_id = "terraform"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`client_id, client_secret and token_url must be set together`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_BasicAuthConflictsWithToken(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  auth_token          = "s3cr3t"
+  basic_auth_username = "terraform"
+  basic_auth_password = "s3cr3t"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Only one of auth_token, client_id or basic_auth_username may be set`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_InvalidChannel(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  channel = "preview"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Invalid channel`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_InvalidProxyURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  proxy_url = "proxy.example.com:3128"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Invalid proxy_url`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_InvalidRetryWait(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  retry_min_wait = "10s"
+  retry_max_wait = "5s"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Invalid retry waits`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_MaxResponseSizeTooLarge(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  max_response_size_mb = 9007199254740992
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`max_response_size_mb must be between 1 and 1048576`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_FallbackRequiresURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  definitions_file          = "definitions.json"
+  fallback_definitions_file = "fallback.json"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`fallback_definitions_file requires`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_CatalogVersionRequiresURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  definitions_json = "[]"
+  catalog_version  = "2026-10-01"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`catalog_version requires`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_ChannelRequiresURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  definitions_file = "definitions.json"
+  channel          = "beta"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`channel requires`),
+			},
+		},
+	})
+}
+
+func TestAccTitlesDataSource_DefinitionsJSON(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  definitions_json = jsonencode([
+    {
+      title_name    = "Fixture"
+      title_version = "1.0.0"
+    }
+  ])
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = ["Fixture"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.jamfautoupdate_titles.test", "titles.0.title_version", "1.0.0"),
+					resource.TestCheckResourceAttr("data.jamfautoupdate_titles.test", "source_type", "inline"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_CacheTTLRequiresCacheDir(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  cache_ttl = "30m"
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`cache_ttl requires cache_dir`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_ValidateConnection(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  definitions_file    = "/nonexistent/definitions.json"
+  validate_connection = true
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Unable to reach definitions source`),
+			},
+		},
+	})
+}
+
+func TestAccProviderConfigure_OfflineRejectsURL(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "jamfautoupdate" {
+  definitions_url = "https://definitions.example.com"
+  offline         = true
+}
+
+data "jamfautoupdate_titles" "test" {
+  title_names = []
+}`,
+				ExpectError: regexp.MustCompile(`Definitions source requires network access`),
+			},
+		},
+	})
+}
+
+func TestAccTitlesDataSource_OSVersion(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  os_version = "13.6"
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.#"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "fetched_at"),
+				),
+			},
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  os_version = "Ventura"
+}`,
+				ExpectError: regexp.MustCompile(`Invalid OS version`),
+			},
+		},
+	})
+}
+
+func TestAccTitlesDataSource_Requires(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  requires = ["pppcp_profile", "system_extension_profile"]
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "titles.#"),
+					resource.TestCheckResourceAttrSet("data.jamfautoupdate_titles.test", "fetched_at"),
+				),
+			},
+			{
+				Config: `data "jamfautoupdate_titles" "test" {
+  requires = ["pppc_profile"]
+}`,
+				ExpectError: regexp.MustCompile(`Invalid required profile`),
+			},
+		},
+	})
+}
+
+func TestAccSemverSortFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `output "sorted" {
+  value = join(",", provider::jamfautoupdate::semver_sort(["1.10", "1.0", "1.0b1", "1.9"]))
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("sorted", "1.0b1,1.0,1.9,1.10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccProfilePayloadTypesFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_titles" "chrome" {
+  title_names = ["GoogleChrome"]
+}
+
+output "payload_types" {
+  value = join(",", provider::jamfautoupdate::profile_payload_types(data.jamfautoupdate_titles.chrome.titles[0].pppcp_profile))
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("payload_types", "com.apple.TCC.configuration-profile-policy"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccCanonicalizePlistFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "jamfautoupdate_titles" "chrome" {
+  title_names    = ["GoogleChrome"]
+  profile_format = "xml"
+}
+
+output "matches" {
+  value = provider::jamfautoupdate::canonicalize_plist(data.jamfautoupdate_titles.chrome.titles[0].pppcp_profile) == data.jamfautoupdate_titles.chrome.titles[0].pppcp_profile
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("matches", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLatestVersionFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `output "latest" {
+  value = provider::jamfautoupdate::latest_version(["1.9", "1.10b1", "1.10", "1.2"])
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("latest", "1.10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccStripMarkupFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() { testAccPreCheck(t) },
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `output "plain" {
+  value = provider::jamfautoupdate::strip_markup("<p>Installs **Chrome** &amp; more</p>")
+}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("plain", "Installs Chrome & more"),
+				),
 			},
 		},
 	})
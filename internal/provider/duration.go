@@ -0,0 +1,27 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// parseOptionalDuration parses a duration attribute such as "500ms" or "1m", returning nil when the
+// attribute is not set.
+func parseOptionalDuration(value types.String) (*time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+	d, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a duration such as 500ms or 30s", value.ValueString())
+	}
+	if d < 0 {
+		return nil, fmt.Errorf("%q must not be negative", value.ValueString())
+	}
+	return &d, nil
+}
@@ -0,0 +1,31 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestParseOptionalDuration(t *testing.T) {
+	if d, err := parseOptionalDuration(types.StringNull()); d != nil || err != nil {
+		t.Errorf("expected nil for an unset duration, got %v, %v", d, err)
+	}
+
+	d, err := parseOptionalDuration(types.StringValue("1m30s"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *d != 90*time.Second {
+		t.Errorf("expected 90s, got %s", *d)
+	}
+
+	for _, value := range []string{"10", "soon", "-1s"} {
+		if _, err := parseOptionalDuration(types.StringValue(value)); err == nil {
+			t.Errorf("%q: expected error, got nil", value)
+		}
+	}
+}
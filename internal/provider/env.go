@@ -0,0 +1,150 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// envAttributePrefix is the prefix of the environment variables that set provider attributes
+// missing from the configuration.
+const envAttributePrefix = "JAMF_AUTO_UPDATE_"
+
+// envDefinitionsSourceAttributes are the mutually exclusive attributes that select the definitions
+// source. Their environment variables are only considered when none of them is configured, so a
+// source set in HCL is never combined with one inherited from the runner.
+var envDefinitionsSourceAttributes = []string{
+	"environment",
+	"definitions_url",
+	"definitions_urls",
+	"definitions_file",
+	"definitions_json",
+}
+
+// envSelfManagedAttributes are skipped by setAttributesFromEnv: the definitions source is set by
+// setDefinitionsSourceFromEnv, and auth_token predates the naming convention and is read by
+// Configure only when no other credentials are set.
+var envSelfManagedAttributes = append(slices.Clone(envDefinitionsSourceAttributes), "auth_token")
+
+// attributeEnvVar returns the environment variable that sets attribute, for example
+// JAMF_AUTO_UPDATE_PROXY_URL for proxy_url.
+func attributeEnvVar(attribute string) string {
+	return envAttributePrefix + strings.ToUpper(attribute)
+}
+
+// setAttributesFromEnv sets the attributes of data that are not set in the configuration from
+// their environment variables, so shared modules can leave credentials and runner-specific
+// settings out of HCL.
+func setAttributesFromEnv(data *JamfAutoUpdateProviderModel) error {
+	model := reflect.ValueOf(data).Elem()
+	for i := range model.NumField() {
+		attribute := model.Type().Field(i).Tag.Get("tfsdk")
+		if slices.Contains(envSelfManagedAttributes, attribute) {
+			continue
+		}
+		name := attributeEnvVar(attribute)
+		value := getenv(name)
+		if value == "" {
+			continue
+		}
+
+		switch field := model.Field(i).Addr().Interface().(type) {
+		case *types.String:
+			if field.IsNull() {
+				*field = types.StringValue(value)
+			}
+		case *types.Int64:
+			if field.IsNull() {
+				n, err := strconv.ParseInt(value, 10, 64)
+				if err != nil {
+					return fmt.Errorf("%s must be an integer, got %q", name, value)
+				}
+				*field = types.Int64Value(n)
+			}
+		case *types.Bool:
+			if field.IsNull() {
+				b, err := strconv.ParseBool(value)
+				if err != nil {
+					return fmt.Errorf("%s must be true or false, got %q", name, value)
+				}
+				*field = types.BoolValue(b)
+			}
+		default:
+			return fmt.Errorf("attribute %s cannot be set from the environment", attribute)
+		}
+	}
+	return nil
+}
+
+// setDefinitionsSourceFromEnv sets the definitions source of data from the environment when the
+// configuration sets none. At most one of the source environment variables may be set;
+// JAMF_AUTO_UPDATE_DEFINITIONS_URLS is a comma-separated list of URLs.
+func setDefinitionsSourceFromEnv(data *JamfAutoUpdateProviderModel) error {
+	sources := map[string]attr.Value{
+		"environment":      data.Environment,
+		"definitions_url":  data.DefinitionsURL,
+		"definitions_urls": data.DefinitionsURLs,
+		"definitions_file": data.DefinitionsFile,
+		"definitions_json": data.DefinitionsJSON,
+	}
+	for _, value := range sources {
+		if !value.IsNull() {
+			return nil
+		}
+	}
+
+	var set []string
+	for _, attribute := range envDefinitionsSourceAttributes {
+		if getenv(attributeEnvVar(attribute)) != "" {
+			set = append(set, attributeEnvVar(attribute))
+		}
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	if len(set) > 1 {
+		return fmt.Errorf("only one of %s may be set, got %s", strings.Join(envDefinitionsSourceEnvVars(), ", "), strings.Join(set, " and "))
+	}
+
+	value := getenv(set[0])
+	switch set[0] {
+	case attributeEnvVar("environment"):
+		data.Environment = types.StringValue(value)
+	case attributeEnvVar("definitions_url"):
+		data.DefinitionsURL = types.StringValue(value)
+	case attributeEnvVar("definitions_urls"):
+		var urls []attr.Value
+		for url := range strings.SplitSeq(value, ",") {
+			if url = strings.TrimSpace(url); url != "" {
+				urls = append(urls, types.StringValue(url))
+			}
+		}
+		list, diags := types.ListValue(types.StringType, urls)
+		if diags.HasError() {
+			return fmt.Errorf("%s must be a comma-separated list of URLs, got %q", set[0], value)
+		}
+		data.DefinitionsURLs = list
+	case attributeEnvVar("definitions_file"):
+		data.DefinitionsFile = types.StringValue(value)
+	case attributeEnvVar("definitions_json"):
+		data.DefinitionsJSON = types.StringValue(value)
+	}
+	return nil
+}
+
+// envDefinitionsSourceEnvVars returns the environment variables of envDefinitionsSourceAttributes.
+func envDefinitionsSourceEnvVars() []string {
+	names := make([]string, len(envDefinitionsSourceAttributes))
+	for i, attribute := range envDefinitionsSourceAttributes {
+		names[i] = attributeEnvVar(attribute)
+	}
+	return names
+}
@@ -0,0 +1,143 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestAttributeEnvVar(t *testing.T) {
+	if got := attributeEnvVar("proxy_url"); got != "JAMF_AUTO_UPDATE_PROXY_URL" {
+		t.Errorf("expected JAMF_AUTO_UPDATE_PROXY_URL, got %s", got)
+	}
+}
+
+func TestSetAttributesFromEnv(t *testing.T) {
+	t.Setenv("JAMF_AUTO_UPDATE_PROXY_URL", "http://proxy.example.com:3128")
+	t.Setenv("JAMF_AUTO_UPDATE_MAX_RETRIES", "5")
+	t.Setenv("JAMF_AUTO_UPDATE_VALIDATE_CONNECTION", "true")
+	t.Setenv("JAMF_AUTO_UPDATE_CONNECT_TIMEOUT", "5s")
+	t.Setenv("JAMF_AUTO_UPDATE_DEFINITIONS_JSON", "[]")
+
+	data := JamfAutoUpdateProviderModel{
+		DefinitionsJSON: types.StringNull(),
+		ConnectTimeout:  types.StringValue("10s"),
+	}
+	if err := setAttributesFromEnv(&data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if data.ProxyURL.ValueString() != "http://proxy.example.com:3128" {
+		t.Errorf("expected proxy_url from the environment, got %s", data.ProxyURL)
+	}
+	if data.MaxRetries.ValueInt64() != 5 {
+		t.Errorf("expected max_retries 5, got %s", data.MaxRetries)
+	}
+	if !data.ValidateConnection.ValueBool() {
+		t.Error("expected validate_connection from the environment")
+	}
+	if data.ConnectTimeout.ValueString() != "10s" {
+		t.Errorf("expected the configured connect_timeout to take precedence, got %s", data.ConnectTimeout)
+	}
+	if !data.DefinitionsJSON.IsNull() {
+		t.Error("expected definitions_json not to be read from the environment")
+	}
+}
+
+func TestSetAttributesFromEnv_Invalid(t *testing.T) {
+	for name, value := range map[string]string{
+		"JAMF_AUTO_UPDATE_MAX_RETRIES":          "five",
+		"JAMF_AUTO_UPDATE_INSECURE_SKIP_VERIFY": "yes please",
+	} {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(name, value)
+			var data JamfAutoUpdateProviderModel
+			if err := setAttributesFromEnv(&data); err == nil {
+				t.Errorf("expected error for %s=%q", name, value)
+			}
+		})
+	}
+}
+
+func TestSetAttributesFromEnv_EveryAttribute(t *testing.T) {
+	resp := &provider.SchemaResponse{}
+	(&JamfAutoUpdateProvider{}).Schema(context.Background(), provider.SchemaRequest{}, resp)
+	for attribute := range resp.Schema.Attributes {
+		if !slices.Contains(envSelfManagedAttributes, attribute) {
+			t.Setenv(attributeEnvVar(attribute), "1")
+		}
+	}
+
+	var data JamfAutoUpdateProviderModel
+	if err := setAttributesFromEnv(&data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestSetDefinitionsSourceFromEnv(t *testing.T) {
+	tests := map[string]struct {
+		value string
+		check func(data JamfAutoUpdateProviderModel) bool
+	}{
+		"JAMF_AUTO_UPDATE_ENVIRONMENT": {"staging", func(data JamfAutoUpdateProviderModel) bool {
+			return data.Environment.ValueString() == "staging"
+		}},
+		"JAMF_AUTO_UPDATE_DEFINITIONS_URL": {"https://a.example.com", func(data JamfAutoUpdateProviderModel) bool {
+			return data.DefinitionsURL.ValueString() == "https://a.example.com"
+		}},
+		"JAMF_AUTO_UPDATE_DEFINITIONS_URLS": {"https://a.example.com, https://b.example.com", func(data JamfAutoUpdateProviderModel) bool {
+			var urls []string
+			data.DefinitionsURLs.ElementsAs(context.Background(), &urls, false)
+			return slices.Equal(urls, []string{"https://a.example.com", "https://b.example.com"})
+		}},
+		"JAMF_AUTO_UPDATE_DEFINITIONS_FILE": {"catalog.json", func(data JamfAutoUpdateProviderModel) bool {
+			return data.DefinitionsFile.ValueString() == "catalog.json"
+		}},
+		"JAMF_AUTO_UPDATE_DEFINITIONS_JSON": {"[]", func(data JamfAutoUpdateProviderModel) bool {
+			return data.DefinitionsJSON.ValueString() == "[]"
+		}},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(name, tt.value)
+			var data JamfAutoUpdateProviderModel
+			if err := setDefinitionsSourceFromEnv(&data); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !tt.check(data) {
+				t.Errorf("expected %s=%q to set the definitions source, got %+v", name, tt.value, data)
+			}
+		})
+	}
+}
+
+func TestSetDefinitionsSourceFromEnv_Configured(t *testing.T) {
+	t.Setenv("JAMF_AUTO_UPDATE_ENVIRONMENT", "staging")
+	t.Setenv("JAMF_AUTO_UPDATE_DEFINITIONS_URL", "https://a.example.com")
+
+	data := JamfAutoUpdateProviderModel{DefinitionsFile: types.StringValue("catalog.json")}
+	if err := setDefinitionsSourceFromEnv(&data); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !data.Environment.IsNull() || !data.DefinitionsURL.IsNull() {
+		t.Error("expected the environment to be ignored when a definitions source is configured")
+	}
+}
+
+func TestSetDefinitionsSourceFromEnv_Conflict(t *testing.T) {
+	t.Setenv("JAMF_AUTO_UPDATE_ENVIRONMENT", "staging")
+	t.Setenv("JAMF_AUTO_UPDATE_DEFINITIONS_JSON", "[]")
+
+	var data JamfAutoUpdateProviderModel
+	err := setDefinitionsSourceFromEnv(&data)
+	if err == nil || !strings.Contains(err.Error(), "JAMF_AUTO_UPDATE_ENVIRONMENT and JAMF_AUTO_UPDATE_DEFINITIONS_JSON") {
+		t.Fatalf("expected a conflict error, got %v", err)
+	}
+}
@@ -0,0 +1,44 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Environments accepted by the environment attribute.
+const (
+	environmentProduction = "production"
+	environmentStaging    = "staging"
+)
+
+// environments lists the supported environments.
+var environments = []string{environmentProduction, environmentStaging}
+
+// Definitions API base URLs of Jamf's environments. The API is private, so the URLs are not part of
+// the source; release builds set them with -ldflags "-X".
+var (
+	productionDefinitionsURL string
+	stagingDefinitionsURL    string
+)
+
+// environmentURL returns the Definitions API base URL of environment, failing for unknown
+// environments and for environments whose URL was not set when the provider was built.
+func environmentURL(environment string) (string, error) {
+	var url string
+	switch environment {
+	case environmentProduction:
+		url = productionDefinitionsURL
+	case environmentStaging:
+		url = stagingDefinitionsURL
+	default:
+		return "", fmt.Errorf("environment must be one of %s, got: %q", strings.Join(environments, ", "), environment)
+	}
+
+	if url == "" {
+		return "", fmt.Errorf("this build of the provider has no endpoint URL for environment %q; set definitions_url instead", environment)
+	}
+	return url, nil
+}
@@ -0,0 +1,33 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEnvironmentURL(t *testing.T) {
+	defer func(production, staging string) {
+		productionDefinitionsURL, stagingDefinitionsURL = production, staging
+	}(productionDefinitionsURL, stagingDefinitionsURL)
+
+	productionDefinitionsURL = "https://definitions.example.com"
+	stagingDefinitionsURL = ""
+
+	url, err := environmentURL("production")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if url != "https://definitions.example.com" {
+		t.Errorf("expected production URL, got %q", url)
+	}
+
+	if _, err := environmentURL("staging"); err == nil || !strings.Contains(err.Error(), "no endpoint URL") {
+		t.Errorf("expected missing endpoint URL error, got %v", err)
+	}
+	if _, err := environmentURL("dev"); err == nil || !strings.Contains(err.Error(), "must be one of production, staging") {
+		t.Errorf("expected unknown environment error, got %v", err)
+	}
+}
@@ -5,26 +5,49 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/functions"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/export"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/manifest"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/mirror"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/servicestatus"
 	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/titles"
 )
 
 // Constants for environment variable names.
 const (
-	envDefinitionsURL  = "JAMF_AUTO_UPDATE_DEFINITIONS_URL"
-	envDefinitionsFile = "JAMF_AUTO_UPDATE_DEFINITIONS_FILE"
+	envAuthToken       = "JAMF_AUTO_UPDATE_TOKEN"
+	envAppendUserAgent = "TF_APPEND_USER_AGENT"
 )
 
+// maxResponseSizeMB is the largest max_response_size_mb accepted, 1 TiB, well past any catalog and
+// far below the size at which the limit in bytes would overflow.
+const maxResponseSizeMB = 1 << 20
+
+// validateConnectionTimeout bounds the connectivity check run when validate_connection is set.
+const validateConnectionTimeout = 10 * time.Second
+
+// defaultCacheTTL is how long cached responses are reused unless cache_ttl is set.
+const defaultCacheTTL = time.Hour
+
 // Ensure JamfAutoUpdateProvider satisfies various provider interfaces.
-var _ provider.Provider = &JamfAutoUpdateProvider{}
+var (
+	_ provider.Provider              = &JamfAutoUpdateProvider{}
+	_ provider.ProviderWithFunctions = &JamfAutoUpdateProvider{}
+)
 
 // JamfAutoUpdateProvider defines the provider implementation.
 type JamfAutoUpdateProvider struct {
@@ -34,8 +57,46 @@ type JamfAutoUpdateProvider struct {
 
 // JamfAutoUpdateProvider describes the provider data model.
 type JamfAutoUpdateProviderModel struct {
-	DefinitionsURL  types.String `tfsdk:"definitions_url"`
-	DefinitionsFile types.String `tfsdk:"definitions_file"`
+	DefinitionsURL        types.String `tfsdk:"definitions_url"`
+	DefinitionsURLs       types.List   `tfsdk:"definitions_urls"`
+	DefinitionsFile       types.String `tfsdk:"definitions_file"`
+	FallbackFile          types.String `tfsdk:"fallback_definitions_file"`
+	DefinitionsJSON       types.String `tfsdk:"definitions_json"`
+	AppendUserAgent       types.String `tfsdk:"append_user_agent"`
+	CacheDir              types.String `tfsdk:"cache_dir"`
+	CacheTTL              types.String `tfsdk:"cache_ttl"`
+	Environment           types.String `tfsdk:"environment"`
+	RequireCatalogSchema  types.Int64  `tfsdk:"require_catalog_schema"`
+	ValidateConnection    types.Bool   `tfsdk:"validate_connection"`
+	Offline               types.Bool   `tfsdk:"offline"`
+	StrictDecoding        types.Bool   `tfsdk:"strict_decoding"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	DisableKeepAlives     types.Bool   `tfsdk:"disable_keep_alives"`
+	MaxIdleConnections    types.Int64  `tfsdk:"max_idle_connections"`
+	EnableHTTP2           types.Bool   `tfsdk:"enable_http2"`
+	ProxyURL              types.String `tfsdk:"proxy_url"`
+	MaxRetries            types.Int64  `tfsdk:"max_retries"`
+	MaxConcurrent         types.Int64  `tfsdk:"max_concurrent_requests"`
+	RetryMinWait          types.String `tfsdk:"retry_min_wait"`
+	RetryMaxWait          types.String `tfsdk:"retry_max_wait"`
+	ConnectTimeout        types.String `tfsdk:"connect_timeout"`
+	TLSHandshakeTimeout   types.String `tfsdk:"tls_handshake_timeout"`
+	ResponseHeaderTimeout types.String `tfsdk:"response_header_timeout"`
+	MaxResponseSizeMB     types.Int64  `tfsdk:"max_response_size_mb"`
+	DebugDumpPath         types.String `tfsdk:"debug_dump_path"`
+	AuditLogPath          types.String `tfsdk:"audit_log_path"`
+	UninstallOverlayPath  types.String `tfsdk:"uninstall_overlay_path"`
+	DuplicateTitles       types.String `tfsdk:"duplicate_titles"`
+	Locale                types.String `tfsdk:"locale"`
+	Channel               types.String `tfsdk:"channel"`
+	CatalogVersion        types.String `tfsdk:"catalog_version"`
+	APIVersion            types.Int64  `tfsdk:"api_version"`
+	AuthToken             types.String `tfsdk:"auth_token"`
+	ClientID              types.String `tfsdk:"client_id"`
+	ClientSecret          types.String `tfsdk:"client_secret"`
+	TokenURL              types.String `tfsdk:"token_url"`
+	BasicAuthUsername     types.String `tfsdk:"basic_auth_username"`
+	BasicAuthPassword     types.String `tfsdk:"basic_auth_password"`
 }
 
 func (p *JamfAutoUpdateProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -46,21 +107,180 @@ func (p *JamfAutoUpdateProvider) Metadata(ctx context.Context, req provider.Meta
 // Schema defines the schema for the Jamf Auto Update provider.
 func (p *JamfAutoUpdateProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Provides metadata for software titles sourced from [Jamf Auto Update](https://datajar.co.uk/products/jamf-auto-update/) using a private API. Intended for internal use within the Jamf organization.",
+		MarkdownDescription: "Provides metadata for software titles sourced from [Jamf Auto Update](https://datajar.co.uk/products/jamf-auto-update/) using a private API. Intended for internal use within the Jamf organization.\n\nAttributes missing from the configuration are read from environment variables named `JAMF_AUTO_UPDATE_` followed by the attribute name in upper case, for example `JAMF_AUTO_UPDATE_PROXY_URL` for `proxy_url`, so shared modules can keep credentials and runner-specific settings out of HCL. Values in the configuration take precedence. The definitions source is read from one of `JAMF_AUTO_UPDATE_ENVIRONMENT`, `JAMF_AUTO_UPDATE_DEFINITIONS_URL`, `JAMF_AUTO_UPDATE_DEFINITIONS_URLS` (comma-separated), `JAMF_AUTO_UPDATE_DEFINITIONS_FILE` or `JAMF_AUTO_UPDATE_DEFINITIONS_JSON` only when none is configured, and `auth_token` from `JAMF_AUTO_UPDATE_TOKEN`.",
 		Attributes: map[string]schema.Attribute{
 			"definitions_url": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "The baseURL of the Definitions API. Mutually exclusive with definitions_file.",
+				MarkdownDescription: "The baseURL of the Definitions API. Use the form `unix:///path/to/socket` to reach a server listening on a Unix domain socket, for example a sidecar proxy. Use the form `s3://bucket/key` to read a catalog export stored in Amazon S3; requests are signed with credentials from the standard AWS credential chain: environment variables, web identity (IRSA), the shared config profile named by `AWS_PROFILE` including `role_arn` and IAM Identity Center (SSO) profiles, ECS container credentials and EC2 instance metadata. Requests are sent unsigned when no credentials are configured. The region is read from a `region` query parameter, such as `s3://bucket/key?region=eu-west-1`, or `AWS_REGION`, and `AWS_ENDPOINT_URL_S3` selects an S3-compatible service. Use `gs://bucket/object` for Google Cloud Storage, authorized with Application Default Credentials: the access token in `GOOGLE_OAUTH_ACCESS_TOKEN`, the credentials file named by `GOOGLE_APPLICATION_CREDENTIALS` or written by `gcloud auth application-default login`, or the service account of the Compute Engine metadata server. Use `azblob://container/blob` for Azure Blob Storage in the account named by `AZURE_STORAGE_ACCOUNT`, authorized with `AZURE_STORAGE_SAS_TOKEN`, `AZURE_STORAGE_KEY`, a service principal secret in `AZURE_TENANT_ID`, `AZURE_CLIENT_ID` and `AZURE_CLIENT_SECRET`, AKS workload identity or a managed identity. Objects are read anonymously when no credentials are configured. Mutually exclusive with definitions_urls and definitions_file.",
+			},
+			"definitions_urls": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "An ordered list of Definitions API base URLs. Before the first request the provider probes every mirror and prefers the fastest healthy one for the rest of the run, falling back to the configured order for unhealthy mirrors. When a request fails with a connection error or a 5xx response, the next mirror is tried. Mutually exclusive with definitions_url and definitions_file.",
 			},
 			"definitions_file": schema.StringAttribute{
 				Optional:            true,
-				MarkdownDescription: "Path to a local JSON file containing definitions. Mutually exclusive with definitions_url.",
+				MarkdownDescription: "Path to a local JSON file containing definitions, either a JSON array of titles or an object holding the array under `titles`, as newer catalog exports are shaped. The object's `schema_version` selects how titles are parsed, and catalogs in a schema version the provider does not support fail with an error rather than being misread. A glob pattern such as `catalogs/*.json` merges the titles of every matching file in lexical path order, and titles that appear in more than one file are resolved by duplicate_titles as if they were duplicated within one catalog. Mutually exclusive with definitions_url and definitions_urls.",
+			},
+			"append_user_agent": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Text appended to the `User-Agent` header sent to the definitions server, such as a team or customer identifier, after `terraform-provider-jamfautoupdate/<version>` and the `TF_APPEND_USER_AGENT` environment variable.",
+			},
+			"cache_dir": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Directory in which responses from the definitions server are cached, so plans within `cache_ttl` reuse the last catalog response instead of downloading it again. Responses are keyed by request URL, `locale`, `channel`, `catalog_version`, `api_version` and the `refresh_trigger` of the reading data source, and only cached once they decoded successfully. Changing `refresh_trigger` bypasses cached responses. Definitions files and `definitions_json` are never cached. Caching is disabled unless set.",
+			},
+			"cache_ttl": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How long a cached response is reused, as a duration such as `30m` or `12h`. Requires cache_dir. Defaults to `1h`.",
+			},
+			"definitions_json": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The catalog itself as a JSON string, in the same format as definitions_file, for hermetic runs and modules that embed small fixture catalogs with `file()` or `jsonencode()`. Takes precedence over the definitions source environment variables. Mutually exclusive with environment, definitions_url, definitions_urls and definitions_file.",
+			},
+			"fallback_definitions_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a local definitions file, in the same format as definitions_file, that titles are read from when the Definitions API and every mirror fail with a connection error or a server error, for example a nightly-synced copy of the catalog. Reads that fall back emit a warning. Requires environment, definitions_url or definitions_urls.",
+			},
+			"environment": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Shortcut for the Definitions API of one of Jamf's environments, `production` or `staging`, so workspaces can switch environments without hard-coding endpoint URLs. Takes precedence over the definitions source environment variables. Mutually exclusive with definitions_url, definitions_urls and definitions_file.",
+			},
+			"auth_token": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Token sent as a bearer token in the `Authorization` header of every request to the definitions server, for endpoints behind a gateway that requires one. Can also be set with the `JAMF_AUTO_UPDATE_TOKEN` environment variable. Has no effect with definitions_file.",
+			},
+			"client_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "OAuth2 client ID used with client_secret to obtain access tokens from token_url with the client credentials grant, for definitions servers that require OAuth2. Tokens are cached and refreshed before they expire. Requires client_secret and token_url. Mutually exclusive with auth_token and basic_auth_username.",
+			},
+			"client_secret": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "OAuth2 client secret for client_id. Requires client_id and token_url.",
+			},
+			"token_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of the OAuth2 token endpoint access tokens are requested from with client_id and client_secret. Requires client_id and client_secret.",
+			},
+			"basic_auth_username": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Username sent with HTTP Basic authentication in the `Authorization` header of every request to the definitions server, including mirrors, for mirrors protected with basic auth. Requires basic_auth_password. Mutually exclusive with auth_token and client_id. Has no effect with definitions_file.",
+			},
+			"basic_auth_password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Password for basic_auth_username. Requires basic_auth_username.",
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Disable verification of the definitions server's TLS certificate. Intended only for lab environments with self-signed mirrors; never enable this against production endpoints.",
+			},
+			"disable_keep_alives": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Disable HTTP keep-alives so every request to the definitions server opens a new connection. Useful behind middleboxes that drop idle connections. Defaults to `false`.",
+			},
+			"max_idle_connections": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of idle connections kept open to the definitions server. Raise this on high-concurrency runners; `0` means no limit. Defaults to `100`.",
+			},
+			"enable_http2": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Whether to negotiate HTTP/2 with the definitions server. Set to `false` to force HTTP/1.1 for proxies that mishandle HTTP/2. Defaults to `true`.",
+			},
+			"proxy_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL of the HTTP, HTTPS or SOCKS5 proxy requests to the definitions server are sent through, for example `http://proxy.example.com:3128`. Hosts listed in the `NO_PROXY` environment variable are still reached directly. Defaults to the proxy named by the `HTTPS_PROXY` or `HTTP_PROXY` environment variable.",
+			},
+			"max_concurrent_requests": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of requests to the definitions server in flight at once; further requests wait for a free slot. Terraform reads data sources in parallel, so lower this when a server or mirror rejects bursts of requests. `0` means no limit. Defaults to `0`.",
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of times a request that failed with a connection error or timeout, a `502`, `503` or `504` response or a rate limit is retried. Certificate verification failures and unknown hosts are not retried. Retries never extend a read beyond its timeout. Set to `0` to disable retries. Defaults to `3`.",
+			},
+			"connect_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum time to establish a connection to the definitions server, including resolving its name, as a duration such as `5s`. Keeps slow DNS or unreachable hosts from using up the read timeout. `0` disables the limit. Defaults to `30s`.",
+			},
+			"tls_handshake_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum time to complete the TLS handshake with the definitions server, as a duration such as `5s`. `0` disables the limit. Defaults to `10s`.",
+			},
+			"response_header_timeout": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum time to wait for the response headers after a request is sent, as a duration such as `15s`. Downloading the response body is not limited by this timeout. Unlimited unless set, within the read timeout.",
+			},
+			"retry_min_wait": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Upper bound of the wait before the first retry, as a duration such as `500ms` or `2s`. Waits are chosen at random up to the bound, which doubles on each further retry up to `retry_max_wait`. Defaults to `1s`.",
+			},
+			"retry_max_wait": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Upper bound of the wait between retries, as a duration such as `30s` or `1m`. Defaults to `30s`.",
+			},
+			"max_response_size_mb": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum size, in megabytes, of a response from the definitions server. Larger responses fail with an error instead of exhausting the provider's memory. Must be between `1` and `1048576` (1 TiB). Defaults to `256`.",
+			},
+			"debug_dump_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path of a file to which a transcript of every request to the definitions server is appended, including headers, status, timings and the first 4 KiB of each response body. Credentials in headers and query parameters are redacted, and requests for access tokens and cloud credentials are recorded without their response bodies, so the file can be attached to support tickets.",
+			},
+			"audit_log_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path of a JSON Lines file to which every catalog read is appended, recording the timestamp, source, titles requested, versions returned and a SHA-256 hash of the returned content. Provides evidence of which definitions a run consumed.",
+			},
+			"uninstall_overlay_path": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a PNG file drawn onto uninstall icons in place of the built-in uninstall overlay badge. The image is composited at 128 pixels, or 256 pixels for retina icons, in the bottom right corner of every `uninstall_icon_base64` and `uninstall_icon_2x_base64`.",
+			},
+			"duplicate_titles": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "How catalog entries sharing a `title_name` are handled: `error` fails the read listing the version of every entry, `warn` reports a warning and uses the first entry of each title. When duplicates are expected, such as overlay entries appended to a catalog, `last_wins` uses the last entry of each title and `highest_version_wins` uses the entry with the highest `title_version`, keeping the earlier entry on ties; the entry used for each title is logged at debug level. Defaults to `error`.",
+			},
+			"locale": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Language tag, such as `de` or `pt-BR`, sent as the `Accept-Language` header on every request to the definitions server so all data sources and resources in a workspace receive the same localized catalog content. Content the server does not localize is returned in its default language. Has no effect with definitions_file.",
+			},
+			"api_version": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Version of the Definitions API to request, sent as an `Accept` header naming its media type with `application/json` as a fallback, so workspaces can opt into a new API version as soon as the server offers it. Titles are decoded according to the schema version the catalog declares, so servers answering with an older version keep working; catalog schema versions this provider cannot parse fail with an error instead of being misread. Only versions this provider can parse may be requested, currently `2`. Has no effect with definitions_file, definitions_json or catalogs in cloud storage. Unless set, no version is requested.",
+			},
+			"catalog_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Catalog snapshot every read is pinned to, sent to the definitions server as the `catalog_version` query parameter, so plans in environments promoted days apart read exactly the same catalog state. Applies to every request to the definitions server, including mirrors. Every catalog read must declare the same version in its top-level `catalog_version` key, so a server that ignores the parameter, a catalog object in cloud storage holding another snapshot or a `fallback_definitions_file` from another snapshot fails the read instead of returning different titles. Requires environment, definitions_url or definitions_urls. Reads the latest catalog unless set.",
+			},
+			"channel": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "The catalog feed titles are read from: `stable` for production definitions or `beta` for pre-release definitions, so pilot workspaces can consume upcoming titles while production stays on stable. Applies to every request to the definitions server, including mirrors. Catalogs read from the `beta` channel must declare it in their top-level `channel` key, and catalogs read from `stable` must not declare another, so a server that ignores the channel fails the read instead of returning titles from the wrong feed. Requires environment, definitions_url or definitions_urls. Defaults to `stable`.",
+			},
+			"strict_decoding": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Fail reads when the titles returned carry catalog fields this provider version does not read, listing the fields and titles, so additions to the upstream catalog schema are noticed instead of silently dropped. Unrecognised profile keys are not affected, since they are kept in `additional_profiles`. Defaults to `false`, ignoring unknown fields.",
+			},
+			"offline": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Guarantee that the provider makes no network calls, for air-gapped and regulated environments. Only definitions_file and definitions_json can be used; configurations that need network access, such as environment, definitions_url, definitions_urls or a data source's definitions_url, fail with an error, and any connection attempt is refused. Defaults to `false`.",
+			},
+			"validate_connection": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Check during provider configuration that the definitions source is reachable, with a `HEAD` request (falling back to `GET`) against the definitions URL or any of its mirrors, or by checking that the definitions files exist, so an unreachable source fails once with an actionable error instead of inside every data source read. Defaults to `false`.",
+			},
+			"require_catalog_schema": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Minimum catalog schema version required. When set, the provider reads the schema version reported by the definitions source during configuration and fails if it is older, preventing misparsing of incompatible catalog formats. Catalogs that do not declare a version are treated as version 2.",
 			},
 		},
 	}
 }
 
 func (p *JamfAutoUpdateProvider) Configure(ctx context.Context, req provider.ConfigureRequest, resp *provider.ConfigureResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
 	var data JamfAutoUpdateProviderModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -69,34 +289,470 @@ func (p *JamfAutoUpdateProvider) Configure(ctx context.Context, req provider.Con
 		return
 	}
 
-	definitionsURL := data.DefinitionsURL.ValueString()
-	if definitionsURL == "" {
-		definitionsURL = getenv(envDefinitionsURL)
+	if err := setAttributesFromEnv(&data); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid environment variable: %s.", err),
+		)
+		return
+	}
+
+	if err := setDefinitionsSourceFromEnv(&data); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid environment variable: %s.", err),
+		)
+		return
 	}
+
+	definitionsURL := data.DefinitionsURL.ValueString()
 	definitionsFile := data.DefinitionsFile.ValueString()
-	if definitionsFile == "" {
-		definitionsFile = getenv(envDefinitionsFile)
+
+	var definitionsURLs []string
+	if !data.DefinitionsURLs.IsNull() {
+		resp.Diagnostics.Append(data.DefinitionsURLs.ElementsAs(ctx, &definitionsURLs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if len(definitionsURLs) == 0 {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"definitions_urls must contain at least one URL.",
+			)
+			return
+		}
+		if !data.DefinitionsURL.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"Only one of definitions_url or definitions_urls may be set.",
+			)
+			return
+		}
+		definitionsURL = definitionsURLs[0]
+	}
+
+	inlineSet := !data.DefinitionsJSON.IsNull()
+	if inlineSet {
+		if !data.Environment.IsNull() || !data.DefinitionsURL.IsNull() || !data.DefinitionsURLs.IsNull() || !data.DefinitionsFile.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"Only one of environment, definitions_url, definitions_urls, definitions_file or definitions_json may be set.",
+			)
+			return
+		}
+		if data.DefinitionsJSON.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"definitions_json must not be empty.",
+			)
+			return
+		}
+		definitionsURL = ""
+		definitionsFile = ""
+	}
+
+	if !data.Environment.IsNull() {
+		if !data.DefinitionsURL.IsNull() || !data.DefinitionsURLs.IsNull() || !data.DefinitionsFile.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"Only one of environment, definitions_url, definitions_urls or definitions_file may be set.",
+			)
+			return
+		}
+		url, err := environmentURL(data.Environment.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid environment: %s.", err),
+			)
+			return
+		}
+		definitionsURL = url
+		definitionsFile = ""
 	}
 
 	urlSet := definitionsURL != ""
 	fileSet := definitionsFile != ""
 
-	if urlSet == fileSet {
+	if urlSet == fileSet && !inlineSet {
 		resp.Diagnostics.AddError(
 			"Invalid provider configuration",
-			"Exactly one of definitions_url or definitions_file must be set.",
+			"Exactly one of environment, definitions_url, definitions_urls, definitions_file or definitions_json must be set.",
+		)
+		return
+	}
+
+	if data.Offline.ValueBool() && urlSet {
+		resp.Diagnostics.AddError(
+			"Definitions source requires network access",
+			"offline is set, so titles can only be read from definitions_file or definitions_json. "+
+				"environment, definitions_url and definitions_urls, including their environment variables, "+
+				"read from a server over the network; unset them or unset offline.",
 		)
 		return
 	}
 
 	var clientObj *client.Client
-	if urlSet {
+	switch {
+	case inlineSet:
+		clientObj = client.NewInlineClient(data.DefinitionsJSON.ValueString())
+	case urlSet:
 		clientObj = client.NewClient(definitionsURL, "")
-	} else {
+		if len(definitionsURLs) > 1 {
+			clientObj.SetMirrors(definitionsURLs[1:]...)
+		}
+	default:
 		clientObj = client.NewClient("", definitionsFile)
 	}
 
 	clientObj.SetLogger(NewTerraformLogger())
+	clientObj.SetOffline(data.Offline.ValueBool())
+	clientObj.SetStrictDecoding(data.StrictDecoding.ValueBool())
+	clientObj.SetUserAgent(userAgent(p.version, data.AppendUserAgent.ValueString()))
+
+	if !data.FallbackFile.IsNull() {
+		if !urlSet {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"fallback_definitions_file requires environment, definitions_url or definitions_urls.",
+			)
+			return
+		}
+		clientObj.SetFallbackDefinitionsFile(data.FallbackFile.ValueString())
+	}
+
+	oauthSet := !data.ClientID.IsNull() || !data.ClientSecret.IsNull() || !data.TokenURL.IsNull()
+	basicSet := !data.BasicAuthUsername.IsNull() || !data.BasicAuthPassword.IsNull()
+	if basicSet {
+		if data.BasicAuthUsername.ValueString() == "" || data.BasicAuthPassword.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"basic_auth_username and basic_auth_password must be set together.",
+			)
+			return
+		}
+		if oauthSet || !data.AuthToken.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"Only one of auth_token, client_id or basic_auth_username may be set.",
+			)
+			return
+		}
+	}
+
+	switch {
+	case basicSet:
+		clientObj.SetBasicAuth(data.BasicAuthUsername.ValueString(), data.BasicAuthPassword.ValueString())
+	case oauthSet:
+		if data.ClientID.ValueString() == "" || data.ClientSecret.ValueString() == "" || data.TokenURL.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"client_id, client_secret and token_url must be set together.",
+			)
+			return
+		}
+		if !data.AuthToken.IsNull() {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"Only one of auth_token or client_id may be set.",
+			)
+			return
+		}
+		clientObj.SetOAuth2ClientCredentials(data.TokenURL.ValueString(), data.ClientID.ValueString(), data.ClientSecret.ValueString())
+	default:
+		authToken := data.AuthToken.ValueString()
+		if authToken == "" {
+			authToken = getenv(envAuthToken)
+		}
+		clientObj.SetAuthToken(authToken)
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		clientObj.SetInsecureSkipVerify(true)
+		resp.Diagnostics.AddWarning(
+			"TLS certificate verification disabled",
+			"insecure_skip_verify is enabled, so the provider does not verify the definitions server's TLS certificate. "+
+				"Catalog content could be intercepted or altered in transit. Only use this setting in lab environments.",
+		)
+	}
+
+	if data.DisableKeepAlives.ValueBool() {
+		clientObj.SetDisableKeepAlives(true)
+	}
+
+	if !data.MaxIdleConnections.IsNull() {
+		maxIdle := data.MaxIdleConnections.ValueInt64()
+		if maxIdle < 0 {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("max_idle_connections must not be negative, got %d.", maxIdle),
+			)
+			return
+		}
+		clientObj.SetMaxIdleConns(int(maxIdle))
+	}
+
+	if !data.EnableHTTP2.IsNull() {
+		clientObj.SetHTTP2Enabled(data.EnableHTTP2.ValueBool())
+	}
+
+	if !data.ProxyURL.IsNull() {
+		if err := clientObj.SetProxyURL(data.ProxyURL.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid proxy_url: %s.", err),
+			)
+			return
+		}
+	}
+
+	if !data.MaxConcurrent.IsNull() {
+		if err := clientObj.SetMaxConcurrentRequests(int(data.MaxConcurrent.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid max_concurrent_requests: %s.", err),
+			)
+			return
+		}
+	}
+
+	if !data.MaxRetries.IsNull() {
+		if err := clientObj.SetMaxRetries(int(data.MaxRetries.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid max_retries: %s.", err),
+			)
+			return
+		}
+	}
+
+	connectTimeout, err := parseOptionalDuration(data.ConnectTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid connect_timeout: %s.", err),
+		)
+		return
+	}
+	tlsHandshakeTimeout, err := parseOptionalDuration(data.TLSHandshakeTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid tls_handshake_timeout: %s.", err),
+		)
+		return
+	}
+	responseHeaderTimeout, err := parseOptionalDuration(data.ResponseHeaderTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid response_header_timeout: %s.", err),
+		)
+		return
+	}
+	if err := clientObj.SetTimeouts(connectTimeout, tlsHandshakeTimeout, responseHeaderTimeout); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid timeouts: %s.", err),
+		)
+		return
+	}
+
+	retryMinWait, err := parseOptionalDuration(data.RetryMinWait)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid retry_min_wait: %s.", err),
+		)
+		return
+	}
+	retryMaxWait, err := parseOptionalDuration(data.RetryMaxWait)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid retry_max_wait: %s.", err),
+		)
+		return
+	}
+	if err := clientObj.SetRetryWait(retryMinWait, retryMaxWait); err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid retry waits: %s.", err),
+		)
+		return
+	}
+
+	cacheTTL, err := parseOptionalDuration(data.CacheTTL)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			fmt.Sprintf("Invalid cache_ttl: %s.", err),
+		)
+		return
+	}
+	if data.CacheDir.IsNull() && cacheTTL != nil {
+		resp.Diagnostics.AddError(
+			"Invalid provider configuration",
+			"cache_ttl requires cache_dir.",
+		)
+		return
+	}
+	if !data.CacheDir.IsNull() {
+		ttl := defaultCacheTTL
+		if cacheTTL != nil {
+			ttl = *cacheTTL
+		}
+		if err := clientObj.SetCache(data.CacheDir.ValueString(), ttl); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid cache_dir: %s.", err),
+			)
+			return
+		}
+	}
+
+	if !data.MaxResponseSizeMB.IsNull() {
+		maxSize := data.MaxResponseSizeMB.ValueInt64()
+		if maxSize <= 0 || maxSize > maxResponseSizeMB {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("max_response_size_mb must be between 1 and %d, got %d.", maxResponseSizeMB, maxSize),
+			)
+			return
+		}
+		clientObj.SetMaxResponseSize(maxSize << 20)
+	}
+
+	if !data.DuplicateTitles.IsNull() {
+		if err := clientObj.SetDuplicateTitlesPolicy(data.DuplicateTitles.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid duplicate_titles: %s.", err),
+			)
+			return
+		}
+	}
+
+	if !data.Locale.IsNull() {
+		if err := clientObj.SetLocale(data.Locale.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid locale: %s.", err),
+			)
+			return
+		}
+	}
+
+	if !data.Channel.IsNull() {
+		if err := clientObj.SetChannel(data.Channel.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid channel: %s.", err),
+			)
+			return
+		}
+		if !urlSet {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"channel requires environment, definitions_url or definitions_urls; definitions_file and definitions_json hold a single catalog.",
+			)
+			return
+		}
+	}
+
+	if !data.APIVersion.IsNull() {
+		if err := clientObj.SetAPIVersion(int(data.APIVersion.ValueInt64())); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid api_version: %s.", err),
+			)
+			return
+		}
+	}
+
+	if !data.CatalogVersion.IsNull() {
+		if err := clientObj.SetCatalogVersion(data.CatalogVersion.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				fmt.Sprintf("Invalid catalog_version: %s.", err),
+			)
+			return
+		}
+		if !urlSet {
+			resp.Diagnostics.AddError(
+				"Invalid provider configuration",
+				"catalog_version requires environment, definitions_url or definitions_urls; definitions_file and definitions_json are pinned by their content.",
+			)
+			return
+		}
+	}
+
+	if dumpPath := data.DebugDumpPath.ValueString(); dumpPath != "" {
+		if err := clientObj.SetDumpFile(dumpPath); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to open debug dump file",
+				fmt.Sprintf("debug_dump_path is set but %q could not be opened for writing: %s", dumpPath, err),
+			)
+			return
+		}
+	}
+
+	if auditPath := data.AuditLogPath.ValueString(); auditPath != "" {
+		if err := clientObj.SetAuditLog(auditPath); err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to open audit log",
+				fmt.Sprintf("audit_log_path is set but %q could not be opened for writing: %s", auditPath, err),
+			)
+			return
+		}
+	}
+
+	if overlayPath := data.UninstallOverlayPath.ValueString(); overlayPath != "" {
+		overlay, err := imaging.LoadOverlayFile(overlayPath)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to load uninstall overlay",
+				fmt.Sprintf("uninstall_overlay_path is set but %q could not be loaded: %s", overlayPath, err),
+			)
+			return
+		}
+		clientObj.SetUninstallOverlay(overlay)
+	}
+
+	if data.ValidateConnection.ValueBool() {
+		checkCtx, cancel := context.WithTimeout(ctx, validateConnectionTimeout)
+		status := clientObj.CheckConnection(checkCtx)
+		cancel()
+		if !status.Healthy {
+			resp.Diagnostics.AddError(
+				"Unable to reach definitions source",
+				fmt.Sprintf("validate_connection is set but the definitions source could not be reached: %s. "+
+					"Check the definitions URL or file, network access to the definitions server (including proxy_url) and the configured credentials, "+
+					"or unset validate_connection to defer the check to data source reads.", status.Message),
+			)
+			return
+		}
+	}
+
+	if !data.RequireCatalogSchema.IsNull() {
+		requiredVersion := data.RequireCatalogSchema.ValueInt64()
+		version, err := clientObj.CatalogSchemaVersion(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to determine catalog schema version",
+				fmt.Sprintf("require_catalog_schema is set but the catalog schema version could not be read: %s", err),
+			)
+			return
+		}
+		if int64(version) < requiredVersion {
+			resp.Diagnostics.AddError(
+				"Incompatible catalog schema version",
+				fmt.Sprintf("The definitions source reports catalog schema version %d, but require_catalog_schema requires version %d or later. "+
+					"Point the provider at a catalog that publishes the required schema, or lower require_catalog_schema.", version, requiredVersion),
+			)
+			return
+		}
+	}
 
 	p.client = clientObj
 	resp.DataSourceData = clientObj
@@ -104,12 +760,33 @@ func (p *JamfAutoUpdateProvider) Configure(ctx context.Context, req provider.Con
 }
 
 func (p *JamfAutoUpdateProvider) Resources(ctx context.Context) []func() resource.Resource {
-	return []func() resource.Resource{}
+	return []func() resource.Resource{
+		manifest.NewAuditManifestResource,
+		export.NewAssetExportResource,
+		mirror.NewDefinitionsMirrorResource,
+	}
 }
 
 func (p *JamfAutoUpdateProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		titles.NewTitlesDataSource,
+		titles.NewTitleDataSource,
+		titles.NewTitleIconDataSource,
+		titles.NewTitleNamesDataSource,
+		titles.NewPatchDefinitionDataSource,
+		titles.NewExtensionAttributeDataSource,
+		titles.NewTitleProfilesDataSource,
+		servicestatus.NewServiceStatusDataSource,
+	}
+}
+
+func (p *JamfAutoUpdateProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		functions.NewSemverSortFunction,
+		functions.NewLatestVersionFunction,
+		functions.NewProfilePayloadTypesFunction,
+		functions.NewCanonicalizePlistFunction,
+		functions.NewStripMarkupFunction,
 	}
 }
 
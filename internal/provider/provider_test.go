@@ -41,26 +41,74 @@ func TestProviderSchema(t *testing.T) {
 		t.Fatal("expected non-nil schema attributes")
 	}
 
-	if _, ok := attrs["definitions_url"]; !ok {
-		t.Error("expected definitions_url attribute in schema")
-	}
-	if _, ok := attrs["definitions_file"]; !ok {
-		t.Error("expected definitions_file attribute in schema")
+	for _, name := range []string{
+		"definitions_url",
+		"definitions_urls",
+		"definitions_file",
+		"fallback_definitions_file",
+		"definitions_json",
+		"append_user_agent",
+		"cache_dir",
+		"cache_ttl",
+		"auth_token",
+		"client_id",
+		"client_secret",
+		"token_url",
+		"environment",
+		"insecure_skip_verify",
+		"disable_keep_alives",
+		"max_idle_connections",
+		"enable_http2",
+		"proxy_url",
+		"max_concurrent_requests",
+		"catalog_version",
+		"basic_auth_username",
+		"basic_auth_password",
+		"connect_timeout",
+		"tls_handshake_timeout",
+		"response_header_timeout",
+		"validate_connection",
+		"offline",
+		"api_version",
+		"strict_decoding",
+		"max_retries",
+		"retry_min_wait",
+		"retry_max_wait",
+		"max_response_size_mb",
+		"debug_dump_path",
+		"audit_log_path",
+		"uninstall_overlay_path",
+		"duplicate_titles",
+		"locale",
+		"channel",
+		"require_catalog_schema",
+	} {
+		if _, ok := attrs[name]; !ok {
+			t.Errorf("expected %s attribute in schema", name)
+		}
 	}
 }
 
 func TestProviderDataSources(t *testing.T) {
 	p := &JamfAutoUpdateProvider{}
 	dataSources := p.DataSources(context.Background())
-	if len(dataSources) != 1 {
-		t.Errorf("expected 1 data source, got %d", len(dataSources))
+	if len(dataSources) != 8 {
+		t.Errorf("expected 8 data sources, got %d", len(dataSources))
 	}
 }
 
 func TestProviderResources(t *testing.T) {
 	p := &JamfAutoUpdateProvider{}
 	resources := p.Resources(context.Background())
-	if len(resources) != 0 {
-		t.Errorf("expected 0 resources, got %d", len(resources))
+	if len(resources) != 3 {
+		t.Errorf("expected 3 resources, got %d", len(resources))
+	}
+}
+
+func TestProviderFunctions(t *testing.T) {
+	p := &JamfAutoUpdateProvider{}
+	functions := p.Functions(context.Background())
+	if len(functions) != 5 {
+		t.Errorf("expected 5 functions, got %d", len(functions))
 	}
 }
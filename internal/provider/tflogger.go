@@ -9,7 +9,7 @@ import (
 	"net/http"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
-	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
 )
 
 // maxLogBodyDisplay is the maximum number of characters to display in logged response bodies.
@@ -18,7 +18,7 @@ const maxLogBodyDisplay = 5000
 // Ensure TerraformLogger implements client.Logger interface
 var _ client.Logger = (*TerraformLogger)(nil)
 
-// TerraformLogger implements the client.Logger interface using tflog
+// TerraformLogger implements the client.Logger interface using the tflog client subsystem
 type TerraformLogger struct{}
 
 // NewTerraformLogger creates a new TerraformLogger
@@ -41,7 +41,7 @@ func prettyPrintJSON(data []byte) string {
 	return string(pretty)
 }
 
-// LogRequest logs HTTP request details using tflog at DEBUG level
+// LogRequest logs HTTP request details using the client subsystem at DEBUG level
 func (l *TerraformLogger) LogRequest(ctx context.Context, method, url string, body []byte) {
 	fields := map[string]any{
 		"method": method,
@@ -52,10 +52,10 @@ func (l *TerraformLogger) LogRequest(ctx context.Context, method, url string, bo
 		fields["request_body"] = prettyPrintJSON(body)
 	}
 
-	tflog.Debug(ctx, "HTTP Request", fields)
+	logging.ClientDebug(ctx, "HTTP Request", fields)
 }
 
-// LogResponse logs HTTP response details using tflog at DEBUG level
+// LogResponse logs HTTP response details using the client subsystem at DEBUG level
 func (l *TerraformLogger) LogResponse(ctx context.Context, statusCode int, headers http.Header, body []byte) {
 	fields := map[string]any{
 		"status_code": statusCode,
@@ -83,10 +83,10 @@ func (l *TerraformLogger) LogResponse(ctx context.Context, statusCode int, heade
 		}
 	}
 
-	tflog.Debug(ctx, "HTTP Response", fields)
+	logging.ClientDebug(ctx, "HTTP Response", fields)
 }
 
-// LogAuth logs authentication-related events using tflog at DEBUG level
+// LogAuth logs authentication-related events using the client subsystem at DEBUG level
 func (l *TerraformLogger) LogAuth(ctx context.Context, message string, fields map[string]any) {
-	tflog.Debug(ctx, message, fields)
+	logging.ClientDebug(ctx, message, fields)
 }
@@ -0,0 +1,21 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "strings"
+
+// userAgentProduct is the product token the provider identifies itself with.
+const userAgentProduct = "terraform-provider-jamfautoupdate"
+
+// userAgent returns the User-Agent sent to the definitions server: the provider name and version,
+// followed by the TF_APPEND_USER_AGENT environment variable and the append_user_agent attribute.
+func userAgent(version, appendUserAgent string) string {
+	parts := []string{userAgentProduct + "/" + version}
+	for _, extra := range []string{getenv(envAppendUserAgent), appendUserAgent} {
+		if extra = strings.TrimSpace(extra); extra != "" {
+			parts = append(parts, extra)
+		}
+	}
+	return strings.Join(parts, " ")
+}
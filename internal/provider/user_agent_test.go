@@ -0,0 +1,18 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import "testing"
+
+func TestUserAgent(t *testing.T) {
+	t.Setenv(envAppendUserAgent, "")
+	if got := userAgent("1.2.3", ""); got != "terraform-provider-jamfautoupdate/1.2.3" {
+		t.Errorf("unexpected User-Agent %q", got)
+	}
+
+	t.Setenv(envAppendUserAgent, " ci-runner/7 ")
+	if got := userAgent("1.2.3", "acme-it"); got != "terraform-provider-jamfautoupdate/1.2.3 ci-runner/7 acme-it" {
+		t.Errorf("unexpected User-Agent %q", got)
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// AddClientError appends a diagnostic describing an error returned by the client.
+// Errors with a well-known type are given a dedicated summary and detail; any other error
+// is reported under the given summary.
+func AddClientError(diags *diag.Diagnostics, summary string, err error) {
+	if titlesErr, ok := errors.AsType[*client.TitlesNotFoundError](err); ok {
+		diags.AddError(
+			"Requested titles not found",
+			fmt.Sprintf("The following titles do not exist: %s",
+				strings.Join(titlesErr.MissingTitles, ", ")),
+		)
+		return
+	}
+
+	if bundleErr, ok := errors.AsType[*client.BundleIDsNotFoundError](err); ok {
+		diags.AddError(
+			"Requested bundle IDs not found",
+			fmt.Sprintf("No titles have the following bundle IDs: %s",
+				strings.Join(bundleErr.MissingBundleIDs, ", ")),
+		)
+		return
+	}
+
+	if malformedErr, ok := errors.AsType[*client.MalformedTitlesError](err); ok {
+		diags.AddError(
+			"Malformed titles in catalog",
+			fmt.Sprintf("%s. The catalog entries have fields of an unexpected type and cannot be used.", malformedErr),
+		)
+		return
+	}
+
+	if unknownErr, ok := errors.AsType[*client.UnknownFieldsError](err); ok {
+		diags.AddError(
+			"Unknown fields in catalog",
+			fmt.Sprintf("The %s. The upstream catalog schema has gained data this provider version drops. "+
+				"Upgrade the provider to a release that reads these fields, or unset strict_decoding in the provider configuration to ignore them.", unknownErr),
+		)
+		return
+	}
+
+	if duplicateErr, ok := errors.AsType[*client.DuplicateTitlesError](err); ok {
+		diags.AddError(
+			"Duplicate titles in catalog",
+			fmt.Sprintf("%s. Remove the duplicate entries from the catalog, or set duplicate_titles in the provider configuration to %q, %q or %q to choose an entry for each title.",
+				duplicateErr, client.DuplicateTitlesPolicyWarn, client.DuplicateTitlesPolicyLastWins, client.DuplicateTitlesPolicyHighestVersionWins),
+		)
+		return
+	}
+
+	if versionErr, ok := errors.AsType[*client.UnsupportedSchemaVersionError](err); ok {
+		diags.AddError(
+			"Unsupported catalog schema version",
+			fmt.Sprintf("The definitions source publishes catalog schema version %d, which this provider cannot parse without risking misreading title fields. "+
+				"Upgrade the provider to a release that supports schema version %d, or point it at a catalog in a supported schema version. (%s)",
+				versionErr.Version, versionErr.Version, versionErr),
+		)
+		return
+	}
+
+	if sizeErr, ok := errors.AsType[*client.ResponseTooLargeError](err); ok {
+		diags.AddError(
+			"Definitions response too large",
+			fmt.Sprintf("The definitions response was larger than the configured limit (%s). "+
+				"Increase max_response_size_mb if the catalog is expected to be this large.", sizeErr),
+		)
+		return
+	}
+
+	if contentErr, ok := errors.AsType[*client.UnexpectedContentTypeError](err); ok {
+		diags.AddError(
+			"Unexpected response from definitions server",
+			fmt.Sprintf("The definitions server returned %s instead of a JSON catalog. "+
+				"This usually means the request was intercepted by a captive portal, VPN or single sign-on page. "+
+				"The response began with: %s", contentErr.ContentType, contentErr.Snippet),
+		)
+		return
+	}
+
+	if authErr, ok := errors.AsType[*client.AuthenticationError](err); ok {
+		diags.AddError(
+			"Definitions API rejected the request",
+			fmt.Sprintf("The definitions server responded with status code %d. %s\n\nServer response: %s",
+				authErr.Err.StatusCode, authGuidance(authErr.Mechanism), authErr.Err.Error()),
+		)
+		return
+	}
+
+	if rateErr, ok := errors.AsType[*client.RateLimitedError](err); ok {
+		detail := "The definitions server returned 429 Too Many Requests and the provider's retries did not succeed within the read timeout."
+		if rateErr.RetryAfter > 0 {
+			detail += fmt.Sprintf(" The server asked the provider to wait %s before retrying.", rateErr.RetryAfter)
+		}
+		detail += " Reduce the number of concurrent reads with terraform -parallelism, " +
+			"raise the provider's max_retries and increase the data source read timeout so more retries fit within it, or try again later."
+		diags.AddError("Rate limited by definitions server", detail)
+		return
+	}
+
+	diags.AddError(summary, err.Error())
+}
+
+// AddSourceWarnings appends a warning for each problem the client resolved while reading titles
+// from source, such as duplicate catalog entries or profile types it does not recognise, and when
+// titles were read from the fallback definitions file.
+func AddSourceWarnings(diags *diag.Diagnostics, source client.Source) {
+	if source.FallbackReason != "" {
+		diags.AddWarning(
+			"Titles read from fallback definitions file",
+			fmt.Sprintf("The Definitions API could not be reached, so titles were read from %s, which may be out of date.\n\nError: %s",
+				source.Location, source.FallbackReason),
+		)
+	}
+	for _, duplicate := range source.Duplicates {
+		diags.AddWarning(
+			"Duplicate title in catalog",
+			fmt.Sprintf("Title %q appears %d times in the catalog with versions %s; the first entry was used.",
+				duplicate.TitleName, len(duplicate.Versions), strings.Join(duplicate.Versions, ", ")),
+		)
+	}
+	for _, profile := range source.UnknownProfiles {
+		diags.AddWarning(
+			"Unknown profile type in catalog",
+			fmt.Sprintf("The catalog contains a %q key, which this provider version does not recognise, for titles %s. "+
+				"It is available in the additional_profiles attribute of those titles.",
+				profile.Key, strings.Join(profile.TitleNames, ", ")),
+		)
+	}
+}
+
+// authGuidance explains how to resolve an authentication failure for the given mechanism.
+func authGuidance(mechanism string) string {
+	switch mechanism {
+	case client.AuthMechanismNone:
+		return "The provider does not send credentials, so the Definitions API only accepts requests from trusted networks. " +
+			"Connect through Jamf Trust or run from a trusted IP range, set auth_token (or the JAMF_AUTO_UPDATE_TOKEN " +
+			"environment variable) for endpoints behind a gateway that requires a token, or set definitions_url (or the " +
+			"JAMF_AUTO_UPDATE_DEFINITIONS_URL environment variable) to an endpoint that does not require authentication."
+	case client.AuthMechanismOAuth2:
+		return "The access token obtained with client_id and client_secret from token_url was rejected. " +
+			"Check that the client is granted access to the Definitions API; a new token is requested on the next read."
+	case client.AuthMechanismAWSSigV4:
+		return "The request for the catalog object was signed with the AWS credentials from the environment or the shared credentials file. " +
+			"Check that the credentials are valid and grant s3:GetObject on the object, and that the region is correct."
+	case client.AuthMechanismGoogleAccessToken:
+		return "The request for the catalog object carried an access token from Google Application Default Credentials: GOOGLE_OAUTH_ACCESS_TOKEN, " +
+			"the credentials file in GOOGLE_APPLICATION_CREDENTIALS or written by `gcloud auth application-default login`, or the metadata server. " +
+			"Check that the token has not expired and that its account is granted storage.objects.get on the object."
+	case client.AuthMechanismAzureSAS, client.AuthMechanismAzureSharedKey:
+		return "The request for the catalog blob was authorized with AZURE_STORAGE_SAS_TOKEN or AZURE_STORAGE_KEY. " +
+			"Check that the SAS token has not expired and allows reading the blob, or that the account key belongs to AZURE_STORAGE_ACCOUNT."
+	case client.AuthMechanismAzureEntraID:
+		return "The request for the catalog blob carried a Microsoft Entra ID token of the service principal in AZURE_CLIENT_ID and AZURE_CLIENT_SECRET, " +
+			"the workload identity in AZURE_FEDERATED_TOKEN_FILE or the managed identity of the app or virtual machine. " +
+			"Check that the identity is assigned the Storage Blob Data Reader role on the container or storage account."
+	case client.AuthMechanismBasic:
+		return "The username and password configured with basic_auth_username and basic_auth_password were rejected. " +
+			"Check that they are correct and grant access to the definitions server and every mirror."
+	case client.AuthMechanismBearer:
+		return "The bearer token configured with auth_token (or the JAMF_AUTO_UPDATE_TOKEN environment variable) was rejected. " +
+			"Check that it is valid, has not expired and grants access to the Definitions API."
+	default:
+		return fmt.Sprintf("The credentials configured for %s authentication were rejected. Check that they are valid and have access to the Definitions API.", mechanism)
+	}
+}
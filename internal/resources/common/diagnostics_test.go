@@ -0,0 +1,298 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestAddClientError_TitlesNotFound(t *testing.T) {
+	var diags diag.Diagnostics
+	err := fmt.Errorf("wrapped: %w", &client.TitlesNotFoundError{MissingTitles: []string{"AppA", "AppB"}})
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if len(diags) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(diags))
+	}
+	if diags[0].Summary() != "Requested titles not found" {
+		t.Errorf("expected titles not found summary, got %q", diags[0].Summary())
+	}
+	if !strings.Contains(diags[0].Detail(), "AppA, AppB") {
+		t.Errorf("expected detail to list missing titles, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddClientError_MalformedTitles(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.MalformedTitlesError{Titles: []client.MalformedTitle{
+		{TitleName: "AppA", Err: errors.New("bad field")},
+	}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if diags[0].Summary() != "Malformed titles in catalog" {
+		t.Errorf("expected malformed titles summary, got %q", diags[0].Summary())
+	}
+	if !strings.Contains(diags[0].Detail(), "AppA: bad field") {
+		t.Errorf("expected detail to describe malformed title, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddClientError_DuplicateTitles(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.DuplicateTitlesError{Titles: []client.DuplicateTitle{
+		{TitleName: "AppA", Versions: []string{"1.0", "2.0"}},
+	}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if diags[0].Summary() != "Duplicate titles in catalog" {
+		t.Errorf("expected duplicate titles summary, got %q", diags[0].Summary())
+	}
+	if !strings.Contains(diags[0].Detail(), "AppA (1.0, 2.0)") {
+		t.Errorf("expected detail to list both versions, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddClientError_UnsupportedSchemaVersion(t *testing.T) {
+	var diags diag.Diagnostics
+	err := fmt.Errorf("wrapped: %w", &client.UnsupportedSchemaVersionError{Version: 3, Supported: []int{2}})
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if diags[0].Summary() != "Unsupported catalog schema version" {
+		t.Errorf("expected unsupported schema version summary, got %q", diags[0].Summary())
+	}
+	if !strings.Contains(diags[0].Detail(), "supported versions: 2") {
+		t.Errorf("expected detail to list supported versions, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddSourceWarnings(t *testing.T) {
+	var diags diag.Diagnostics
+	AddSourceWarnings(&diags, client.Source{Duplicates: []client.DuplicateTitle{
+		{TitleName: "AppA", Versions: []string{"1.0", "2.0"}},
+		{TitleName: "AppB", Versions: []string{"3.0", "3.1"}},
+	}})
+
+	if diags.WarningsCount() != 2 || diags.HasError() {
+		t.Fatalf("expected 2 warnings, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "1.0, 2.0") {
+		t.Errorf("expected detail to list both versions, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddSourceWarnings_UnknownProfiles(t *testing.T) {
+	var diags diag.Diagnostics
+	AddSourceWarnings(&diags, client.Source{UnknownProfiles: []client.UnknownProfile{
+		{Key: "dns_proxy_profile", TitleNames: []string{"AppA", "AppB"}},
+	}})
+
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("expected 1 warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), `"dns_proxy_profile"`) || !strings.Contains(diags[0].Detail(), "AppA, AppB") {
+		t.Errorf("expected detail to name the key and titles, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddSourceWarnings_Fallback(t *testing.T) {
+	var diags diag.Diagnostics
+	AddSourceWarnings(&diags, client.Source{
+		Type:           client.SourceTypeFile,
+		Location:       "/var/lib/definitions.json",
+		FallbackReason: "connection refused",
+	})
+
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("expected 1 warning, got %v", diags)
+	}
+	if !strings.Contains(diags[0].Detail(), "/var/lib/definitions.json") || !strings.Contains(diags[0].Detail(), "connection refused") {
+		t.Errorf("expected detail to name the file and the API error, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddClientError_BundleIDsNotFound(t *testing.T) {
+	var diags diag.Diagnostics
+	AddClientError(&diags, "Unable to read titles", &client.BundleIDsNotFoundError{MissingBundleIDs: []string{"us.zoom.xos", "com.example.app"}})
+
+	if diags[0].Summary() != "Requested bundle IDs not found" {
+		t.Errorf("expected bundle IDs not found summary, got %q", diags[0].Summary())
+	}
+	if !strings.Contains(diags[0].Detail(), "us.zoom.xos, com.example.app") {
+		t.Errorf("expected detail to list the missing bundle IDs, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddClientError_ResponseTooLarge(t *testing.T) {
+	var diags diag.Diagnostics
+	err := fmt.Errorf("error decoding response: %w", &client.ResponseTooLargeError{Limit: 10 << 20})
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if diags[0].Summary() != "Definitions response too large" {
+		t.Errorf("expected response too large summary, got %q", diags[0].Summary())
+	}
+	if !strings.Contains(diags[0].Detail(), "response exceeded 10 MB") {
+		t.Errorf("expected detail to include the limit, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddClientError_UnexpectedContentType(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.UnexpectedContentTypeError{ContentType: "text/html", Snippet: "<html><title>Sign in</title>"}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if diags[0].Summary() != "Unexpected response from definitions server" {
+		t.Errorf("expected unexpected response summary, got %q", diags[0].Summary())
+	}
+	if !strings.Contains(diags[0].Detail(), "<title>Sign in</title>") {
+		t.Errorf("expected detail to include the body snippet, got %q", diags[0].Detail())
+	}
+}
+
+func TestAddClientError_Authentication(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.AuthenticationError{Mechanism: client.AuthMechanismNone, Err: &client.APIError{StatusCode: 403}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if diags[0].Summary() != "Definitions API rejected the request" {
+		t.Errorf("expected authentication summary, got %q", diags[0].Summary())
+	}
+	for _, want := range []string{"status code 403", "does not send credentials", "JAMF_AUTO_UPDATE_DEFINITIONS_URL"} {
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("expected detail to contain %q, got %q", want, diags[0].Detail())
+		}
+	}
+}
+
+func TestAddClientError_AuthenticationBearer(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.AuthenticationError{Mechanism: client.AuthMechanismBearer, Err: &client.APIError{StatusCode: 401}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	for _, want := range []string{"status code 401", "auth_token", "JAMF_AUTO_UPDATE_TOKEN"} {
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("expected detail to contain %q, got %q", want, diags[0].Detail())
+		}
+	}
+}
+
+func TestAddClientError_AuthenticationOAuth2(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.AuthenticationError{Mechanism: client.AuthMechanismOAuth2, Err: &client.APIError{StatusCode: 401}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	for _, want := range []string{"status code 401", "client_id", "token_url"} {
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("expected detail to contain %q, got %q", want, diags[0].Detail())
+		}
+	}
+}
+
+func TestAddClientError_UnknownFields(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.UnknownFieldsError{Fields: map[string][]string{"release_notes": {"Firefox"}}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if diags[0].Summary() != "Unknown fields in catalog" {
+		t.Errorf("unexpected summary: %s", diags[0].Summary())
+	}
+	for _, want := range []string{"release_notes (Firefox)", "strict_decoding"} {
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("expected detail to contain %q, got %q", want, diags[0].Detail())
+		}
+	}
+}
+
+func TestAddClientError_AuthenticationBasic(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.AuthenticationError{Mechanism: client.AuthMechanismBasic, Err: &client.APIError{StatusCode: 401}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	for _, want := range []string{"status code 401", "basic_auth_username", "basic_auth_password"} {
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("expected detail to contain %q, got %q", want, diags[0].Detail())
+		}
+	}
+}
+
+func TestAddClientError_AuthenticationAWSSigV4(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.AuthenticationError{Mechanism: client.AuthMechanismAWSSigV4, Err: &client.APIError{StatusCode: 403}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	for _, want := range []string{"status code 403", "AWS credentials", "s3:GetObject"} {
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("expected detail to contain %q, got %q", want, diags[0].Detail())
+		}
+	}
+}
+
+func TestAddClientError_AuthenticationObjectStores(t *testing.T) {
+	tests := map[string]string{
+		client.AuthMechanismGoogleAccessToken: "GOOGLE_OAUTH_ACCESS_TOKEN",
+		client.AuthMechanismAzureSAS:          "AZURE_STORAGE_SAS_TOKEN",
+		client.AuthMechanismAzureSharedKey:    "AZURE_STORAGE_KEY",
+		client.AuthMechanismAzureEntraID:      "managed identity",
+	}
+	for mechanism, want := range tests {
+		var diags diag.Diagnostics
+		err := &client.AuthenticationError{Mechanism: mechanism, Err: &client.APIError{StatusCode: 403}}
+
+		AddClientError(&diags, "Unable to read titles", err)
+
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("%s: expected detail to contain %q, got %q", mechanism, want, diags[0].Detail())
+		}
+	}
+}
+
+func TestAddClientError_RateLimited(t *testing.T) {
+	var diags diag.Diagnostics
+	err := &client.RateLimitedError{RetryAfter: 2 * time.Minute, Err: &client.APIError{StatusCode: 429}}
+
+	AddClientError(&diags, "Unable to read titles", err)
+
+	if diags[0].Summary() != "Rate limited by definitions server" {
+		t.Errorf("expected rate limited summary, got %q", diags[0].Summary())
+	}
+	for _, want := range []string{"wait 2m0s", "-parallelism", "max_retries", "read timeout"} {
+		if !strings.Contains(diags[0].Detail(), want) {
+			t.Errorf("expected detail to contain %q, got %q", want, diags[0].Detail())
+		}
+	}
+}
+
+func TestAddClientError_Generic(t *testing.T) {
+	var diags diag.Diagnostics
+
+	AddClientError(&diags, "Unable to read titles", errors.New("boom"))
+
+	if !diags.HasError() {
+		t.Fatal("expected an error diagnostic")
+	}
+	if diags[0].Summary() != "Unable to read titles" {
+		t.Errorf("expected the given summary, got %q", diags[0].Summary())
+	}
+	if diags[0].Detail() != "boom" {
+		t.Errorf("expected detail boom, got %q", diags[0].Detail())
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// TitleVersion is the version of a title read from the catalog.
+type TitleVersion struct {
+	TitleName    string
+	TitleVersion string
+}
+
+// AddVersionChangesWarning appends a warning summarizing, one line such as
+// "GoogleChrome: 119.0 → 120.0" per title, every title in current whose version differs from the
+// one recorded for it in previous, so reviewers can see meaningful changes in the plan output.
+// Titles added or removed are not reported, and no warning is added when nothing changed.
+func AddVersionChangesWarning(diags *diag.Diagnostics, previous map[string]string, current []TitleVersion) {
+	changes := versionChanges(previous, current)
+	if len(changes) == 0 {
+		return
+	}
+	diags.AddWarning(
+		"Jamf Auto Update title versions changed",
+		fmt.Sprintf("The following titles have new versions in the catalog:\n\n%s", strings.Join(changes, "\n")),
+	)
+}
+
+// versionChanges returns a summary line for every title in current whose version differs from the
+// one recorded for it in previous.
+func versionChanges(previous map[string]string, current []TitleVersion) []string {
+	var changes []string
+	for _, title := range current {
+		previousVersion, ok := previous[title.TitleName]
+		if !ok || previousVersion == title.TitleVersion {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s: %s → %s", title.TitleName, previousVersion, title.TitleVersion))
+	}
+	return changes
+}
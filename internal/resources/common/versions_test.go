@@ -0,0 +1,48 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestVersionChanges(t *testing.T) {
+	previous := map[string]string{
+		"GoogleChrome": "119.0",
+		"Firefox":      "121.0",
+		"Removed":      "1.0",
+	}
+	current := []TitleVersion{
+		{TitleName: "Firefox", TitleVersion: "121.0"},
+		{TitleName: "GoogleChrome", TitleVersion: "120.0"},
+		{TitleName: "Added", TitleVersion: "2.0"},
+	}
+
+	changes := versionChanges(previous, current)
+	if len(changes) != 1 {
+		t.Fatalf("expected 1 change, got %v", changes)
+	}
+	if changes[0] != "GoogleChrome: 119.0 → 120.0" {
+		t.Errorf("unexpected change summary: %s", changes[0])
+	}
+}
+
+func TestAddVersionChangesWarning(t *testing.T) {
+	var diags diag.Diagnostics
+	AddVersionChangesWarning(&diags, nil, []TitleVersion{{TitleName: "GoogleChrome", TitleVersion: "120.0"}})
+	if len(diags) != 0 {
+		t.Fatalf("expected no warning without previous versions, got %v", diags)
+	}
+
+	AddVersionChangesWarning(&diags, map[string]string{"GoogleChrome": "119.0"}, []TitleVersion{{TitleName: "GoogleChrome", TitleVersion: "120.0"}})
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning, got %v", diags)
+	}
+	if detail := diags[0].Detail(); !strings.Contains(detail, "GoogleChrome: 119.0 → 120.0") {
+		t.Errorf("expected the change in the warning, got %q", detail)
+	}
+}
@@ -0,0 +1,319 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"maps"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/profiles"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/titles"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// checksumsFile is the name of the checksum manifest written at the root of the export directory.
+// It uses the sha256sum format, so the export can be verified with `sha256sum -c SHA256SUMS`.
+const checksumsFile = "SHA256SUMS"
+
+// exportedAsset is a single file written to the export directory.
+type exportedAsset struct {
+	// Path is the slash separated path of the file relative to the export directory.
+	Path    string
+	Content []byte
+}
+
+// titleProfile is a catalog profile of a title and the name it is exported under.
+type titleProfile struct {
+	name    string
+	content *string
+}
+
+// titleProfiles returns the catalog profiles of a title in a stable order, followed by any
+// additional profiles sorted by key.
+func titleProfiles(title client.Title) []titleProfile {
+	ordered := []titleProfile{
+		{"content_filter", title.ContentFilterProfile},
+		{"kernel_extension", title.KernelExtensionProfile},
+		{"managed_login_items", title.ManagedLoginItemsProfile},
+		{"notifications", title.NotificationsProfile},
+		{"pppcp", title.PPPCPProfile},
+		{"screen_recording", title.ScreenRecordingProfile},
+		{"system_extension", title.SystemExtensionProfile},
+	}
+	for _, key := range slices.Sorted(maps.Keys(title.AdditionalProfiles)) {
+		content := title.AdditionalProfiles[key]
+		ordered = append(ordered, titleProfile{strings.TrimSuffix(key, "_profile"), &content})
+	}
+	return ordered
+}
+
+// buildAssets renders the processed assets of each title: the catalog icon, the uninstall and Self
+// Service icons, each profile as a .mobileconfig file and the extension attribute script. Assets
+// are laid out in a directory per title and returned sorted by path, so the export is stable
+// regardless of the order titles were returned in. opts controls how the icons are rendered.
+func buildAssets(titles []client.Title, opts imaging.Options) ([]exportedAsset, error) {
+	var assets []exportedAsset
+	for _, title := range titles {
+		titleAssets, err := buildTitleAssets(title, opts)
+		if err != nil {
+			return nil, fmt.Errorf("title %q: %w", stringValue(title.TitleName), err)
+		}
+		assets = append(assets, titleAssets...)
+	}
+
+	slices.SortFunc(assets, func(a, b exportedAsset) int {
+		return cmp.Compare(a.Path, b.Path)
+	})
+
+	return assets, nil
+}
+
+// buildTitleAssets renders the processed assets of a single title.
+func buildTitleAssets(title client.Title, opts imaging.Options) ([]exportedAsset, error) {
+	dir, err := titleDirectory(stringValue(title.TitleName))
+	if err != nil {
+		return nil, err
+	}
+
+	var assets []exportedAsset
+
+	if title.IconHiRes != nil {
+		iconAssets, err := buildIconAssets(dir, *title.IconHiRes, opts)
+		if err != nil {
+			return nil, err
+		}
+		assets = append(assets, iconAssets...)
+	}
+
+	for _, profile := range titleProfiles(title) {
+		if profile.content == nil {
+			continue
+		}
+		if !isPathElement(profile.name) {
+			return nil, fmt.Errorf("profile name %q cannot be used as a file name", profile.name)
+		}
+		content, err := profiles.Bytes(*profile.content)
+		if err != nil {
+			return nil, fmt.Errorf("%s profile: %w", profile.name, err)
+		}
+		assets = append(assets, exportedAsset{
+			Path:    path.Join(dir, "profiles", profile.name+".mobileconfig"),
+			Content: content,
+		})
+	}
+
+	if title.ExtensionAttribute != nil {
+		ea, err := titles.ParseExtensionAttribute(*title.ExtensionAttribute)
+		if err != nil {
+			return nil, fmt.Errorf("extension attribute: %w", err)
+		}
+		assets = append(assets, exportedAsset{
+			Path:    path.Join(dir, "extension_attribute.sh"),
+			Content: []byte(ea.Script),
+		})
+	}
+
+	return assets, nil
+}
+
+// buildIconAssets decodes the catalog icon and renders the uninstall and Self Service icons from it.
+func buildIconAssets(dir, iconB64 string, opts imaging.Options) ([]exportedAsset, error) {
+	icon, err := base64.StdEncoding.DecodeString(iconB64)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding icon: %w", err)
+	}
+
+	format, err := imaging.DetectFormat(icon)
+	if err != nil {
+		return nil, err
+	}
+
+	canvas, err := imaging.NewCanvas(iconB64, opts)
+	if err != nil {
+		return nil, err
+	}
+	uninstall, uninstall2x, err := imaging.UninstallIcons(canvas)
+	if err != nil {
+		return nil, err
+	}
+	selfService, err := imaging.SelfServiceIcon(canvas)
+	if err != nil {
+		return nil, err
+	}
+
+	assets := []exportedAsset{{Path: path.Join(dir, "icon"+format.Extension), Content: icon}}
+	for name, rendered := range map[string]*string{
+		"uninstall_icon.png":    uninstall,
+		"uninstall_icon@2x.png": uninstall2x,
+		"self_service_icon.png": selfService,
+	} {
+		content, err := base64.StdEncoding.DecodeString(*rendered)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding rendered %s: %w", name, err)
+		}
+		assets = append(assets, exportedAsset{Path: path.Join(dir, name), Content: content})
+	}
+
+	return assets, nil
+}
+
+// titleDirectory returns the export directory name for a title, rejecting names that cannot be
+// used as a single path element.
+func titleDirectory(name string) (string, error) {
+	if !isPathElement(name) {
+		return "", fmt.Errorf("title name %q cannot be used as a directory name", name)
+	}
+	return name, nil
+}
+
+// isPathElement reports whether a catalog supplied name can be used as a single path element of
+// the export without escaping its parent directory.
+func isPathElement(name string) bool {
+	return name != "" && name != "." && name != ".." && !strings.ContainsAny(name, `/\`)
+}
+
+// exportTarget returns the location in dir of the file at the slash separated path p, rejecting
+// paths that would resolve outside dir.
+func exportTarget(dir, p string) (string, error) {
+	local := filepath.FromSlash(p)
+	if !filepath.IsLocal(local) {
+		return "", fmt.Errorf("exported file %q is outside the export directory", p)
+	}
+	return filepath.Join(dir, local), nil
+}
+
+// renderChecksums renders the checksum manifest for assets in the sha256sum format.
+func renderChecksums(assets []exportedAsset) []byte {
+	var buf bytes.Buffer
+	for _, asset := range assets {
+		fmt.Fprintf(&buf, "%s  %s\n", contentHash(asset.Content), asset.Path)
+	}
+	return buf.Bytes()
+}
+
+// contentHash returns the hex encoded SHA-256 of content.
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// writeExport writes assets and the checksum manifest to dir, creating directories as needed.
+// Files listed in stale that are no longer part of the export are removed.
+func writeExport(dir string, assets []exportedAsset, manifest []byte, stale []string) error {
+	written := make(map[string]struct{}, len(assets))
+	for _, asset := range assets {
+		target, err := exportTarget(dir, asset.Path)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("error creating export directory: %w", err)
+		}
+		if err := os.WriteFile(target, asset.Content, 0o644); err != nil {
+			return fmt.Errorf("error writing exported file: %w", err)
+		}
+		written[asset.Path] = struct{}{}
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, checksumsFile), manifest, 0o644); err != nil {
+		return fmt.Errorf("error writing checksum manifest: %w", err)
+	}
+
+	var removed []string
+	for _, p := range stale {
+		if _, ok := written[p]; !ok {
+			removed = append(removed, p)
+		}
+	}
+	return removeFiles(dir, removed)
+}
+
+// removeFiles removes the given files from dir, along with any directories they leave empty.
+// Files that no longer exist are ignored.
+func removeFiles(dir string, paths []string) error {
+	for _, p := range paths {
+		target, err := exportTarget(dir, p)
+		if err != nil {
+			return err
+		}
+		if err := os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return fmt.Errorf("error removing exported file: %w", err)
+		}
+
+		// Removing a directory that still has entries fails, which ends the walk up to dir.
+		for parent := filepath.Dir(target); parent != filepath.Clean(dir); parent = filepath.Dir(parent) {
+			if os.Remove(parent) != nil {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// verifyExport reports whether every file recorded in state and the checksum manifest still exist
+// in dir with their recorded content.
+func verifyExport(dir string, files []ExportedFileModel, manifestHash string) (bool, error) {
+	expected := make(map[string]string, len(files)+1)
+	for _, file := range files {
+		expected[file.Path.ValueString()] = file.SHA256.ValueString()
+	}
+	expected[checksumsFile] = manifestHash
+
+	for p, hash := range expected {
+		content, err := os.ReadFile(filepath.Join(dir, filepath.FromSlash(p)))
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		if err != nil {
+			return false, fmt.Errorf("error reading exported file: %w", err)
+		}
+		if contentHash(content) != hash {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// buildExportedFileModels converts assets into their state representation.
+func buildExportedFileModels(assets []exportedAsset) []ExportedFileModel {
+	models := make([]ExportedFileModel, 0, len(assets))
+	for _, asset := range assets {
+		models = append(models, ExportedFileModel{
+			Path:   types.StringValue(asset.Path),
+			SHA256: types.StringValue(contentHash(asset.Content)),
+		})
+	}
+	return models
+}
+
+// exportedPaths returns the paths of the files recorded in state.
+func exportedPaths(files []ExportedFileModel) []string {
+	paths := make([]string, 0, len(files))
+	for _, file := range files {
+		paths = append(paths, file.Path.ValueString())
+	}
+	return paths
+}
+
+// stringValue dereferences s, returning an empty string for nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
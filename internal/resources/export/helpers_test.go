@@ -0,0 +1,229 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+)
+
+const testProfile = `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0">
+<dict>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+</dict>
+</plist>`
+
+func createTestPNG(t *testing.T, width, height int) string {
+	t.Helper()
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := range height {
+		for x := range width {
+			img.Set(x, y, color.RGBA{R: 255, G: 0, B: 0, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatalf("failed to encode test PNG: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func testTitles(t *testing.T) []client.Title {
+	return []client.Title{
+		{
+			TitleName:            new("GoogleChrome"),
+			IconHiRes:            new(createTestPNG(t, 64, 64)),
+			NotificationsProfile: new(base64.StdEncoding.EncodeToString([]byte(testProfile))),
+			ExtensionAttribute:   new(base64.StdEncoding.EncodeToString([]byte("#!/bin/sh\necho 1\n"))),
+		},
+		{
+			TitleName:          new("Firefox"),
+			ExtensionAttribute: new("#!/bin/sh\necho 2\n"),
+		},
+	}
+}
+
+func assetPaths(assets []exportedAsset) []string {
+	paths := make([]string, 0, len(assets))
+	for _, asset := range assets {
+		paths = append(paths, asset.Path)
+	}
+	return paths
+}
+
+func TestBuildAssets(t *testing.T) {
+	assets, err := buildAssets(testTitles(t), imaging.Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"Firefox/extension_attribute.sh",
+		"GoogleChrome/extension_attribute.sh",
+		"GoogleChrome/icon.png",
+		"GoogleChrome/profiles/notifications.mobileconfig",
+		"GoogleChrome/self_service_icon.png",
+		"GoogleChrome/uninstall_icon.png",
+		"GoogleChrome/uninstall_icon@2x.png",
+	}
+	if got := assetPaths(assets); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected assets %v, got %v", want, got)
+	}
+
+	if string(assets[0].Content) != "#!/bin/sh\necho 2\n" {
+		t.Errorf("expected plain text script to be written as is, got %q", assets[0].Content)
+	}
+	if string(assets[1].Content) != "#!/bin/sh\necho 1\n" {
+		t.Errorf("expected base64 script to be decoded, got %q", assets[1].Content)
+	}
+	if string(assets[3].Content) != testProfile {
+		t.Errorf("expected decoded profile, got %q", assets[3].Content)
+	}
+	if _, err := png.Decode(bytes.NewReader(assets[6].Content)); err != nil {
+		t.Errorf("expected uninstall icon to be a PNG: %v", err)
+	}
+}
+
+func TestBuildAssets_AdditionalProfiles(t *testing.T) {
+	assets, err := buildAssets([]client.Title{{
+		TitleName: new("Zoom"),
+		AdditionalProfiles: map[string]string{
+			"relay_profile":     base64.StdEncoding.EncodeToString([]byte(testProfile)),
+			"dns_proxy_profile": base64.StdEncoding.EncodeToString([]byte(testProfile)),
+		},
+	}}, imaging.Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := "Zoom/profiles/dns_proxy.mobileconfig,Zoom/profiles/relay.mobileconfig"
+	if got := strings.Join(assetPaths(assets), ","); got != want {
+		t.Errorf("expected assets %s, got %s", want, got)
+	}
+}
+
+func TestBuildAssets_Errors(t *testing.T) {
+	tests := map[string]client.Title{
+		"unsafe name":     {TitleName: new("../escape")},
+		"missing name":    {},
+		"invalid icon":    {TitleName: new("Broken"), IconHiRes: new("not base64!")},
+		"invalid profile": {TitleName: new("Broken"), PPPCPProfile: new("not a profile!")},
+		"unsafe profile name": {TitleName: new("Zoom"), AdditionalProfiles: map[string]string{
+			"../../../x_profile": base64.StdEncoding.EncodeToString([]byte(testProfile)),
+		}},
+		"invalid extension attribute": {TitleName: new("Broken"), ExtensionAttribute: new("<extensionAttribute><displayName>")},
+	}
+	for name, title := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := buildAssets([]client.Title{title}, imaging.Options{}); err == nil {
+				t.Error("expected error")
+			}
+		})
+	}
+}
+
+func TestBuildAssets_ExtensionAttributeTemplate(t *testing.T) {
+	template := `<extensionAttribute><displayName>Zoom Version</displayName><dataType>string</dataType>` +
+		`<scriptContentsMac>#!/bin/sh
+echo 3
+</scriptContentsMac></extensionAttribute>`
+	assets, err := buildAssets([]client.Title{{
+		TitleName:          new("Zoom"),
+		ExtensionAttribute: new(base64.StdEncoding.EncodeToString([]byte(template))),
+	}}, imaging.Options{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(assets) != 1 || string(assets[0].Content) != "#!/bin/sh\necho 3\n" {
+		t.Errorf("expected the script body of the template, got %v", assets)
+	}
+}
+
+func TestRenderChecksums(t *testing.T) {
+	manifest := renderChecksums([]exportedAsset{{Path: "A/icon.png", Content: []byte("icon")}})
+	want := contentHash([]byte("icon")) + "  A/icon.png\n"
+	if string(manifest) != want {
+		t.Errorf("expected %q, got %q", want, manifest)
+	}
+}
+
+func TestWriteExport_RemovesStaleFiles(t *testing.T) {
+	dir := t.TempDir()
+	first := []exportedAsset{
+		{Path: "A/icon.png", Content: []byte("a")},
+		{Path: "B/profiles/pppcp.mobileconfig", Content: []byte("b")},
+	}
+	if err := writeExport(dir, first, renderChecksums(first), nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	second := first[:1]
+	if err := writeExport(dir, second, renderChecksums(second), assetPaths(first)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(dir, "A", "icon.png")); err != nil {
+		t.Errorf("expected kept file to exist: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "B")); !os.IsNotExist(err) {
+		t.Errorf("expected directory of stale file to be removed, got %v", err)
+	}
+}
+
+func TestWriteExport_RejectsEscapingPaths(t *testing.T) {
+	parent := t.TempDir()
+	dir := filepath.Join(parent, "export")
+	assets := []exportedAsset{{Path: "Zoom/profiles/../../../x.mobileconfig", Content: []byte("x")}}
+	if err := writeExport(dir, assets, renderChecksums(assets), nil); err == nil {
+		t.Fatal("expected error for a path outside the export directory")
+	}
+	if _, err := os.Stat(filepath.Join(parent, "x.mobileconfig")); !os.IsNotExist(err) {
+		t.Errorf("expected no file outside the export directory, got %v", err)
+	}
+}
+
+func TestVerifyExport(t *testing.T) {
+	dir := t.TempDir()
+	assets := []exportedAsset{{Path: "A/icon.png", Content: []byte("a")}}
+	manifest := renderChecksums(assets)
+	if err := writeExport(dir, assets, manifest, nil); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	files := buildExportedFileModels(assets)
+
+	intact, err := verifyExport(dir, files, contentHash(manifest))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !intact {
+		t.Error("expected freshly written export to be intact")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "A", "icon.png"), []byte("modified"), 0o644); err != nil {
+		t.Fatalf("failed to modify file: %v", err)
+	}
+	if intact, _ := verifyExport(dir, files, contentHash(manifest)); intact {
+		t.Error("expected modified export to be reported")
+	}
+
+	if err := os.Remove(filepath.Join(dir, checksumsFile)); err != nil {
+		t.Fatalf("failed to remove manifest: %v", err)
+	}
+	if intact, _ := verifyExport(dir, nil, contentHash(manifest)); intact {
+		t.Error("expected missing manifest to be reported")
+	}
+}
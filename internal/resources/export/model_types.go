@@ -0,0 +1,25 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AssetExportResourceModel describes the resource data model.
+type AssetExportResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Directory      types.String   `tfsdk:"directory"`
+	TitleNames     types.List     `tfsdk:"title_names"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	ManifestSHA256 types.String   `tfsdk:"manifest_sha256"`
+	Files          types.List     `tfsdk:"files"`
+}
+
+// ExportedFileModel describes a single file written to the export directory.
+type ExportedFileModel struct {
+	Path   types.String `tfsdk:"path"`
+	SHA256 types.String `tfsdk:"sha256"`
+}
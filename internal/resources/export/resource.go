@@ -0,0 +1,350 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultWriteTimeout is the default timeout for fetching titles and writing the export. Rendering
+// icons makes exports slower to write than the audit manifest.
+const defaultWriteTimeout = 5 * time.Minute
+
+var (
+	_ resource.Resource               = &AssetExportResource{}
+	_ resource.ResourceWithConfigure  = &AssetExportResource{}
+	_ resource.ResourceWithModifyPlan = &AssetExportResource{}
+)
+
+// exportedFileAttrTypes describes the object type of an entry in the files attribute.
+var exportedFileAttrTypes = map[string]attr.Type{
+	"path":   types.StringType,
+	"sha256": types.StringType,
+}
+
+// NewAssetExportResource returns a new instance of the asset export resource.
+func NewAssetExportResource() resource.Resource {
+	return &AssetExportResource{}
+}
+
+// AssetExportResource defines the resource implementation.
+type AssetExportResource struct {
+	client *client.Client
+}
+
+func (r *AssetExportResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_asset_export"
+}
+
+func (r *AssetExportResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exports the processed assets of the selected Jamf Auto Update titles into a directory tree for handoff to non-Terraform tooling. " +
+			"Each title gets a directory named after it holding the catalog icon, `uninstall_icon.png`, `uninstall_icon@2x.png`, `self_service_icon.png`, " +
+			"each of its profiles decoded to `profiles/<type>.mobileconfig` and its extension attribute script as `extension_attribute.sh`. " +
+			"A `" + checksumsFile + "` manifest in the `sha256sum` format is written at the root of the directory, so the export can be verified with `sha256sum -c " + checksumsFile + "`. " +
+			"The export is rewritten whenever the assets of the selected titles change, and files of titles no longer selected are removed.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The path of the export directory",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path of the directory to export assets into. It is created as needed. Changing this forces a new export to be written.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title_names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "List of title names to export assets for.",
+			},
+			"manifest_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 of the written `" + checksumsFile + "` manifest",
+			},
+			"files": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The files written to the export directory, excluding the checksum manifest",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"path": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The path of the file relative to the export directory, using forward slashes",
+						},
+						"sha256": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The SHA-256 of the file",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AssetExportResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan renders the selected titles' assets during plan and, when the checksum manifest would
+// differ from the one recorded in state, marks the computed attributes unknown so the export is rewritten.
+func (r *AssetExportResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state AssetExportResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TitleNames.IsUnknown() {
+		return
+	}
+
+	_, manifest, diags := r.renderExport(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if contentHash(manifest) == state.ManifestSHA256.ValueString() {
+		return
+	}
+
+	tflog.Debug(ctx, "Assets of exported titles changed, export will be rewritten")
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("manifest_sha256"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("files"), types.ListUnknown(types.ObjectType{AttrTypes: exportedFileAttrTypes}))...)
+}
+
+func (r *AssetExportResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data AssetExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWriteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.write(createCtx, &data, nil)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AssetExportResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data AssetExportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, diags := filesFromState(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	intact, err := verifyExport(data.Directory.ValueString(), files, data.ManifestSHA256.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read asset export", err.Error())
+		return
+	}
+
+	if !intact {
+		tflog.Debug(ctx, "Asset export is incomplete or was modified outside of Terraform, removing from state", map[string]any{
+			"directory": data.Directory.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AssetExportResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data, state AssetExportResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := filesFromState(ctx, state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultWriteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.write(updateCtx, &data, exportedPaths(previous))...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AssetExportResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data AssetExportResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	files, diags := filesFromState(ctx, data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := removeFiles(data.Directory.ValueString(), append(exportedPaths(files), checksumsFile)); err != nil {
+		resp.Diagnostics.AddError("Unable to delete asset export", err.Error())
+	}
+}
+
+// write renders the export for the model's titles, writes it to disk and populates the computed
+// attributes. Previously exported files in stale that are no longer part of the export are removed.
+func (r *AssetExportResource) write(ctx context.Context, data *AssetExportResourceModel, stale []string) diag.Diagnostics {
+	assets, manifest, diags := r.renderExport(ctx, *data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := writeExport(data.Directory.ValueString(), assets, manifest, stale); err != nil {
+		diags.AddError("Unable to write asset export", err.Error())
+		return diags
+	}
+
+	data.ID = data.Directory
+	data.ManifestSHA256 = types.StringValue(contentHash(manifest))
+	files, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: exportedFileAttrTypes}, buildExportedFileModels(assets))
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Files = files
+
+	tflog.Debug(ctx, fmt.Sprintf("Wrote asset export with %d files", len(assets)), map[string]any{
+		"directory": data.Directory.ValueString(),
+	})
+
+	return diags
+}
+
+// renderExport fetches the model's titles and renders their assets and the checksum manifest.
+func (r *AssetExportResource) renderExport(ctx context.Context, data AssetExportResourceModel) ([]exportedAsset, []byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var titleNames []string
+	diags.Append(data.TitleNames.ElementsAs(ctx, &titleNames, false)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	var titles []client.Title
+	if len(titleNames) > 0 {
+		var source client.Source
+		var err error
+		titles, source, err = r.client.GetTitlesWithSource(ctx, titleNames...)
+		if err != nil {
+			common.AddClientError(&diags, "Unable to read Jamf Auto Update titles", err)
+			return nil, nil, diags
+		}
+		common.AddSourceWarnings(&diags, source)
+	}
+
+	assets, err := buildAssets(titles, imaging.Options{Overlay: r.client.UninstallOverlay()})
+	if err != nil {
+		diags.AddError("Error processing title data", err.Error())
+		return nil, nil, diags
+	}
+
+	return assets, renderChecksums(assets), diags
+}
+
+// filesFromState returns the exported files recorded in the model.
+func filesFromState(ctx context.Context, data AssetExportResourceModel) ([]ExportedFileModel, diag.Diagnostics) {
+	var files []ExportedFileModel
+	if data.Files.IsNull() || data.Files.IsUnknown() {
+		return files, nil
+	}
+	diags := data.Files.ElementsAs(ctx, &files, false)
+	return files, diags
+}
@@ -0,0 +1,48 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package export
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+func TestAssetExportResource_Metadata(t *testing.T) {
+	r := &AssetExportResource{}
+	req := resource.MetadataRequest{
+		ProviderTypeName: "jamfautoupdate",
+	}
+	resp := &resource.MetadataResponse{}
+
+	r.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "jamfautoupdate_asset_export" {
+		t.Errorf("expected jamfautoupdate_asset_export, got %s", resp.TypeName)
+	}
+}
+
+func TestAssetExportResource_Schema(t *testing.T) {
+	r := &AssetExportResource{}
+	req := resource.SchemaRequest{}
+	resp := &resource.SchemaResponse{}
+
+	r.Schema(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+
+	expectedAttrs := []string{"timeouts", "id", "directory", "title_names", "manifest_sha256", "files"}
+	for _, name := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected attribute %q in schema", name)
+		}
+	}
+
+	if diags := resp.Schema.ValidateImplementation(context.Background()); diags.HasError() {
+		t.Errorf("schema failed validation: %v", diags)
+	}
+}
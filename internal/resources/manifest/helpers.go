@@ -0,0 +1,179 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Supported manifest output formats.
+const (
+	formatJSON = "json"
+	formatCSV  = "csv"
+)
+
+// manifestEntry is a single title as written to the manifest file.
+type manifestEntry struct {
+	TitleName    string `json:"title_name"`
+	TitleVersion string `json:"title_version"`
+	MinimumOS    string `json:"minimum_os"`
+	MaximumOS    string `json:"maximum_os"`
+	AppBundleID  string `json:"app_bundle_id"`
+	SHA256       string `json:"sha256"`
+}
+
+// manifestDocument is the top-level structure of a JSON manifest.
+type manifestDocument struct {
+	Titles []manifestEntry `json:"titles"`
+}
+
+// buildManifestEntries converts titles into manifest entries sorted by title name, so the
+// rendered manifest is stable regardless of the order titles were returned in.
+func buildManifestEntries(titles []client.Title) ([]manifestEntry, error) {
+	entries := make([]manifestEntry, 0, len(titles))
+	for _, title := range titles {
+		hash, err := titleHash(title)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, manifestEntry{
+			TitleName:    stringValue(title.TitleName),
+			TitleVersion: stringValue(title.TitleVersion),
+			MinimumOS:    stringValue(title.MinimumOS),
+			MaximumOS:    stringValue(title.MaximumOS),
+			AppBundleID:  stringValue(title.PatchDefinition.BundleID()),
+			SHA256:       hash,
+		})
+	}
+
+	slices.SortFunc(entries, func(a, b manifestEntry) int {
+		return cmp.Compare(a.TitleName, b.TitleName)
+	})
+
+	return entries, nil
+}
+
+// renderManifest renders manifest entries in the given format.
+func renderManifest(entries []manifestEntry, format string) ([]byte, error) {
+	switch format {
+	case formatJSON:
+		content, err := json.MarshalIndent(manifestDocument{Titles: entries}, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("error encoding JSON manifest: %w", err)
+		}
+		return append(content, '\n'), nil
+	case formatCSV:
+		var buf bytes.Buffer
+		writer := csv.NewWriter(&buf)
+		records := [][]string{{"title_name", "title_version", "minimum_os", "maximum_os", "app_bundle_id", "sha256"}}
+		for _, entry := range entries {
+			records = append(records, []string{
+				entry.TitleName, entry.TitleVersion, entry.MinimumOS, entry.MaximumOS, entry.AppBundleID, entry.SHA256,
+			})
+		}
+		if err := writer.WriteAll(records); err != nil {
+			return nil, fmt.Errorf("error encoding CSV manifest: %w", err)
+		}
+		return buf.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported manifest format %q", format)
+	}
+}
+
+// titleHash returns the hex encoded SHA-256 of the title's full definition.
+func titleHash(title client.Title) (string, error) {
+	encoded, err := json.Marshal(title)
+	if err != nil {
+		return "", fmt.Errorf("error encoding title for hashing: %w", err)
+	}
+	return contentHash(encoded), nil
+}
+
+// contentHash returns the hex encoded SHA-256 of content.
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// writeManifest writes the manifest content to path, creating parent directories as needed.
+func writeManifest(path string, content []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return fmt.Errorf("error creating manifest directory: %w", err)
+	}
+	if err := os.WriteFile(path, content, 0o644); err != nil {
+		return fmt.Errorf("error writing manifest file: %w", err)
+	}
+	return nil
+}
+
+// readManifestHash returns the SHA-256 of the manifest file at path. The boolean result is
+// false when the file does not exist.
+func readManifestHash(path string) (string, bool, error) {
+	content, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", false, nil
+	}
+	if err != nil {
+		return "", false, fmt.Errorf("error reading manifest file: %w", err)
+	}
+	return contentHash(content), true, nil
+}
+
+// buildManifestTitleModels converts manifest entries into their state representation.
+func buildManifestTitleModels(entries []manifestEntry) []ManifestTitleModel {
+	models := make([]ManifestTitleModel, 0, len(entries))
+	for _, entry := range entries {
+		models = append(models, ManifestTitleModel{
+			TitleName:    types.StringValue(entry.TitleName),
+			TitleVersion: types.StringValue(entry.TitleVersion),
+			MinimumOS:    types.StringValue(entry.MinimumOS),
+			MaximumOS:    types.StringValue(entry.MaximumOS),
+			AppBundleID:  types.StringValue(entry.AppBundleID),
+			SHA256:       types.StringValue(entry.SHA256),
+		})
+	}
+	return models
+}
+
+// titleVersions returns the names and versions of the titles in entries, in order.
+func titleVersions(entries []manifestEntry) []common.TitleVersion {
+	versions := make([]common.TitleVersion, 0, len(entries))
+	for _, entry := range entries {
+		versions = append(versions, common.TitleVersion{TitleName: entry.TitleName, TitleVersion: entry.TitleVersion})
+	}
+	return versions
+}
+
+// previousVersions returns the versions recorded in state for the titles of a manifest, keyed by title name.
+func previousVersions(titles []ManifestTitleModel) map[string]string {
+	versions := make(map[string]string, len(titles))
+	for _, title := range titles {
+		versions[title.TitleName.ValueString()] = title.TitleVersion.ValueString()
+	}
+	return versions
+}
+
+// stringValue dereferences s, returning an empty string for nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,167 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func testTitles() []client.Title {
+	return []client.Title{
+		{
+			TitleName:    new("GoogleChrome"),
+			TitleVersion: new("120.0"),
+			MinimumOS:    new("12.0"),
+			PatchDefinition: client.PatchDefinition{
+				Requirements: []client.Requirement{
+					{Name: new("Application Bundle ID"), Value: new("com.google.Chrome")},
+				},
+			},
+		},
+		{
+			TitleName:    new("Firefox"),
+			TitleVersion: new("121.0"),
+		},
+	}
+}
+
+func TestBuildManifestEntries_SortedByName(t *testing.T) {
+	entries, err := buildManifestEntries(testTitles())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("expected 2 entries, got %d", len(entries))
+	}
+	if entries[0].TitleName != "Firefox" || entries[1].TitleName != "GoogleChrome" {
+		t.Errorf("expected entries sorted by name, got %s, %s", entries[0].TitleName, entries[1].TitleName)
+	}
+	if entries[1].AppBundleID != "com.google.Chrome" {
+		t.Errorf("expected com.google.Chrome, got %s", entries[1].AppBundleID)
+	}
+	if entries[0].MaximumOS != "" {
+		t.Errorf("expected empty maximum_os for nil field, got %s", entries[0].MaximumOS)
+	}
+	if len(entries[0].SHA256) != 64 {
+		t.Errorf("expected 64 character hash, got %q", entries[0].SHA256)
+	}
+}
+
+func TestBuildManifestEntries_HashChangesWithVersion(t *testing.T) {
+	titles := testTitles()
+	before, err := buildManifestEntries(titles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	titles[0].TitleVersion = new("121.0")
+	after, err := buildManifestEntries(titles)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if before[1].SHA256 == after[1].SHA256 {
+		t.Error("expected hash to change when the title version changes")
+	}
+	if before[0].SHA256 != after[0].SHA256 {
+		t.Error("expected hash of unchanged title to be stable")
+	}
+}
+
+func TestRenderManifest_JSON(t *testing.T) {
+	entries, _ := buildManifestEntries(testTitles())
+	content, err := renderManifest(entries, formatJSON)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var doc manifestDocument
+	if err := json.Unmarshal(content, &doc); err != nil {
+		t.Fatalf("manifest is not valid JSON: %v", err)
+	}
+	if len(doc.Titles) != 2 {
+		t.Errorf("expected 2 titles, got %d", len(doc.Titles))
+	}
+}
+
+func TestRenderManifest_CSV(t *testing.T) {
+	entries, _ := buildManifestEntries(testTitles())
+	content, err := renderManifest(entries, formatCSV)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(content)), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("expected header and 2 rows, got %d lines", len(lines))
+	}
+	if lines[0] != "title_name,title_version,minimum_os,maximum_os,app_bundle_id,sha256" {
+		t.Errorf("unexpected header: %s", lines[0])
+	}
+	if !strings.HasPrefix(lines[2], "GoogleChrome,120.0,12.0,,com.google.Chrome,") {
+		t.Errorf("unexpected row: %s", lines[2])
+	}
+}
+
+func TestRenderManifest_UnsupportedFormat(t *testing.T) {
+	_, err := renderManifest(nil, "xml")
+	if err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+}
+
+func TestWriteManifest_CreatesDirectories(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "manifest.json")
+	if err := writeManifest(path, []byte("{}")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hash, exists, err := readManifestHash(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected manifest to exist")
+	}
+	if hash != contentHash([]byte("{}")) {
+		t.Errorf("expected hash of written content, got %s", hash)
+	}
+}
+
+func TestReadManifestHash_Missing(t *testing.T) {
+	_, exists, err := readManifestHash(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exists {
+		t.Error("expected missing manifest to be reported as not existing")
+	}
+}
+
+func TestReadManifestHash_Directory(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "manifest.json"), 0o755); err != nil {
+		t.Fatalf("failed to create directory: %v", err)
+	}
+	_, _, err := readManifestHash(filepath.Join(dir, "manifest.json"))
+	if err == nil {
+		t.Fatal("expected error when manifest path is a directory")
+	}
+}
+
+func TestPreviousVersions(t *testing.T) {
+	versions := previousVersions([]ManifestTitleModel{
+		{TitleName: types.StringValue("GoogleChrome"), TitleVersion: types.StringValue("119.0")},
+	})
+	if versions["GoogleChrome"] != "119.0" {
+		t.Errorf("expected GoogleChrome 119.0, got %v", versions)
+	}
+}
@@ -0,0 +1,30 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AuditManifestResourceModel describes the resource data model.
+type AuditManifestResourceModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Path           types.String   `tfsdk:"path"`
+	Format         types.String   `tfsdk:"format"`
+	TitleNames     types.List     `tfsdk:"title_names"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	ManifestSHA256 types.String   `tfsdk:"manifest_sha256"`
+	Titles         types.List     `tfsdk:"titles"`
+}
+
+// ManifestTitleModel describes a single title entry recorded in the manifest.
+type ManifestTitleModel struct {
+	TitleName    types.String `tfsdk:"title_name"`
+	TitleVersion types.String `tfsdk:"title_version"`
+	MinimumOS    types.String `tfsdk:"minimum_os"`
+	MaximumOS    types.String `tfsdk:"maximum_os"`
+	AppBundleID  types.String `tfsdk:"app_bundle_id"`
+	SHA256       types.String `tfsdk:"sha256"`
+}
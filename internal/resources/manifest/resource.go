@@ -0,0 +1,380 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package manifest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultWriteTimeout is the default timeout for fetching titles and writing the manifest.
+const defaultWriteTimeout = 90 * time.Second
+
+var (
+	_ resource.Resource                   = &AuditManifestResource{}
+	_ resource.ResourceWithConfigure      = &AuditManifestResource{}
+	_ resource.ResourceWithValidateConfig = &AuditManifestResource{}
+	_ resource.ResourceWithModifyPlan     = &AuditManifestResource{}
+)
+
+// manifestTitleAttrTypes describes the object type of an entry in the titles attribute.
+var manifestTitleAttrTypes = map[string]attr.Type{
+	"title_name":    types.StringType,
+	"title_version": types.StringType,
+	"minimum_os":    types.StringType,
+	"maximum_os":    types.StringType,
+	"app_bundle_id": types.StringType,
+	"sha256":        types.StringType,
+}
+
+// NewAuditManifestResource returns a new instance of the audit manifest resource.
+func NewAuditManifestResource() resource.Resource {
+	return &AuditManifestResource{}
+}
+
+// AuditManifestResource defines the resource implementation.
+type AuditManifestResource struct {
+	client *client.Client
+}
+
+func (r *AuditManifestResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_audit_manifest"
+}
+
+func (r *AuditManifestResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Writes a manifest of the selected Jamf Auto Update titles to disk, recording the name, version, OS range, bundle identifier and definition hash of each title. The manifest is rewritten whenever the catalog content of the selected titles changes, giving change management an artifact of exactly which definitions were deployed. Version changes since the last apply are summarized as warnings in the plan output.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The path of the manifest file",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path of the manifest file to write. Parent directories are created as needed. Changing this forces a new manifest to be written.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"format": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(formatJSON),
+				MarkdownDescription: "The manifest format, either `json` or `csv`. Defaults to `json`.",
+			},
+			"title_names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Required:            true,
+				MarkdownDescription: "List of title names to record in the manifest.",
+			},
+			"manifest_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 of the written manifest file",
+			},
+			"titles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The titles recorded in the manifest",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"title_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the title",
+						},
+						"title_version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the title",
+						},
+						"minimum_os": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Minimum OS version required",
+						},
+						"maximum_os": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Maximum OS version supported",
+						},
+						"app_bundle_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The application bundle identifier",
+						},
+						"sha256": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The SHA-256 of the title's full definition",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *AuditManifestResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+func (r *AuditManifestResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var format types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("format"), &format)...)
+	if resp.Diagnostics.HasError() || format.IsNull() || format.IsUnknown() {
+		return
+	}
+
+	switch format.ValueString() {
+	case formatJSON, formatCSV:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("format"),
+			"Invalid manifest format",
+			fmt.Sprintf("format must be %q or %q, got: %q", formatJSON, formatCSV, format.ValueString()),
+		)
+	}
+}
+
+// ModifyPlan fetches the selected titles during plan and, when the rendered manifest would differ
+// from the one recorded in state, marks the computed attributes unknown so the manifest is rewritten.
+// Version changes are summarized in a warning so reviewers can see them in the plan output.
+func (r *AuditManifestResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state AuditManifestResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.TitleNames.IsUnknown() || plan.Format.IsUnknown() {
+		return
+	}
+
+	entries, content, diags := r.renderManifest(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if contentHash(content) == state.ManifestSHA256.ValueString() {
+		return
+	}
+
+	var previous []ManifestTitleModel
+	if !state.Titles.IsNull() && !state.Titles.IsUnknown() {
+		resp.Diagnostics.Append(state.Titles.ElementsAs(ctx, &previous, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	common.AddVersionChangesWarning(&resp.Diagnostics, previousVersions(previous), titleVersions(entries))
+
+	tflog.Debug(ctx, "Catalog content of manifest titles changed, manifest will be rewritten")
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("manifest_sha256"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("titles"), types.ListUnknown(types.ObjectType{AttrTypes: manifestTitleAttrTypes}))...)
+}
+
+func (r *AuditManifestResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data AuditManifestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWriteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.write(createCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditManifestResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data AuditManifestResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, exists, err := readManifestHash(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read audit manifest", err.Error())
+		return
+	}
+
+	if !exists || hash != data.ManifestSHA256.ValueString() {
+		tflog.Debug(ctx, "Audit manifest is missing or was modified outside of Terraform, removing from state", map[string]any{
+			"path": data.Path.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditManifestResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data AuditManifestResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultWriteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.write(updateCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AuditManifestResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data AuditManifestResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := os.Remove(data.Path.ValueString()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		resp.Diagnostics.AddError("Unable to delete audit manifest", err.Error())
+	}
+}
+
+// write renders the manifest for the model's titles, writes it to disk and populates the computed attributes.
+func (r *AuditManifestResource) write(ctx context.Context, data *AuditManifestResourceModel) diag.Diagnostics {
+	entries, content, diags := r.renderManifest(ctx, *data)
+	if diags.HasError() {
+		return diags
+	}
+
+	if err := writeManifest(data.Path.ValueString(), content); err != nil {
+		diags.AddError("Unable to write audit manifest", err.Error())
+		return diags
+	}
+
+	data.ID = data.Path
+	data.ManifestSHA256 = types.StringValue(contentHash(content))
+	titles, listDiags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: manifestTitleAttrTypes}, buildManifestTitleModels(entries))
+	diags.Append(listDiags...)
+	if diags.HasError() {
+		return diags
+	}
+	data.Titles = titles
+
+	tflog.Debug(ctx, fmt.Sprintf("Wrote audit manifest with %d titles", len(entries)), map[string]any{
+		"path": data.Path.ValueString(),
+	})
+
+	return diags
+}
+
+// renderManifest fetches the model's titles and renders the manifest content.
+func (r *AuditManifestResource) renderManifest(ctx context.Context, data AuditManifestResourceModel) ([]manifestEntry, []byte, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var titleNames []string
+	diags.Append(data.TitleNames.ElementsAs(ctx, &titleNames, false)...)
+	if diags.HasError() {
+		return nil, nil, diags
+	}
+
+	var titles []client.Title
+	if len(titleNames) > 0 {
+		var source client.Source
+		var err error
+		titles, source, err = r.client.GetTitlesWithSource(ctx, titleNames...)
+		if err != nil {
+			common.AddClientError(&diags, "Unable to read Jamf Auto Update titles", err)
+			return nil, nil, diags
+		}
+		common.AddSourceWarnings(&diags, source)
+	}
+
+	entries, err := buildManifestEntries(titles)
+	if err != nil {
+		diags.AddError("Error processing title data", err.Error())
+		return nil, nil, diags
+	}
+
+	content, err := renderManifest(entries, data.Format.ValueString())
+	if err != nil {
+		diags.AddError("Error rendering audit manifest", err.Error())
+		return nil, nil, diags
+	}
+
+	return entries, content, diags
+}
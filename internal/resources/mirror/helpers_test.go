@@ -0,0 +1,65 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirror
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestWriteCatalog_CreatesDirectories(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "nested", "catalog.json")
+	if err := writeCatalog(path, []byte("[]")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	hash, exists, err := readCatalogHash(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !exists {
+		t.Fatal("expected mirror to exist")
+	}
+	if hash != contentHash([]byte("[]")) {
+		t.Errorf("expected hash of written content, got %s", hash)
+	}
+}
+
+func TestWriteCatalog_ReplacesAndLeavesNoTemporaryFiles(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "catalog.json")
+	if err := writeCatalog(path, []byte("[]")); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := writeCatalog(path, []byte(`[{"title_name": "GoogleChrome"}]`)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != `[{"title_name": "GoogleChrome"}]` {
+		t.Errorf("expected mirror to be replaced, got %s", content)
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(entries) != 1 {
+		t.Errorf("expected only the mirror file, got %d entries", len(entries))
+	}
+}
+
+func TestReadCatalogHash_Missing(t *testing.T) {
+	_, exists, err := readCatalogHash(filepath.Join(t.TempDir(), "missing.json"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if exists {
+		t.Error("expected missing mirror to be reported as not existing")
+	}
+}
@@ -0,0 +1,18 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirror
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DefinitionsMirrorResourceModel describes the resource data model.
+type DefinitionsMirrorResourceModel struct {
+	ID            types.String   `tfsdk:"id"`
+	Path          types.String   `tfsdk:"path"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	CatalogSHA256 types.String   `tfsdk:"catalog_sha256"`
+	Source        types.String   `tfsdk:"source"`
+}
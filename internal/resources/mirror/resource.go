@@ -0,0 +1,273 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mirror
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"time"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultWriteTimeout is the default timeout for downloading and writing the catalog.
+const defaultWriteTimeout = 5 * time.Minute
+
+// defaultReadTimeout is the default timeout for downloading the catalog during plan.
+const defaultReadTimeout = 90 * time.Second
+
+var (
+	_ resource.Resource               = &DefinitionsMirrorResource{}
+	_ resource.ResourceWithConfigure  = &DefinitionsMirrorResource{}
+	_ resource.ResourceWithModifyPlan = &DefinitionsMirrorResource{}
+)
+
+// NewDefinitionsMirrorResource returns a new instance of the definitions mirror resource.
+func NewDefinitionsMirrorResource() resource.Resource {
+	return &DefinitionsMirrorResource{}
+}
+
+// DefinitionsMirrorResource defines the resource implementation.
+type DefinitionsMirrorResource struct {
+	client *client.Client
+}
+
+func (r *DefinitionsMirrorResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_definitions_mirror"
+}
+
+func (r *DefinitionsMirrorResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Downloads the full Jamf Auto Update catalog to a managed local file, for runners that cannot reach the definitions server. " +
+			"Point another provider instance's `definitions_file` at `path` to read titles from the mirror. " +
+			"The catalog is downloaded during every plan, bounded by the `read` timeout, and the mirror is rewritten when its SHA-256 changes; responses that are not a catalog of titles never replace the mirror. " +
+			"The file is replaced atomically, so readers never see a partially written catalog.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+			}),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The path of the mirrored catalog",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"path": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Path of the mirrored catalog file. Parent directories are created as needed. Changing this forces a new mirror to be written.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"catalog_sha256": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The SHA-256 of the mirrored catalog",
+			},
+			"source": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL, with credentials redacted, or file the mirrored catalog was downloaded from",
+			},
+		},
+	}
+}
+
+func (r *DefinitionsMirrorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = c
+}
+
+// ModifyPlan downloads the catalog during plan and, when it differs from the one recorded in state,
+// marks the computed attributes unknown so the mirror is rewritten.
+func (r *DefinitionsMirrorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var state, plan DefinitionsMirrorResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := plan.Timeouts.Read(ctx, defaultReadTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	content, _, err := r.client.FetchCatalog(readCtx)
+	if err != nil {
+		common.AddClientError(&resp.Diagnostics, "Unable to download Jamf Auto Update catalog", err)
+		return
+	}
+
+	if contentHash(content) == state.CatalogSHA256.ValueString() {
+		return
+	}
+
+	tflog.Debug(ctx, "Upstream catalog changed, mirror will be rewritten")
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("catalog_sha256"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source"), types.StringUnknown())...)
+}
+
+func (r *DefinitionsMirrorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data DefinitionsMirrorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, defaultWriteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createCtx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.write(createCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefinitionsMirrorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data DefinitionsMirrorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hash, exists, err := readCatalogHash(data.Path.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read definitions mirror", err.Error())
+		return
+	}
+
+	if !exists || hash != data.CatalogSHA256.ValueString() {
+		tflog.Debug(ctx, "Definitions mirror is missing or was modified outside of Terraform, removing from state", map[string]any{
+			"path": data.Path.ValueString(),
+		})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefinitionsMirrorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data DefinitionsMirrorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, defaultWriteTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateCtx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	resp.Diagnostics.Append(r.write(updateCtx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *DefinitionsMirrorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data DefinitionsMirrorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := os.Remove(data.Path.ValueString()); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		resp.Diagnostics.AddError("Unable to delete definitions mirror", err.Error())
+	}
+}
+
+// write downloads the catalog, writes it to the model's path and populates the computed attributes.
+func (r *DefinitionsMirrorResource) write(ctx context.Context, data *DefinitionsMirrorResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	content, source, err := r.client.FetchCatalog(ctx)
+	if err != nil {
+		common.AddClientError(&diags, "Unable to download Jamf Auto Update catalog", err)
+		return diags
+	}
+
+	if err := writeCatalog(data.Path.ValueString(), content); err != nil {
+		diags.AddError("Unable to write definitions mirror", err.Error())
+		return diags
+	}
+
+	data.ID = data.Path
+	data.CatalogSHA256 = types.StringValue(contentHash(content))
+	data.Source = types.StringValue(source)
+
+	tflog.Debug(ctx, fmt.Sprintf("Wrote definitions mirror of %d bytes", len(content)), map[string]any{
+		"path":   data.Path.ValueString(),
+		"source": source,
+	})
+
+	return diags
+}
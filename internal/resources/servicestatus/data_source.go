@@ -0,0 +1,136 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package servicestatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultCheckTimeout is the default timeout for the health check.
+const defaultCheckTimeout = 10 * time.Second
+
+var _ datasource.DataSource = &ServiceStatusDataSource{}
+
+// NewServiceStatusDataSource returns a new instance of the service status data source.
+func NewServiceStatusDataSource() datasource.DataSource {
+	return &ServiceStatusDataSource{}
+}
+
+// ServiceStatusDataSource defines the data source implementation.
+type ServiceStatusDataSource struct {
+	client *client.Client
+}
+
+func (d *ServiceStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_status"
+}
+
+func (d *ServiceStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks the availability of the configured definitions source. An unavailable source is reported through the `healthy` attribute rather than as an error, so configurations can short-circuit or alert when the catalog is down.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx),
+			"healthy": schema.BoolAttribute{
+				Computed:            true,
+				MarkdownDescription: "Whether the definitions source is available",
+			},
+			"latency_ms": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Time taken for the definitions source to respond, in milliseconds",
+			},
+			"status_code": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "The HTTP status code returned by the definitions endpoint. Null for definitions files or when no response was received.",
+			},
+			"message": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Description of the failure when the definitions source is unhealthy",
+			},
+			"checked_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The time of the check in RFC 3339 format",
+			},
+		},
+	}
+}
+
+func (d *ServiceStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *ServiceStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data ServiceStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	checkTimeout := defaultCheckTimeout
+	if !data.Timeouts.IsNull() && !data.Timeouts.IsUnknown() {
+		configuredTimeout, timeoutDiags := data.Timeouts.Read(ctx, defaultCheckTimeout)
+		resp.Diagnostics.Append(timeoutDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		checkTimeout = configuredTimeout
+	}
+
+	checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+
+	status := d.client.CheckHealth(checkCtx)
+	buildServiceStatusModel(&data, status)
+
+	tflog.Debug(ctx, "Checked definitions source health", map[string]any{
+		"healthy":    status.Healthy,
+		"latency_ms": status.Latency.Milliseconds(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildServiceStatusModel populates the computed attributes of data from a health check result.
+func buildServiceStatusModel(data *ServiceStatusDataSourceModel, status client.HealthStatus) {
+	data.Healthy = types.BoolValue(status.Healthy)
+	data.LatencyMS = types.Int64Value(status.Latency.Milliseconds())
+	data.CheckedAt = types.StringValue(status.CheckedAt.UTC().Format(time.RFC3339))
+
+	data.StatusCode = types.Int64Null()
+	if status.StatusCode != 0 {
+		data.StatusCode = types.Int64Value(int64(status.StatusCode))
+	}
+
+	data.Message = types.StringNull()
+	if status.Message != "" {
+		data.Message = types.StringValue(status.Message)
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package servicestatus
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestServiceStatusDataSource_Metadata(t *testing.T) {
+	ds := &ServiceStatusDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jamfautoupdate",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "jamfautoupdate_service_status" {
+		t.Errorf("expected jamfautoupdate_service_status, got %s", resp.TypeName)
+	}
+}
+
+func TestServiceStatusDataSource_Schema(t *testing.T) {
+	ds := &ServiceStatusDataSource{}
+	req := datasource.SchemaRequest{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+
+	expectedAttrs := []string{"timeouts", "healthy", "latency_ms", "status_code", "message", "checked_at"}
+	for _, name := range expectedAttrs {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected attribute %q in schema", name)
+		}
+	}
+}
+
+func TestBuildServiceStatusModel_Healthy(t *testing.T) {
+	var data ServiceStatusDataSourceModel
+	buildServiceStatusModel(&data, client.HealthStatus{
+		Healthy:    true,
+		Latency:    42 * time.Millisecond,
+		StatusCode: 200,
+		CheckedAt:  time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	})
+
+	if !data.Healthy.ValueBool() {
+		t.Error("expected healthy to be true")
+	}
+	if data.LatencyMS.ValueInt64() != 42 {
+		t.Errorf("expected latency 42, got %d", data.LatencyMS.ValueInt64())
+	}
+	if data.StatusCode.ValueInt64() != 200 {
+		t.Errorf("expected status code 200, got %d", data.StatusCode.ValueInt64())
+	}
+	if data.CheckedAt.ValueString() != "2026-01-02T03:04:05Z" {
+		t.Errorf("unexpected checked_at: %s", data.CheckedAt.ValueString())
+	}
+	if !data.Message.IsNull() {
+		t.Error("expected null message for healthy status")
+	}
+}
+
+func TestBuildServiceStatusModel_Unhealthy(t *testing.T) {
+	var data ServiceStatusDataSourceModel
+	buildServiceStatusModel(&data, client.HealthStatus{
+		Message:   "definitions file is not accessible",
+		CheckedAt: time.Now(),
+	})
+
+	if data.Healthy.ValueBool() {
+		t.Error("expected healthy to be false")
+	}
+	if !data.StatusCode.IsNull() {
+		t.Error("expected null status code when no response was received")
+	}
+	if data.Message.ValueString() != "definitions file is not accessible" {
+		t.Errorf("unexpected message: %s", data.Message.ValueString())
+	}
+}
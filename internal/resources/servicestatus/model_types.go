@@ -0,0 +1,19 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package servicestatus
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ServiceStatusDataSourceModel describes the data source data model.
+type ServiceStatusDataSourceModel struct {
+	Timeouts   timeouts.Value `tfsdk:"timeouts"`
+	Healthy    types.Bool     `tfsdk:"healthy"`
+	LatencyMS  types.Int64    `tfsdk:"latency_ms"`
+	StatusCode types.Int64    `tfsdk:"status_code"`
+	Message    types.String   `tfsdk:"message"`
+	CheckedAt  types.String   `tfsdk:"checked_at"`
+}
@@ -0,0 +1,71 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+)
+
+// Badge defaults used when the corresponding attribute is not configured.
+const (
+	defaultBadgeColor     = "#FF9500"
+	defaultBadgeTextColor = "#FFFFFF"
+	defaultBadgePosition  = imaging.BadgePositionTopLeft
+)
+
+// maxBadgeTextLength is the maximum number of characters of badge text, which keeps the badge legible.
+const maxBadgeTextLength = 12
+
+// badgeOptionsFromModel converts the icon_badge configuration into a badge, applying defaults for
+// unset attributes. It returns nil when no badge is configured.
+func badgeOptionsFromModel(m *IconBadgeModel) (*imaging.Badge, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	text := m.Text.ValueString()
+	if strings.TrimSpace(text) == "" {
+		return nil, fmt.Errorf("badge text must not be empty")
+	}
+	if n := len([]rune(text)); n > maxBadgeTextLength {
+		return nil, fmt.Errorf("badge text must be at most %d characters, got %d", maxBadgeTextLength, n)
+	}
+
+	colorValue := defaultBadgeColor
+	if !m.Color.IsNull() {
+		colorValue = m.Color.ValueString()
+	}
+	badgeColor, err := imaging.ParseHexColor(colorValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid badge color: %w", err)
+	}
+
+	textColorValue := defaultBadgeTextColor
+	if !m.TextColor.IsNull() {
+		textColorValue = m.TextColor.ValueString()
+	}
+	textColor, err := imaging.ParseHexColor(textColorValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid badge text color: %w", err)
+	}
+
+	position := defaultBadgePosition
+	if !m.Position.IsNull() {
+		position = m.Position.ValueString()
+	}
+	if !slices.Contains(imaging.BadgePositions, position) {
+		return nil, fmt.Errorf("badge position must be one of %s, got: %q", strings.Join(imaging.BadgePositions, ", "), position)
+	}
+
+	return &imaging.Badge{
+		Text:      text,
+		Color:     badgeColor,
+		TextColor: textColor,
+		Position:  position,
+	}, nil
+}
@@ -0,0 +1,73 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBadgeOptionsFromModel_Nil(t *testing.T) {
+	opts, err := badgeOptionsFromModel(nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if opts != nil {
+		t.Errorf("expected nil options, got %+v", opts)
+	}
+}
+
+func TestBadgeOptionsFromModel_Defaults(t *testing.T) {
+	opts, err := badgeOptionsFromModel(&IconBadgeModel{
+		Text:      types.StringValue("PILOT"),
+		Color:     types.StringNull(),
+		TextColor: types.StringNull(),
+		Position:  types.StringNull(),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if opts.Text != "PILOT" {
+		t.Errorf("expected text PILOT, got %q", opts.Text)
+	}
+	if opts.Position != defaultBadgePosition {
+		t.Errorf("expected position %q, got %q", defaultBadgePosition, opts.Position)
+	}
+	if want, _ := imaging.ParseHexColor(defaultBadgeColor); opts.Color != want {
+		t.Errorf("expected color %v, got %v", want, opts.Color)
+	}
+	if want, _ := imaging.ParseHexColor(defaultBadgeTextColor); opts.TextColor != want {
+		t.Errorf("expected text color %v, got %v", want, opts.TextColor)
+	}
+}
+
+func TestBadgeOptionsFromModel_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		model IconBadgeModel
+		want  string
+	}{
+		{"empty text", IconBadgeModel{Text: types.StringValue("  ")}, "must not be empty"},
+		{"long text", IconBadgeModel{Text: types.StringValue(strings.Repeat("X", maxBadgeTextLength+1))}, "at most"},
+		{"bad color", IconBadgeModel{Text: types.StringValue("BETA"), Color: types.StringValue("orange")}, "invalid badge color"},
+		{"bad text color", IconBadgeModel{Text: types.StringValue("BETA"), TextColor: types.StringValue("#12")}, "invalid badge text color"},
+		{"bad position", IconBadgeModel{Text: types.StringValue("BETA"), Position: types.StringValue("center")}, "badge position must be one of"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := badgeOptionsFromModel(&tt.model)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("expected error containing %q, got %q", tt.want, err.Error())
+			}
+		})
+	}
+}
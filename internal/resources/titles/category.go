@@ -0,0 +1,64 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+)
+
+// defaultCategory is the suggested category of titles that match no category rule.
+const defaultCategory = "Applications"
+
+// categoryRule suggests a category for titles whose name or description contains any of its keywords.
+type categoryRule struct {
+	category string
+	keywords []string
+}
+
+// categoryRules are checked in order, so more specific categories come before broader ones. Keywords
+// are lower case and matched as whole words or phrases.
+var categoryRules = []categoryRule{
+	{"Browsers", []string{"browser", "web browser"}},
+	{"Security", []string{"antivirus", "anti virus", "malware", "vpn", "firewall", "password manager", "encryption", "endpoint security", "security"}},
+	{"Developer Tools", []string{"ide", "code editor", "developer", "developers", "programming", "git", "terminal", "sdk", "database"}},
+	{"Communication", []string{"chat", "messaging", "messenger", "video conferencing", "meetings", "email", "mail client", "calls"}},
+	{"Media", []string{"video player", "media player", "audio", "music", "photo", "photos", "image editor", "video editor", "streaming"}},
+	{"Productivity", []string{"office", "spreadsheet", "word processor", "presentation", "presentations", "notes", "pdf", "productivity", "calendar", "collaboration"}},
+	{"Utilities", []string{"utility", "utilities", "archive", "archiver", "compression", "backup", "remote desktop", "file transfer", "ftp"}},
+}
+
+// suggestedCategory derives a Jamf Pro category for a title from its catalog metadata: the first
+// category rule matching its name, display name or description, then Security for titles that ship a
+// content filter profile, then defaultCategory.
+func suggestedCategory(title client.Title) string {
+	text := " " + strings.Join(categoryWords(stringValue(title.TitleName), stringValue(title.TitleDisplayName), stringValue(title.TitleDescription)), " ") + " "
+
+	for _, rule := range categoryRules {
+		for _, keyword := range rule.keywords {
+			if strings.Contains(text, " "+keyword+" ") {
+				return rule.category
+			}
+		}
+	}
+
+	if title.ContentFilterProfile != nil {
+		return "Security"
+	}
+	return defaultCategory
+}
+
+// categoryWords splits texts into lower case words, treating anything other than letters and digits
+// as a separator.
+func categoryWords(texts ...string) []string {
+	var words []string
+	for _, text := range texts {
+		words = append(words, strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})...)
+	}
+	return words
+}
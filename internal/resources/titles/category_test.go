@@ -0,0 +1,51 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+)
+
+func TestSuggestedCategory(t *testing.T) {
+	tests := map[string]struct {
+		title client.Title
+		want  string
+	}{
+		"browser in description": {
+			client.Title{TitleName: new("GoogleChrome"), TitleDescription: new("A fast, secure web browser.")},
+			"Browsers",
+		},
+		"phrase across punctuation": {
+			client.Title{TitleName: new("Zoom"), TitleDescription: new("Video-conferencing, chat and meetings.")},
+			"Communication",
+		},
+		"display name": {
+			client.Title{TitleName: new("VSCode"), TitleDisplayName: new("Visual Studio Code Editor")},
+			"Developer Tools",
+		},
+		"rule order": {
+			client.Title{TitleName: new("Brave"), TitleDescription: new("Privacy browser with a built in VPN.")},
+			"Browsers",
+		},
+		"whole words only": {
+			client.Title{TitleName: new("Slide"), TitleDescription: new("Makes ideas into officially approved slides.")},
+			defaultCategory,
+		},
+		"content filter profile": {
+			client.Title{TitleName: new("Umbrella"), ContentFilterProfile: new("cHJvZmlsZQ==")},
+			"Security",
+		},
+		"no metadata": {client.Title{}, defaultCategory},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := suggestedCategory(tt.title); got != tt.want {
+				t.Errorf("suggestedCategory() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -7,13 +7,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
+	"slices"
 	"time"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/common"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -21,7 +27,10 @@ import (
 // defaultReadTimeout is the default timeout duration for reading titles from the API.
 const defaultReadTimeout = 90 * time.Second
 
-var _ datasource.DataSource = &TitlesDataSource{}
+var (
+	_ datasource.DataSource                   = &TitlesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &TitlesDataSource{}
+)
 
 // NewTitlesDataSource returns a new instance of the titles data source.
 func NewTitlesDataSource() datasource.DataSource {
@@ -37,6 +46,9 @@ func (d *TitlesDataSource) Metadata(ctx context.Context, req datasource.Metadata
 	resp.TypeName = req.ProviderTypeName + "_titles"
 }
 
+// Schema defines the schema for the titles data source. Data source schemas cannot be versioned and
+// have no state upgraders: Terraform discards data source state and reads it again on every plan, so
+// attributes can be retyped or renamed without migrating existing state.
 func (d *TitlesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: "Fetches information about Jamf Auto Update titles. Available titles are shown in the [Jamf Auto Update Catalog Browser](https://support.datajar.co.uk/hc/en-us/articles/4409234438161-Jamf-Auto-Update-Catalog-Browser-User-Guide)",
@@ -45,88 +57,434 @@ func (d *TitlesDataSource) Schema(ctx context.Context, req datasource.SchemaRequ
 			"title_names": schema.ListAttribute{
 				ElementType:         types.StringType,
 				Optional:            true,
-				MarkdownDescription: "List of specific title names to retrieve.",
+				MarkdownDescription: "List of specific title names to retrieve. Mutually exclusive with `bundle_ids`.",
 			},
-			"titles": schema.ListNestedAttribute{
+			"bundle_ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "List of application bundle identifiers, such as `com.google.Chrome`, to retrieve the titles of, matched case-insensitively against each title's `app_bundle_id`. Every title sharing a requested bundle ID is returned, and the read fails if no title has one of them. The full catalog is streamed to resolve bundle IDs, so prefer `title_names` when the names are known. Mutually exclusive with `title_names`.",
+			},
+			"requires_privacy_approval": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When `true`, only titles shipping a PPPC, screen recording or notifications profile are returned, so the titles that need MDM pre-approval of privacy permissions can be deployed together. When `false`, only titles shipping none of those profiles are returned. As with `requires`, a profile published as an empty string is not shipped. Applies to the titles selected by `title_names` or `bundle_ids`, or to the full catalog when neither is set.",
+			},
+			"os_version": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A macOS version, such as `13.6`. When set, only titles whose `minimum_os` and `maximum_os` range includes the version are returned, so catalogs can be built for fleets pinned to a macOS release. Versions are compared numerically, a missing bound is unrestricted and a maximum covers its point releases, so a `maximum_os` of `14` includes `14.2`. Applies to the titles selected by `title_names` or `bundle_ids`, or to the full catalog when neither is set.",
+			},
+			"requires": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Profile attributes titles must ship, such as `pppcp_profile` or `system_extension_profile`. When set, only titles providing every listed profile are returned, so the Jamf Pro profiles a set of titles needs can be generated only for those titles. Each value must be one of `content_filter_profile`, `kernel_extension_profile`, `managed_login_items_profile`, `notifications_profile`, `pppcp_profile`, `screen_recording_profile` or `system_extension_profile`. Applies to the titles selected by `title_names` or `bundle_ids`, or to the full catalog when neither is set.",
+			},
+			"definitions_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Base URL of a Definitions API to read these titles from instead of the provider's definitions source, so a single provider block can mix sources. Other provider settings, such as `locale` and `duplicate_titles`, still apply; provider mirrors and Unix socket URLs do not. Mutually exclusive with `definitions_file`.",
+			},
+			"definitions_file": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Path to a local definitions file to read these titles from instead of the provider's definitions source, so critical titles can be pinned to a curated file while others use the live API. Accepts the same formats as the provider's `definitions_file`. Mutually exclusive with `definitions_url`.",
+			},
+			"refresh_trigger": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Arbitrary value that, when changed, forces the titles to be refetched from the definitions source, bypassing responses shared within the plan and those in the provider's `cache_dir`. Reads with an unchanged value keep reusing cached responses. Useful for scheduled pipelines that need to deliberately pick up new catalog content.",
+			},
+			"previous_versions": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Versions the titles were last deployed at, keyed by title name, such as the `title_version` values recorded by the resources that consume these titles. Titles whose catalog version differs are summarized in a warning such as `GoogleChrome: 119.0 → 120.0`, so reviewers can see meaningful version changes in the plan output. Titles missing from the map are not reported.",
+			},
+			"icon_badge": schema.SingleNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Draws a short text badge, such as `BETA` or `PILOT`, onto each title's icon and exposes the result as `badged_icon_base64`, so ring-based rollouts get visually distinct artwork.",
+				Attributes: map[string]schema.Attribute{
+					"text": schema.StringAttribute{
+						Required:            true,
+						MarkdownDescription: fmt.Sprintf("The badge text, at most %d characters.", maxBadgeTextLength),
+					},
+					"color": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: fmt.Sprintf("The badge background color in `#RRGGBB` or `#RRGGBBAA` format. Defaults to `%s`.", defaultBadgeColor),
+					},
+					"text_color": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: fmt.Sprintf("The badge text color in `#RRGGBB` or `#RRGGBBAA` format. Defaults to `%s`.", defaultBadgeTextColor),
+					},
+					"position": schema.StringAttribute{
+						Optional:            true,
+						MarkdownDescription: fmt.Sprintf("The corner of the icon the badge is drawn in: `top_left`, `top_right`, `bottom_left` or `bottom_right`. Defaults to `%s`.", defaultBadgePosition),
+					},
+				},
+			},
+			"icon_mask": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The shape processed icons are clipped to before overlays and badges are drawn: `none` keeps the source artwork as is, `squircle` applies the rounded-corner macOS app icon mask so square artwork matches Apple's icon style in Self Service. Applies to `uninstall_icon_base64`, `uninstall_icon_2x_base64`, `badged_icon_base64` and `self_service_icon_base64`. Defaults to `%s`.", defaultIconMask),
+			},
+			"icon_padding": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Shrinks the artwork onto a transparent margin before masks, overlays and badges are applied, as a percentage of the icon size on each side, so edge-to-edge source icons no longer collide with the uninstall overlay. Must be between 0 and %d. Defaults to `0`.", imaging.MaxPadding),
+			},
+			"icon_background_color": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A solid color in `#RRGGBB` format that transparent areas of processed icons, including masked corners and padding, are flattened onto. Needed when the destination cannot render alpha, such as some dashboards or JPEG output. Applies to `uninstall_icon_base64`, `uninstall_icon_2x_base64`, `badged_icon_base64` and `self_service_icon_base64`. Icons keep their transparency when unset.",
+			},
+			"icon_processing": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("How icon processing failures are handled: `strict` fails the read when any title's icon cannot be processed, `best_effort` reports a warning and leaves that title's `uninstall_icon_base64`, `uninstall_icon_2x_base64`, `badged_icon_base64`, `self_service_icon_base64` and `icon_payload` null so one corrupt catalog icon does not block every title. Defaults to `%s`.", defaultIconProcessing),
+			},
+			"profile_format": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The format of each title's profile attributes, including `additional_profiles`: `base64` exposes profiles as published in the catalog, with a warning for any profile that is not a well-formed property list, `xml` decodes them to property list XML text so changes are readable in plan output and the profiles can be post-processed in HCL. XML profiles are normalized, with dictionary keys sorted and consistent indentation, so functionally identical payloads from catalog re-exports do not cause diffs. Signed profiles are exposed as the property list embedded in their signature. Defaults to `%s`.", defaultProfileFormat),
+			},
+			"skip_errored_titles": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "When `true`, a title that cannot be processed, such as a catalog entry with a field of an unexpected type, an icon that cannot be decoded or, with `profile_format` set to `xml`, a profile that cannot be decoded, is skipped with a warning and listed in `errored_titles` instead of failing the whole read. When `icon_processing` is `best_effort`, titles with bad icons are kept with null derived icons rather than skipped. Defaults to `false`.",
+			},
+			"source_type": schema.StringAttribute{
 				Computed:            true,
-				MarkdownDescription: "List of titles and their details",
+				MarkdownDescription: "Where the titles were read from: `url` for the Definitions API, `file` for `definitions_file` or `inline` for the provider's `definitions_json`. Null when no titles were requested.",
+			},
+			"source_location": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The URL, with credentials redacted, or the path of the file the titles were read from. Null when no titles were requested.",
+			},
+			"fetched_at": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "When the titles were read, as an RFC 3339 timestamp. Null when no titles were requested.",
+			},
+			"title_names_found": schema.SetAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The names of the titles returned in `titles`, for use with `for_each`. Combined with `bundle_ids`, `requires_privacy_approval`, `os_version`, `requires` or `skip_errored_titles`, it lists only the titles that were actually found.",
+			},
+			"errored_titles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "Titles skipped because they could not be processed. Always empty unless `skip_errored_titles` is `true`.",
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"title_name": schema.StringAttribute{
 							Computed:            true,
-							MarkdownDescription: "The name of the title",
-						},
-						"title_display_name": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The display name of the title",
-						},
-						"title_description": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The description of the title",
-						},
-						"title_version": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The version of the title",
-						},
-						"minimum_os": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Minimum OS version required",
+							MarkdownDescription: "The name of the title, or null if the name itself could not be decoded",
 						},
-						"maximum_os": schema.StringAttribute{
+						"error": schema.StringAttribute{
 							Computed:            true,
-							MarkdownDescription: "Maximum OS version supported",
-						},
-						"icon_base64": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The icon in base64 format",
-						},
-						"uninstall_icon_base64": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The uninstall icon in base64 format",
-						},
-						"extension_attribute": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Extension attribute data",
-						},
-						"content_filter_profile": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Content filter profile data",
-						},
-						"kernel_extension_profile": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Kernel extension profile data",
-						},
-						"managed_login_items_profile": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Managed login items profile data",
-						},
-						"notifications_profile": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Notifications profile data",
-						},
-						"pppcp_profile": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "PPPCP profile data",
-						},
-						"screen_recording_profile": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "Screen recording profile data",
-						},
-						"system_extension_profile": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "System extension profile data",
-						},
-						"app_bundle_id": schema.StringAttribute{
-							Computed:            true,
-							MarkdownDescription: "The application bundle identifier",
+							MarkdownDescription: "The reason the title was skipped",
 						},
 					},
 				},
 			},
+			"titles": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "List of titles and their details",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: titleAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// titleAttributes returns the computed attributes describing a single title, shared by the titles
+// and title data sources.
+func titleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"title_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the title",
+		},
+		"title_display_name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The display name of the title",
+		},
+		"title_description": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description of the title",
+		},
+		"title_description_plain": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The description of the title with HTML and Markdown markup removed, for fields that display raw text. Block elements become line breaks, list items become `- ` bullets and links are replaced by their text.",
+		},
+		"title_version": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The version of the title",
+		},
+		"minimum_os": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Minimum OS version required",
+		},
+		"maximum_os": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Maximum OS version supported",
+		},
+		"icon_base64": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The icon in base64 format",
+		},
+		"uninstall_icon_base64": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The uninstall icon in base64 format",
+		},
+		"uninstall_icon_2x_base64": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The uninstall icon rendered at double resolution for retina displays, in base64 format",
+		},
+		"badged_icon_base64": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The icon with the configured `icon_badge` drawn onto it, in base64 format. Null when no badge is configured.",
+		},
+		"self_service_icon_base64": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("The icon rendered at the %d x %d pixel size Jamf Pro recommends for Self Service uploads, with `icon_mask` and `icon_padding` applied, in base64 format", imaging.SelfServiceIconSize, imaging.SelfServiceIconSize),
+		},
+		"extension_attribute": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Extension attribute data",
+		},
+		"uninstall_script": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The script that removes the title from a device, as published in the catalog, so removal policies can be generated alongside install and update policies. Null when the catalog provides no uninstall logic for the title.",
+		},
+		"content_filter_profile": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Content filter profile data",
+		},
+		"kernel_extension_profile": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Kernel extension profile data",
+		},
+		"managed_login_items_profile": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Managed login items profile data",
+		},
+		"notifications_profile": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Notifications profile data",
+		},
+		"pppcp_profile": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "PPPCP profile data",
+		},
+		"screen_recording_profile": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Screen recording profile data",
+		},
+		"system_extension_profile": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "System extension profile data",
+		},
+		"additional_profiles": schema.MapAttribute{
+			Computed:    true,
+			ElementType: types.StringType,
+			MarkdownDescription: "Profiles of types this provider version has no dedicated attribute for, keyed by their catalog key " +
+				"(for example `dns_proxy_profile`). A warning names each such key when it is read.",
+		},
+		"app_bundle_id": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The application bundle identifier",
+		},
+		"eula_url": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The URL of the title's end user license agreement. Null when the catalog does not report one.",
+		},
+		"license_type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The license model of the title as reported by the catalog, such as `freeware`, `open_source` or `commercial`, for software asset management inventories. Null when the catalog does not report one.",
+		},
+		"estimated_download_size_mb": schema.Float64Attribute{
+			Computed:            true,
+			MarkdownDescription: "The approximate size of the title's installer download in megabytes, so bandwidth-sensitive deployment rings can be planned. Null when the catalog does not report one.",
+		},
+		"suggested_category": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: fmt.Sprintf("A Jamf Pro category suggested for the title, derived from its name and description, such as `Browsers`, `Security` or `Productivity`, so policies and packages can be categorized consistently. Titles that match no category are suggested `%s`.", defaultCategory),
+		},
+		"icon_payload": schema.SingleNestedAttribute{
+			Computed:            true,
+			MarkdownDescription: "The icon shaped for upload to Jamf Pro icon and Self Service attributes",
+			Attributes: map[string]schema.Attribute{
+				"filename": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The icon filename, derived from the title name and image type",
+				},
+				"base64": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The icon in base64 format",
+				},
+				"content_type": schema.StringAttribute{
+					Computed:            true,
+					MarkdownDescription: "The MIME type of the icon",
+				},
+			},
 		},
 	}
 }
 
+func (d *TitlesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var titleNames, bundleIDs types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("title_names"), &titleNames)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bundle_ids"), &bundleIDs)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !titleNames.IsNull() && !bundleIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bundle_ids"),
+			"Conflicting title selectors",
+			"Only one of title_names or bundle_ids may be set.",
+		)
+	}
+
+	var osVersion types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("os_version"), &osVersion)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !osVersion.IsUnknown() {
+		if _, err := osVersionFromModel(osVersion); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("os_version"),
+				"Invalid OS version",
+				err.Error(),
+			)
+		}
+	}
+
+	var requires types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("requires"), &requires)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, element := range requires.Elements() {
+		key, ok := element.(types.String)
+		if !ok || key.IsNull() || key.IsUnknown() {
+			continue
+		}
+		if err := validateRequiredProfile(key.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("requires").AtListIndex(i),
+				"Invalid required profile",
+				err.Error(),
+			)
+		}
+	}
+
+	var definitionsURL, definitionsFile types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("definitions_url"), &definitionsURL)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("definitions_file"), &definitionsFile)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !definitionsURL.IsNull() && !definitionsFile.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("definitions_file"),
+			"Conflicting definitions sources",
+			"Only one of definitions_url or definitions_file may be set.",
+		)
+	}
+
+	var mask types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("icon_mask"), &mask)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !mask.IsUnknown() {
+		if _, err := iconMaskFromModel(mask); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("icon_mask"),
+				"Invalid icon mask",
+				err.Error(),
+			)
+		}
+	}
+
+	var padding types.Int64
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("icon_padding"), &padding)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !padding.IsUnknown() {
+		if _, err := iconPaddingFromModel(padding); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("icon_padding"),
+				"Invalid icon padding",
+				err.Error(),
+			)
+		}
+	}
+
+	var background types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("icon_background_color"), &background)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !background.IsUnknown() {
+		if _, err := iconBackgroundFromModel(background); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("icon_background_color"),
+				"Invalid icon background color",
+				err.Error(),
+			)
+		}
+	}
+
+	var processing types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("icon_processing"), &processing)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !processing.IsUnknown() {
+		if _, err := iconProcessingFromModel(processing); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("icon_processing"),
+				"Invalid icon processing mode",
+				err.Error(),
+			)
+		}
+	}
+
+	var profileFormat types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("profile_format"), &profileFormat)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !profileFormat.IsUnknown() {
+		if _, err := profileFormatFromModel(profileFormat); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("profile_format"),
+				"Invalid profile format",
+				err.Error(),
+			)
+		}
+	}
+
+	var badge *IconBadgeModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("icon_badge"), &badge)...)
+	if resp.Diagnostics.HasError() || badge == nil {
+		return
+	}
+
+	if badge.Text.IsUnknown() || badge.Color.IsUnknown() || badge.TextColor.IsUnknown() || badge.Position.IsUnknown() {
+		return
+	}
+
+	if _, err := badgeOptionsFromModel(badge); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("icon_badge"),
+			"Invalid icon badge",
+			err.Error(),
+		)
+	}
+}
+
 func (d *TitlesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -147,6 +505,24 @@ func (d *TitlesDataSource) Configure(ctx context.Context, req datasource.Configu
 }
 
 func (d *TitlesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	// Titles selected by values computed from other resources cannot be read until apply.
+	if !req.Config.Raw.IsFullyKnown() {
+		if req.ClientCapabilities.DeferralAllowed {
+			tflog.Debug(ctx, "Deferring titles read until the configuration is known")
+			resp.Deferred = &datasource.Deferred{Reason: datasource.DeferredReasonDataSourceConfigUnknown}
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Titles configuration is not known",
+			"The titles cannot be read because the configuration contains values that are not known until apply, "+
+				"and this version of Terraform does not support deferring the read. "+
+				"Use values known at plan time, or run the apply in stages with -target.",
+		)
+		return
+	}
+
 	var data TitlesDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -162,14 +538,44 @@ func (d *TitlesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		}
 	}
 
-	if !data.TitleNames.IsUnknown() && len(titleNames) == 0 {
+	var bundleIDs []string
+	if !data.BundleIDs.IsNull() {
+		resp.Diagnostics.Append(data.BundleIDs.ElementsAs(ctx, &bundleIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !data.TitleNames.IsNull() && !data.BundleIDs.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("bundle_ids"), "Conflicting title selectors", "Only one of title_names or bundle_ids may be set.")
+		return
+	}
+
+	var requiredProfiles []string
+	if !data.Requires.IsNull() {
+		resp.Diagnostics.Append(data.Requires.ElementsAs(ctx, &requiredProfiles, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	for i, key := range requiredProfiles {
+		if err := validateRequiredProfile(key); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("requires").AtListIndex(i), "Invalid required profile", err.Error())
+			return
+		}
+	}
+
+	if len(titleNames) == 0 && len(bundleIDs) == 0 && data.PrivacyFilter.IsNull() && data.OSVersion.IsNull() && data.Requires.IsNull() {
 		data.Titles = []TitleModel{}
+		data.TitleNamesFound = titleNamesFound(data.Titles)
+		data.ErroredTitles = []ErroredTitleModel{}
 		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 		return
 	}
 
 	readTimeout := defaultReadTimeout
-	if !data.Timeouts.IsNull() && !data.Timeouts.IsUnknown() {
+	if !data.Timeouts.IsNull() {
 		configuredTimeout, timeoutDiags := data.Timeouts.Read(ctx, defaultReadTimeout)
 		resp.Diagnostics.Append(timeoutDiags...)
 		if resp.Diagnostics.HasError() {
@@ -178,37 +584,198 @@ func (d *TitlesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		readTimeout = configuredTimeout
 	}
 
+	if !data.RefreshTrigger.IsNull() {
+		tflog.Debug(ctx, "Refreshing titles for refresh trigger", map[string]any{
+			"refresh_trigger": data.RefreshTrigger.ValueString(),
+		})
+		// Responses cached under another trigger value are not reused, so a changed trigger refetches.
+		ctx = client.WithRefreshKey(ctx, data.RefreshTrigger.ValueString())
+	}
+
+	badge, err := badgeOptionsFromModel(data.IconBadge)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("icon_badge"), "Invalid icon badge", err.Error())
+		return
+	}
+
+	mask, err := iconMaskFromModel(data.IconMask)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("icon_mask"), "Invalid icon mask", err.Error())
+		return
+	}
+
+	padding, err := iconPaddingFromModel(data.IconPadding)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("icon_padding"), "Invalid icon padding", err.Error())
+		return
+	}
+
+	background, err := iconBackgroundFromModel(data.IconBackground)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("icon_background_color"), "Invalid icon background color", err.Error())
+		return
+	}
+
+	processing, err := iconProcessingFromModel(data.IconProcessing)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("icon_processing"), "Invalid icon processing mode", err.Error())
+		return
+	}
+
+	profileFormat, err := profileFormatFromModel(data.ProfileFormat)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile_format"), "Invalid profile format", err.Error())
+		return
+	}
+
+	osVersion, err := osVersionFromModel(data.OSVersion)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("os_version"), "Invalid OS version", err.Error())
+		return
+	}
+
+	titlesClient, err := d.titlesClient(data)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("definitions_url"), "Invalid definitions source", err.Error())
+		return
+	}
+
 	readCtx, cancel := context.WithTimeout(ctx, readTimeout)
 	defer cancel()
 
-	titles, err := d.client.GetTitles(readCtx, titleNames...)
+	skipErrored := data.SkipErrored.ValueBool()
+
+	var titles []client.Title
+	var source client.Source
+	if len(bundleIDs) > 0 {
+		titles, source, err = titlesClient.GetTitlesByBundleIDWithSource(readCtx, bundleIDs...)
+	} else {
+		titles, source, err = titlesClient.GetTitlesWithSource(readCtx, titleNames...)
+	}
+	var decodeErrored []ErroredTitleModel
+	if malformedErr, ok := errors.AsType[*client.MalformedTitlesError](err); ok && skipErrored {
+		decodeErrored = erroredTitlesFromMalformed(malformedErr, &resp.Diagnostics)
+		err = nil
+	}
 	if err != nil {
-		if titlesErr, ok := errors.AsType[*client.TitlesNotFoundError](err); ok {
-			resp.Diagnostics.AddError(
-				"Requested titles not found",
-				fmt.Sprintf("The following titles do not exist: %s",
-					strings.Join(titlesErr.MissingTitles, ", ")),
-			)
+		common.AddClientError(&resp.Diagnostics, "Unable to read Jamf Auto Update titles", err)
+		return
+	}
+	common.AddSourceWarnings(&resp.Diagnostics, source)
+
+	if !data.PrivacyFilter.IsNull() {
+		titles = filterPrivacyApproval(titles, data.PrivacyFilter.ValueBool())
+	}
+	if osVersion != "" {
+		titles = filterOSVersion(titles, osVersion)
+	}
+	if len(requiredProfiles) > 0 {
+		titles = filterRequiredProfiles(titles, requiredProfiles)
+	}
+
+	var profileErrored []ErroredTitleModel
+	if profileFormat == profileFormatXML {
+		var diags diag.Diagnostics
+		titles, profileErrored, diags = decodeProfiles(titles, skipErrored)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Unable to read Jamf Auto Update titles",
-			err.Error(),
-		)
-		return
+	} else {
+		resp.Diagnostics.Append(validateProfiles(titles)...)
 	}
 
-	models, err := buildTitleModelsFromResponse(titles)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error processing title data",
-			err.Error(),
-		)
+	models, iconErrored, diags := buildTitleModelsFromResponse(ctx, titles, iconOptions{
+		badge:       badge,
+		mask:        mask,
+		padding:     padding,
+		background:  background,
+		overlay:     d.client.UninstallOverlay(),
+		bestEffort:  processing == iconProcessingBestEffort,
+		skipErrored: skipErrored,
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
+	if !data.PreviousVersions.IsNull() {
+		var previous map[string]string
+		resp.Diagnostics.Append(data.PreviousVersions.ElementsAs(ctx, &previous, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		common.AddVersionChangesWarning(&resp.Diagnostics, previous, titleVersions(models))
+	}
+
 	data.Titles = models
+	data.TitleNamesFound = titleNamesFound(models)
+	data.ErroredTitles = slices.Concat(decodeErrored, profileErrored, iconErrored)
+	data.SourceType = types.StringValue(source.Type)
+	data.SourceLocation = types.StringValue(source.Location)
+	data.FetchedAt = types.StringValue(source.FetchedAt.UTC().Format(time.RFC3339))
 
 	tflog.Debug(ctx, fmt.Sprintf("Fetched %d titles from Jamf Auto Update API", len(data.Titles)))
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// titlesClient returns the client titles are read with: the provider's client, or one reading from
+// the data source's definitions_url or definitions_file when either is set.
+func (d *TitlesDataSource) titlesClient(data TitlesDataSourceModel) (*client.Client, error) {
+	if data.DefinitionsURL.IsNull() && data.DefinitionsFile.IsNull() {
+		return d.client, nil
+	}
+	if !data.DefinitionsURL.IsNull() && !data.DefinitionsFile.IsNull() {
+		return nil, errors.New("only one of definitions_url or definitions_file may be set")
+	}
+	return d.client.WithSource(data.DefinitionsURL.ValueString(), data.DefinitionsFile.ValueString())
+}
+
+// titleVersions returns the names and versions of the titles in models, in order.
+func titleVersions(models []TitleModel) []common.TitleVersion {
+	versions := make([]common.TitleVersion, 0, len(models))
+	for _, model := range models {
+		versions = append(versions, common.TitleVersion{TitleName: model.TitleName.ValueString(), TitleVersion: model.TitleVersion.ValueString()})
+	}
+	return versions
+}
+
+// titleNamesFound returns the set of names of the titles in models. Titles without a name are left out.
+func titleNamesFound(models []TitleModel) types.Set {
+	names := make([]attr.Value, 0, len(models))
+	seen := make(map[string]struct{}, len(models))
+	for _, model := range models {
+		if model.TitleName.IsNull() {
+			continue
+		}
+		if _, ok := seen[model.TitleName.ValueString()]; ok {
+			continue
+		}
+		seen[model.TitleName.ValueString()] = struct{}{}
+		names = append(names, model.TitleName)
+	}
+	return types.SetValueMust(types.StringType, names)
+}
+
+// erroredTitlesFromMalformed converts the catalog entries the client could not decode into errored
+// titles, adding a warning for each.
+func erroredTitlesFromMalformed(malformedErr *client.MalformedTitlesError, diags *diag.Diagnostics) []ErroredTitleModel {
+	errored := make([]ErroredTitleModel, 0, len(malformedErr.Titles))
+	for _, title := range malformedErr.Titles {
+		name := types.StringNull()
+		description := fmt.Sprintf("catalog entry %d", title.Index)
+		if title.TitleName != "" {
+			name = types.StringValue(title.TitleName)
+			description = fmt.Sprintf("title %q", title.TitleName)
+		}
+		diags.AddWarning(
+			"Skipped errored title",
+			fmt.Sprintf("The %s was skipped because it could not be decoded: %s", description, title.Err),
+		)
+		errored = append(errored, ErroredTitleModel{
+			TitleName: name,
+			Error:     types.StringValue(title.Err.Error()),
+		})
+	}
+	return errored
+}
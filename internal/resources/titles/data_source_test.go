@@ -5,9 +5,20 @@ package titles
 
 import (
 	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
 
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestTitlesDataSource_Metadata(t *testing.T) {
@@ -40,7 +51,7 @@ func TestTitlesDataSource_Schema(t *testing.T) {
 		t.Fatal("expected non-nil schema attributes")
 	}
 
-	expectedAttrs := []string{"timeouts", "title_names", "titles"}
+	expectedAttrs := []string{"timeouts", "title_names", "bundle_ids", "requires_privacy_approval", "os_version", "requires", "definitions_url", "definitions_file", "refresh_trigger", "previous_versions", "icon_badge", "icon_mask", "icon_padding", "icon_background_color", "icon_processing", "profile_format", "skip_errored_titles", "source_type", "source_location", "fetched_at", "title_names_found", "errored_titles", "titles"}
 	for _, name := range expectedAttrs {
 		if _, ok := attrs[name]; !ok {
 			t.Errorf("expected attribute %q in schema", name)
@@ -59,13 +70,187 @@ func TestTitlesDataSource_Schema(t *testing.T) {
 	_ = ok
 
 	expectedNestedAttrs := []string{
-		"title_name", "title_display_name", "title_description", "title_version",
+		"title_name", "title_display_name", "title_description", "title_description_plain", "title_version",
 		"minimum_os", "maximum_os", "icon_base64", "uninstall_icon_base64",
-		"extension_attribute", "content_filter_profile", "kernel_extension_profile",
+		"uninstall_icon_2x_base64", "badged_icon_base64", "self_service_icon_base64",
+		"extension_attribute", "uninstall_script", "content_filter_profile", "kernel_extension_profile",
 		"managed_login_items_profile", "notifications_profile", "pppcp_profile",
-		"screen_recording_profile", "system_extension_profile", "app_bundle_id",
+		"screen_recording_profile", "system_extension_profile", "additional_profiles",
+		"app_bundle_id", "eula_url", "license_type", "estimated_download_size_mb", "suggested_category", "icon_payload",
 	}
-	if len(expectedNestedAttrs) != 17 {
-		t.Errorf("expected 17 nested attributes, listed %d", len(expectedNestedAttrs))
+	if len(expectedNestedAttrs) != 28 {
+		t.Errorf("expected 28 nested attributes, listed %d", len(expectedNestedAttrs))
+	}
+}
+
+func TestErroredTitlesFromMalformed(t *testing.T) {
+	var diags diag.Diagnostics
+	errored := erroredTitlesFromMalformed(&client.MalformedTitlesError{Titles: []client.MalformedTitle{
+		{TitleName: "AppA", Index: 0, Err: errors.New("bad version")},
+		{Index: 4, Err: errors.New("bad name")},
+	}}, &diags)
+
+	if diags.WarningsCount() != 2 {
+		t.Errorf("expected 2 warnings, got %d", diags.WarningsCount())
+	}
+	if len(errored) != 2 {
+		t.Fatalf("expected 2 errored titles, got %d", len(errored))
+	}
+	if errored[0].TitleName.ValueString() != "AppA" || errored[0].Error.ValueString() != "bad version" {
+		t.Errorf("unexpected errored title: %+v", errored[0])
+	}
+	if !errored[1].TitleName.IsNull() {
+		t.Errorf("expected null title name for unnamed entry, got %s", errored[1].TitleName)
+	}
+}
+
+func TestTitlesClient(t *testing.T) {
+	provider := client.NewClient("https://example.com", "")
+	d := &TitlesDataSource{client: provider}
+
+	c, err := d.titlesClient(TitlesDataSourceModel{DefinitionsURL: types.StringNull(), DefinitionsFile: types.StringNull()})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c != provider {
+		t.Error("expected the provider's client when no source is overridden")
+	}
+
+	c, err = d.titlesClient(TitlesDataSourceModel{DefinitionsURL: types.StringNull(), DefinitionsFile: types.StringValue("definitions.json")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c == provider {
+		t.Error("expected a separate client for an overridden source")
+	}
+
+	if _, err := d.titlesClient(TitlesDataSourceModel{DefinitionsURL: types.StringValue("https://example.org"), DefinitionsFile: types.StringValue("definitions.json")}); err == nil {
+		t.Error("expected error when both sources are set")
+	}
+}
+
+func TestTitlesDataSource_ReadUnknownConfig(t *testing.T) {
+	ctx := context.Background()
+	d := &TitlesDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), tftypes.UnknownValue),
+	}
+
+	resp := &datasource.ReadResponse{}
+	d.Read(ctx, datasource.ReadRequest{Config: config, ClientCapabilities: datasource.ReadClientCapabilities{DeferralAllowed: true}}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if resp.Deferred == nil || resp.Deferred.Reason != datasource.DeferredReasonDataSourceConfigUnknown {
+		t.Errorf("expected the read to be deferred for unknown configuration, got %v", resp.Deferred)
+	}
+
+	resp = &datasource.ReadResponse{}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error when deferral is not allowed")
+	}
+	if resp.Deferred != nil {
+		t.Error("expected no deferral when deferral is not allowed")
+	}
+}
+
+func TestTitleNamesFound(t *testing.T) {
+	got := titleNamesFound([]TitleModel{
+		{TitleName: types.StringValue("GoogleChrome")},
+		{TitleName: types.StringNull()},
+		{TitleName: types.StringValue("Firefox")},
+		{TitleName: types.StringValue("GoogleChrome")},
+	})
+	want := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("GoogleChrome"), types.StringValue("Firefox")})
+	if !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	if empty := titleNamesFound(nil); empty.IsNull() || len(empty.Elements()) != 0 {
+		t.Errorf("expected an empty set, got %v", empty)
+	}
+}
+
+func TestTitlesDataSource_ReadRefreshTrigger(t *testing.T) {
+	ctx := context.Background()
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"title_name":"GoogleChrome","title_version":"1.0","patch_definition":{"requirements":[]}}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	d := &TitlesDataSource{client: client.NewClient(server.URL, "")}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	read := func(trigger string) {
+		t.Helper()
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["title_names"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "GoogleChrome")})
+		values["refresh_trigger"] = tftypes.NewValue(tftypes.String, trigger)
+
+		config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+	}
+
+	read("2026-10-15")
+	read("2026-10-15")
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected an unchanged trigger to reuse the titles, got %d requests", n)
+	}
+	read("2026-10-16")
+	if n := requests.Load(); n != 2 {
+		t.Errorf("expected a changed trigger to refetch the titles, got %d requests", n)
+	}
+}
+
+func TestTitlesDataSource_ReadPreviousVersions(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"title_name":"GoogleChrome","title_version":"120.0","patch_definition":{"requirements":[]}}]`))
+	}))
+	t.Cleanup(server.Close)
+
+	d := &TitlesDataSource{client: client.NewClient(server.URL, "")}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["title_names"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "GoogleChrome")})
+	values["previous_versions"] = tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{"GoogleChrome": tftypes.NewValue(tftypes.String, "119.0")})
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "GoogleChrome: 119.0 → 120.0") {
+		t.Errorf("expected a version change warning, got %v", warnings)
 	}
 }
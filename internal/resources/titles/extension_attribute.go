@@ -0,0 +1,55 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// ExtensionAttribute is an extension attribute broken out of a catalog title.
+type ExtensionAttribute struct {
+	// DisplayName, Description and DataType are empty when the catalog publishes only the script.
+	DisplayName string
+	Description string
+	DataType    string
+	Script      string
+}
+
+// extensionAttributeTemplate is the XML template Jamf Pro exports extension attributes in.
+type extensionAttributeTemplate struct {
+	XMLName     xml.Name `xml:"extensionAttribute"`
+	DisplayName string   `xml:"displayName"`
+	Description string   `xml:"description"`
+	DataType    string   `xml:"dataType"`
+	Script      string   `xml:"scriptContentsMac"`
+}
+
+// ParseExtensionAttribute breaks out the extension attribute of a title. The catalog may carry the
+// Jamf Pro XML template or just the script, either of them base64 encoded or as plain text. Data
+// types are upper-cased to match the Jamf Pro API, such as STRING, INTEGER or DATE.
+func ParseExtensionAttribute(content string) (ExtensionAttribute, error) {
+	data := []byte(content)
+	if decoded, err := base64.StdEncoding.DecodeString(strings.Join(strings.Fields(content), "")); err == nil {
+		data = decoded
+	}
+
+	if !bytes.HasPrefix(bytes.TrimSpace(data), []byte("<")) {
+		return ExtensionAttribute{Script: string(data)}, nil
+	}
+
+	var template extensionAttributeTemplate
+	if err := xml.Unmarshal(data, &template); err != nil {
+		return ExtensionAttribute{}, fmt.Errorf("error parsing extension attribute template: %w", err)
+	}
+	return ExtensionAttribute{
+		DisplayName: strings.TrimSpace(template.DisplayName),
+		Description: strings.TrimSpace(template.Description),
+		DataType:    strings.ToUpper(strings.TrimSpace(template.DataType)),
+		Script:      template.Script,
+	}, nil
+}
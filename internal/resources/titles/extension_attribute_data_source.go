@@ -0,0 +1,111 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &ExtensionAttributeDataSource{}
+
+// NewExtensionAttributeDataSource returns a new instance of the extension attribute data source.
+func NewExtensionAttributeDataSource() datasource.DataSource {
+	return &ExtensionAttributeDataSource{}
+}
+
+// ExtensionAttributeDataSource defines the data source implementation.
+type ExtensionAttributeDataSource struct {
+	client *client.Client
+}
+
+func (d *ExtensionAttributeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_extension_attribute"
+}
+
+func (d *ExtensionAttributeDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Breaks out the extension attribute of a Jamf Auto Update title into its script, display name and data type, so it can be passed to Jamf Pro computer extension attribute resources without parsing `extension_attribute` in HCL.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx),
+			"title_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the title whose extension attribute is read.",
+			},
+			"display_name": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The display name of the extension attribute. Null when the catalog publishes only the script.",
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The description of the extension attribute. Null when the catalog publishes only the script or no description.",
+			},
+			"data_type": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The data type of the extension attribute in the form the Jamf Pro API uses, such as `STRING`, `INTEGER` or `DATE`. Null when the catalog publishes only the script.",
+			},
+			"script": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "The body of the extension attribute script, decoded from base64 and XML.",
+			},
+		},
+	}
+}
+
+func (d *ExtensionAttributeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureClient(req, resp)
+}
+
+func (d *ExtensionAttributeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data ExtensionAttributeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	titleName := data.TitleName.ValueString()
+	title, ok := readSingleTitle(ctx, d.client, titleName, data.Timeouts, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if title.ExtensionAttribute == nil || *title.ExtensionAttribute == "" {
+		resp.Diagnostics.AddError(
+			"Title has no extension attribute",
+			fmt.Sprintf("The definitions source does not provide an extension attribute for %q.", titleName),
+		)
+		return
+	}
+
+	ea, err := ParseExtensionAttribute(*title.ExtensionAttribute)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error processing title data",
+			fmt.Sprintf("Title %q: %s", titleName, err),
+		)
+		return
+	}
+
+	data.DisplayName = optionalString(ea.DisplayName)
+	data.Description = optionalString(ea.Description)
+	data.DataType = optionalString(ea.DataType)
+	data.Script = types.StringValue(ea.Script)
+
+	tflog.Debug(ctx, "Fetched extension attribute from Jamf Auto Update API", map[string]any{
+		"title_name": titleName,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
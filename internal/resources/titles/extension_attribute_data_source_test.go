@@ -0,0 +1,105 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestExtensionAttributeDataSource_Metadata(t *testing.T) {
+	ds := &ExtensionAttributeDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jamfautoupdate",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "jamfautoupdate_extension_attribute" {
+		t.Errorf("expected jamfautoupdate_extension_attribute, got %s", resp.TypeName)
+	}
+}
+
+func TestExtensionAttributeDataSource_Schema(t *testing.T) {
+	ds := &ExtensionAttributeDataSource{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+
+	for _, name := range []string{"timeouts", "title_name", "display_name", "description", "data_type", "script"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected attribute %q in schema", name)
+		}
+	}
+}
+
+func TestExtensionAttributeDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	catalog, err := json.Marshal([]map[string]any{
+		{"title_name": "GoogleChrome", "extension_attribute": testExtensionAttributeTemplate},
+		{"title_name": "Firefox"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "definitions.json")
+	if err := os.WriteFile(file, catalog, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	d := &ExtensionAttributeDataSource{client: client.NewClient("", file)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	read := func(titleName string) *datasource.ReadResponse {
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["title_name"] = tftypes.NewValue(tftypes.String, titleName)
+
+		config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+		return resp
+	}
+
+	resp := read("GoogleChrome")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state ExtensionAttributeDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if state.DisplayName.ValueString() != "Google Chrome Version" {
+		t.Errorf("expected display name Google Chrome Version, got %s", state.DisplayName)
+	}
+	if state.DataType.ValueString() != "STRING" {
+		t.Errorf("expected data type STRING, got %s", state.DataType)
+	}
+	if state.Script.ValueString() != "#!/bin/sh\necho \"<result>1.0</result>\"\n" {
+		t.Errorf("unexpected script: %q", state.Script.ValueString())
+	}
+
+	if resp := read("Firefox"); !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a title without an extension attribute")
+	}
+}
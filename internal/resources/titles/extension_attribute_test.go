@@ -0,0 +1,71 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+const testExtensionAttributeTemplate = `<?xml version="1.0" encoding="UTF-8"?>
+<extensionAttribute>
+  <displayName>Google Chrome Version</displayName>
+  <description>Reports the installed version of Google Chrome</description>
+  <dataType>string</dataType>
+  <scriptContentsMac>#!/bin/sh
+echo "&lt;result&gt;1.0&lt;/result&gt;"
+</scriptContentsMac>
+</extensionAttribute>`
+
+func TestParseExtensionAttribute(t *testing.T) {
+	want := ExtensionAttribute{
+		DisplayName: "Google Chrome Version",
+		Description: "Reports the installed version of Google Chrome",
+		DataType:    "STRING",
+		Script:      "#!/bin/sh\necho \"<result>1.0</result>\"\n",
+	}
+
+	for name, content := range map[string]string{
+		"xml":    testExtensionAttributeTemplate,
+		"base64": base64.StdEncoding.EncodeToString([]byte(testExtensionAttributeTemplate)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseExtensionAttribute(content)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != want {
+				t.Errorf("ParseExtensionAttribute() = %+v, want %+v", got, want)
+			}
+		})
+	}
+}
+
+func TestParseExtensionAttribute_Script(t *testing.T) {
+	script := "#!/bin/sh\necho \"<result>1</result>\"\n"
+
+	for name, content := range map[string]string{
+		"plain":  script,
+		"base64": base64.StdEncoding.EncodeToString([]byte(script)),
+	} {
+		t.Run(name, func(t *testing.T) {
+			got, err := ParseExtensionAttribute(content)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != (ExtensionAttribute{Script: script}) {
+				t.Errorf("expected only the script, got %+v", got)
+			}
+		})
+	}
+}
+
+func TestParseExtensionAttribute_Invalid(t *testing.T) {
+	if _, err := ParseExtensionAttribute("<extensionAttribute><displayName>"); err == nil {
+		t.Error("expected error for a truncated template")
+	}
+	if _, err := ParseExtensionAttribute("<computer_extension_attribute/>"); err == nil {
+		t.Error("expected error for an unsupported template")
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"image/color"
 	"image/png"
 	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // createTestPNG generates a minimal valid PNG image of the given dimensions and returns it as a base64 string.
@@ -28,60 +31,93 @@ func createTestPNG(t *testing.T, width, height int) string {
 	return base64.StdEncoding.EncodeToString(buf.Bytes())
 }
 
-func TestProcessUninstallIcon_ValidPNG(t *testing.T) {
-	input := createTestPNG(t, 64, 64)
-	result, err := processUninstallIcon(input)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+func TestIconPaddingFromModel(t *testing.T) {
+	tests := []struct {
+		input types.Int64
+		want  int
+	}{
+		{types.Int64Null(), 0},
+		{types.Int64Value(0), 0},
+		{types.Int64Value(10), 10},
+		{types.Int64Value(imaging.MaxPadding), imaging.MaxPadding},
 	}
-	if result == nil {
-		t.Fatal("expected non-nil result")
+
+	for _, tt := range tests {
+		got, err := iconPaddingFromModel(tt.input)
+		if err != nil {
+			t.Errorf("iconPaddingFromModel(%s) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("iconPaddingFromModel(%s) = %d, want %d", tt.input, got, tt.want)
+		}
 	}
+}
 
-	decoded, err := base64.StdEncoding.DecodeString(*result)
-	if err != nil {
-		t.Fatalf("result is not valid base64: %v", err)
+func TestIconPaddingFromModel_Invalid(t *testing.T) {
+	for _, input := range []int64{-1, imaging.MaxPadding + 1} {
+		if _, err := iconPaddingFromModel(types.Int64Value(input)); err == nil {
+			t.Errorf("iconPaddingFromModel(%d) expected error", input)
+		}
 	}
+}
 
-	img, err := png.Decode(bytes.NewReader(decoded))
-	if err != nil {
-		t.Fatalf("result is not valid PNG: %v", err)
+func TestIconMaskFromModel(t *testing.T) {
+	tests := []struct {
+		input types.String
+		want  string
+	}{
+		{types.StringNull(), imaging.MaskNone},
+		{types.StringValue("none"), imaging.MaskNone},
+		{types.StringValue("squircle"), imaging.MaskSquircle},
 	}
 
-	bounds := img.Bounds()
-	if bounds.Dx() != BaseImageSize || bounds.Dy() != BaseImageSize {
-		t.Errorf("expected %dx%d image, got %dx%d", BaseImageSize, BaseImageSize, bounds.Dx(), bounds.Dy())
+	for _, tt := range tests {
+		got, err := iconMaskFromModel(tt.input)
+		if err != nil {
+			t.Errorf("iconMaskFromModel(%s) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("iconMaskFromModel(%s) = %q, want %q", tt.input, got, tt.want)
+		}
 	}
 }
 
-func TestProcessUninstallIcon_InvalidBase64(t *testing.T) {
-	_, err := processUninstallIcon("not-valid-base64!!!")
-	if err == nil {
-		t.Fatal("expected error for invalid base64")
+func TestIconMaskFromModel_Invalid(t *testing.T) {
+	if _, err := iconMaskFromModel(types.StringValue("circle")); err == nil {
+		t.Fatal("expected error for unsupported mask")
 	}
 }
 
-func TestProcessUninstallIcon_InvalidImageData(t *testing.T) {
-	input := base64.StdEncoding.EncodeToString([]byte("not an image"))
-	_, err := processUninstallIcon(input)
-	if err == nil {
-		t.Fatal("expected error for invalid image data")
+func TestIconBackgroundFromModel(t *testing.T) {
+	background, err := iconBackgroundFromModel(types.StringNull())
+	if err != nil || background != nil {
+		t.Errorf("expected nil background when unset, got %v, %v", background, err)
 	}
-}
 
-func TestOverlayImageBase64_ValidPNG(t *testing.T) {
-	decoded, err := base64.StdEncoding.DecodeString(OverlayImageBase64)
+	background, err = iconBackgroundFromModel(types.StringValue("#FFFFFF"))
 	if err != nil {
-		t.Fatalf("OverlayImageBase64 is not valid base64: %v", err)
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if *background != (color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}) {
+		t.Errorf("expected white background, got %v", *background)
 	}
+}
 
-	img, err := png.Decode(bytes.NewReader(decoded))
-	if err != nil {
-		t.Fatalf("OverlayImageBase64 is not valid PNG: %v", err)
+func TestIconBackgroundFromModel_Invalid(t *testing.T) {
+	for _, input := range []string{"white", "#FFFFFF80"} {
+		if _, err := iconBackgroundFromModel(types.StringValue(input)); err == nil {
+			t.Errorf("iconBackgroundFromModel(%q) expected error", input)
+		}
 	}
+}
 
-	bounds := img.Bounds()
-	if bounds.Dx() == 0 || bounds.Dy() == 0 {
-		t.Error("overlay image has zero dimensions")
+func TestOptionalString(t *testing.T) {
+	if got := optionalString(""); !got.IsNull() {
+		t.Errorf("expected null for an empty string, got %s", got)
+	}
+	if got := optionalString("STRING"); got.ValueString() != "STRING" {
+		t.Errorf("expected STRING, got %s", got)
 	}
 }
@@ -0,0 +1,144 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TitleIconDataSource{}
+
+// NewTitleIconDataSource returns a new instance of the title icon data source.
+func NewTitleIconDataSource() datasource.DataSource {
+	return &TitleIconDataSource{}
+}
+
+// TitleIconDataSource defines the data source implementation.
+type TitleIconDataSource struct {
+	client *client.Client
+}
+
+func (d *TitleIconDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_title_icon"
+}
+
+func (d *TitleIconDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Renders the icon of a Jamf Auto Update title at several standard sizes, for dashboards and Self Service assets that need multiple resolutions.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx),
+			"title_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the title whose icon is rendered.",
+			},
+			"icon_mask": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The shape the icon is clipped to at every size: `none` keeps the source artwork as is, `squircle` applies the rounded-corner macOS app icon mask. Defaults to `%s`.", defaultIconMask),
+			},
+			"icon_padding": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("Shrinks the artwork onto a transparent margin before the mask is applied, as a percentage of the icon size on each side. Must be between 0 and %d. Defaults to `0`.", imaging.MaxPadding),
+			},
+			"icon_background_color": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "A solid color in `#RRGGBB` format that transparent areas of the icon, including masked corners and padding, are flattened onto. Icons keep their transparency when unset.",
+			},
+			"icons": schema.MapAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "Base64 encoded PNG renderings of the icon keyed by their width and height in pixels: `32`, `64`, `128`, `256` and `512`, with `icon_mask`, `icon_padding` and `icon_background_color` applied.",
+			},
+		},
+	}
+}
+
+func (d *TitleIconDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureClient(req, resp)
+}
+
+func (d *TitleIconDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data TitleIconDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mask, err := iconMaskFromModel(data.IconMask)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("icon_mask"), "Invalid icon mask", err.Error())
+		return
+	}
+
+	padding, err := iconPaddingFromModel(data.IconPadding)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("icon_padding"), "Invalid icon padding", err.Error())
+		return
+	}
+
+	background, err := iconBackgroundFromModel(data.IconBackground)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("icon_background_color"), "Invalid icon background color", err.Error())
+		return
+	}
+
+	titleName := data.TitleName.ValueString()
+	title, ok := readSingleTitle(ctx, d.client, titleName, data.Timeouts, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if title.IconHiRes == nil {
+		resp.Diagnostics.AddError(
+			"Title has no icon",
+			fmt.Sprintf("The definitions source does not provide an icon for %q.", titleName),
+		)
+		return
+	}
+
+	opts := iconOptions{mask: mask, padding: padding, background: background}
+	canvas, err := imaging.NewCanvas(*title.IconHiRes, opts.imagingOptions())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rendering title icon",
+			err.Error(),
+		)
+		return
+	}
+
+	icons, err := imaging.RenderSizes(canvas, imaging.IconSizes)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error rendering title icon",
+			err.Error(),
+		)
+		return
+	}
+
+	logging.IconsDebug(ctx, "Rendered title icon sizes", map[string]any{
+		"title_name": titleName,
+		"sizes":      len(icons),
+	})
+
+	iconsValue, diags := types.MapValueFrom(ctx, types.StringType, icons)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Icons = iconsValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
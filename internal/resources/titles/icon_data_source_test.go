@@ -0,0 +1,42 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+)
+
+func TestTitleIconDataSource_Metadata(t *testing.T) {
+	ds := &TitleIconDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jamfautoupdate",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "jamfautoupdate_title_icon" {
+		t.Errorf("expected jamfautoupdate_title_icon, got %s", resp.TypeName)
+	}
+}
+
+func TestTitleIconDataSource_Schema(t *testing.T) {
+	ds := &TitleIconDataSource{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+
+	for _, name := range []string{"timeouts", "title_name", "icon_mask", "icon_padding", "icon_background_color", "icons"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected attribute %q in schema", name)
+		}
+	}
+}
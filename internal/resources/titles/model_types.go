@@ -10,28 +10,155 @@ import (
 
 // TitlesDataSourceModel describes the data source data model.
 type TitlesDataSourceModel struct {
-	TitleNames types.List     `tfsdk:"title_names"`
-	Timeouts   timeouts.Value `tfsdk:"timeouts"`
-	Titles     []TitleModel   `tfsdk:"titles"`
+	TitleNames       types.List          `tfsdk:"title_names"`
+	BundleIDs        types.List          `tfsdk:"bundle_ids"`
+	PrivacyFilter    types.Bool          `tfsdk:"requires_privacy_approval"`
+	OSVersion        types.String        `tfsdk:"os_version"`
+	Requires         types.List          `tfsdk:"requires"`
+	DefinitionsURL   types.String        `tfsdk:"definitions_url"`
+	DefinitionsFile  types.String        `tfsdk:"definitions_file"`
+	RefreshTrigger   types.String        `tfsdk:"refresh_trigger"`
+	PreviousVersions types.Map           `tfsdk:"previous_versions"`
+	IconBadge        *IconBadgeModel     `tfsdk:"icon_badge"`
+	IconMask         types.String        `tfsdk:"icon_mask"`
+	IconPadding      types.Int64         `tfsdk:"icon_padding"`
+	IconBackground   types.String        `tfsdk:"icon_background_color"`
+	IconProcessing   types.String        `tfsdk:"icon_processing"`
+	ProfileFormat    types.String        `tfsdk:"profile_format"`
+	SkipErrored      types.Bool          `tfsdk:"skip_errored_titles"`
+	Timeouts         timeouts.Value      `tfsdk:"timeouts"`
+	SourceType       types.String        `tfsdk:"source_type"`
+	SourceLocation   types.String        `tfsdk:"source_location"`
+	FetchedAt        types.String        `tfsdk:"fetched_at"`
+	Titles           []TitleModel        `tfsdk:"titles"`
+	TitleNamesFound  types.Set           `tfsdk:"title_names_found"`
+	ErroredTitles    []ErroredTitleModel `tfsdk:"errored_titles"`
+}
+
+// ErroredTitleModel describes a title that was skipped because it could not be processed.
+type ErroredTitleModel struct {
+	TitleName types.String `tfsdk:"title_name"`
+	Error     types.String `tfsdk:"error"`
+}
+
+// IconBadgeModel describes the text badge drawn onto title icons.
+type IconBadgeModel struct {
+	Text      types.String `tfsdk:"text"`
+	Color     types.String `tfsdk:"color"`
+	TextColor types.String `tfsdk:"text_color"`
+	Position  types.String `tfsdk:"position"`
 }
 
 // TitleModel describes the structure of a title in the data source.
 type TitleModel struct {
-	TitleName                types.String `tfsdk:"title_name"`
-	TitleDisplayName         types.String `tfsdk:"title_display_name"`
-	TitleDescription         types.String `tfsdk:"title_description"`
-	TitleVersion             types.String `tfsdk:"title_version"`
-	MinimumOS                types.String `tfsdk:"minimum_os"`
-	MaximumOS                types.String `tfsdk:"maximum_os"`
-	IconBase64               types.String `tfsdk:"icon_base64"`
-	UninstallIconBase64      types.String `tfsdk:"uninstall_icon_base64"`
-	ExtensionAttribute       types.String `tfsdk:"extension_attribute"`
-	ContentFilterProfile     types.String `tfsdk:"content_filter_profile"`
-	KernelExtensionProfile   types.String `tfsdk:"kernel_extension_profile"`
-	ManagedLoginItemsProfile types.String `tfsdk:"managed_login_items_profile"`
-	NotificationsProfile     types.String `tfsdk:"notifications_profile"`
-	PPPCPProfile             types.String `tfsdk:"pppcp_profile"`
-	ScreenRecordingProfile   types.String `tfsdk:"screen_recording_profile"`
-	SystemExtensionProfile   types.String `tfsdk:"system_extension_profile"`
-	AppBundleID              types.String `tfsdk:"app_bundle_id"`
+	TitleName                types.String      `tfsdk:"title_name"`
+	TitleDisplayName         types.String      `tfsdk:"title_display_name"`
+	TitleDescription         types.String      `tfsdk:"title_description"`
+	TitleDescriptionPlain    types.String      `tfsdk:"title_description_plain"`
+	TitleVersion             types.String      `tfsdk:"title_version"`
+	MinimumOS                types.String      `tfsdk:"minimum_os"`
+	MaximumOS                types.String      `tfsdk:"maximum_os"`
+	IconBase64               types.String      `tfsdk:"icon_base64"`
+	UninstallIconBase64      types.String      `tfsdk:"uninstall_icon_base64"`
+	UninstallIcon2xBase64    types.String      `tfsdk:"uninstall_icon_2x_base64"`
+	BadgedIconBase64         types.String      `tfsdk:"badged_icon_base64"`
+	SelfServiceIconBase64    types.String      `tfsdk:"self_service_icon_base64"`
+	ExtensionAttribute       types.String      `tfsdk:"extension_attribute"`
+	UninstallScript          types.String      `tfsdk:"uninstall_script"`
+	ContentFilterProfile     types.String      `tfsdk:"content_filter_profile"`
+	KernelExtensionProfile   types.String      `tfsdk:"kernel_extension_profile"`
+	ManagedLoginItemsProfile types.String      `tfsdk:"managed_login_items_profile"`
+	NotificationsProfile     types.String      `tfsdk:"notifications_profile"`
+	PPPCPProfile             types.String      `tfsdk:"pppcp_profile"`
+	ScreenRecordingProfile   types.String      `tfsdk:"screen_recording_profile"`
+	SystemExtensionProfile   types.String      `tfsdk:"system_extension_profile"`
+	AdditionalProfiles       types.Map         `tfsdk:"additional_profiles"`
+	AppBundleID              types.String      `tfsdk:"app_bundle_id"`
+	EULAURL                  types.String      `tfsdk:"eula_url"`
+	LicenseType              types.String      `tfsdk:"license_type"`
+	EstimatedDownloadSizeMB  types.Float64     `tfsdk:"estimated_download_size_mb"`
+	SuggestedCategory        types.String      `tfsdk:"suggested_category"`
+	IconPayload              *IconPayloadModel `tfsdk:"icon_payload"`
+}
+
+// IconPayloadModel describes a title icon in the shape expected by Jamf Pro icon uploads.
+type IconPayloadModel struct {
+	Filename    types.String `tfsdk:"filename"`
+	Base64      types.String `tfsdk:"base64"`
+	ContentType types.String `tfsdk:"content_type"`
+}
+
+// TitleIconDataSourceModel describes the title icon data source data model.
+type TitleIconDataSourceModel struct {
+	TitleName      types.String   `tfsdk:"title_name"`
+	IconMask       types.String   `tfsdk:"icon_mask"`
+	IconPadding    types.Int64    `tfsdk:"icon_padding"`
+	IconBackground types.String   `tfsdk:"icon_background_color"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	Icons          types.Map      `tfsdk:"icons"`
+}
+
+// TitleDataSourceModel describes the title data source data model. The title's attributes are
+// embedded so they appear at the top level of the data source.
+type TitleDataSourceModel struct {
+	TitleModel
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+// PatchDefinitionDataSourceModel describes the patch definition data source data model.
+type PatchDefinitionDataSourceModel struct {
+	TitleName    types.String          `tfsdk:"title_name"`
+	Timeouts     timeouts.Value        `tfsdk:"timeouts"`
+	Requirements []PatchCriterionModel `tfsdk:"requirements"`
+	Capabilities []PatchCriterionModel `tfsdk:"capabilities"`
+	Components   []PatchComponentModel `tfsdk:"components"`
+	KillApps     []KillAppModel        `tfsdk:"kill_apps"`
+}
+
+// PatchCriterionModel describes a requirement, capability or component criterion of a patch definition.
+type PatchCriterionModel struct {
+	Name     types.String `tfsdk:"name"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+	Type     types.String `tfsdk:"type"`
+	And      types.Bool   `tfsdk:"and"`
+}
+
+// PatchComponentModel describes a component of a patch definition.
+type PatchComponentModel struct {
+	Name     types.String          `tfsdk:"name"`
+	Version  types.String          `tfsdk:"version"`
+	Criteria []PatchCriterionModel `tfsdk:"criteria"`
+}
+
+// KillAppModel describes an application that must be quit before a title is updated.
+type KillAppModel struct {
+	BundleID types.String `tfsdk:"bundle_id"`
+	AppName  types.String `tfsdk:"app_name"`
+}
+
+// ExtensionAttributeDataSourceModel describes the extension attribute data source data model.
+type ExtensionAttributeDataSourceModel struct {
+	TitleName   types.String   `tfsdk:"title_name"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	DisplayName types.String   `tfsdk:"display_name"`
+	Description types.String   `tfsdk:"description"`
+	DataType    types.String   `tfsdk:"data_type"`
+	Script      types.String   `tfsdk:"script"`
+}
+
+// TitleProfilesDataSourceModel describes the title profiles data source data model.
+type TitleProfilesDataSourceModel struct {
+	TitleName     types.String   `tfsdk:"title_name"`
+	ProfileFormat types.String   `tfsdk:"profile_format"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
+	Profiles      types.Map      `tfsdk:"profiles"`
+}
+
+// TitleNamesDataSourceModel describes the title names data source data model.
+type TitleNamesDataSourceModel struct {
+	Prefix   types.String   `tfsdk:"prefix"`
+	Pattern  types.String   `tfsdk:"pattern"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+	Names    []types.String `tfsdk:"names"`
 }
@@ -0,0 +1,54 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/versions"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// osVersionFromModel validates the configured macOS version, returning an empty string when unset.
+func osVersionFromModel(v types.String) (string, error) {
+	if v.IsNull() {
+		return "", nil
+	}
+
+	osVersion := strings.TrimSpace(v.ValueString())
+	if osVersion == "" {
+		return "", errors.New("os version must not be empty")
+	}
+	if !strings.ContainsFunc(osVersion, func(r rune) bool { return r >= '0' && r <= '9' }) {
+		return "", errors.New("os version must contain a version number, such as 13.6")
+	}
+	return osVersion, nil
+}
+
+// supportsOSVersion reports whether osVersion lies within the title's minimum_os and maximum_os. A
+// missing bound is unrestricted, and a maximum covers its own point releases, so a maximum of 14
+// includes 14.2.
+func supportsOSVersion(title client.Title, osVersion string) bool {
+	if minimum := strings.TrimSpace(stringValue(title.MinimumOS)); minimum != "" && versions.Compare(osVersion, minimum) < 0 {
+		return false
+	}
+	if maximum := strings.TrimSpace(stringValue(title.MaximumOS)); maximum != "" && versions.Compare(osVersion, maximum) > 0 &&
+		!strings.HasPrefix(osVersion, maximum+".") {
+		return false
+	}
+	return true
+}
+
+// filterOSVersion returns the titles that support osVersion, in their original order.
+func filterOSVersion(titles []client.Title, osVersion string) []client.Title {
+	filtered := make([]client.Title, 0, len(titles))
+	for _, title := range titles {
+		if supportsOSVersion(title, osVersion) {
+			filtered = append(filtered, title)
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,60 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestOSVersionFromModel(t *testing.T) {
+	if got, err := osVersionFromModel(types.StringNull()); err != nil || got != "" {
+		t.Errorf("expected empty version for null, got %q, %v", got, err)
+	}
+	if got, err := osVersionFromModel(types.StringValue(" 13.6 ")); err != nil || got != "13.6" {
+		t.Errorf("expected 13.6, got %q, %v", got, err)
+	}
+	for _, invalid := range []string{"", "  ", "Ventura"} {
+		if _, err := osVersionFromModel(types.StringValue(invalid)); err == nil {
+			t.Errorf("expected error for %q", invalid)
+		}
+	}
+}
+
+func TestFilterOSVersion(t *testing.T) {
+	titles := []client.Title{
+		{TitleName: new("Unbounded")},
+		{TitleName: new("Modern"), MinimumOS: new("14.0")},
+		{TitleName: new("Legacy"), MaximumOS: new("12.7.6")},
+		{TitleName: new("Ranged"), MinimumOS: new("10.15"), MaximumOS: new("13")},
+		{TitleName: new("Numeric"), MinimumOS: new("13.10")},
+	}
+
+	names := func(titles []client.Title) []string {
+		var names []string
+		for _, title := range titles {
+			names = append(names, *title.TitleName)
+		}
+		return names
+	}
+
+	tests := []struct {
+		osVersion string
+		want      []string
+	}{
+		{"13.6", []string{"Unbounded", "Ranged"}},
+		{"13.10.1", []string{"Unbounded", "Ranged", "Numeric"}},
+		{"14.2", []string{"Unbounded", "Modern", "Numeric"}},
+		{"12.7.6", []string{"Unbounded", "Legacy", "Ranged"}},
+		{"10.14", []string{"Unbounded", "Legacy"}},
+	}
+	for _, tt := range tests {
+		if got := names(filterOSVersion(titles, tt.osVersion)); !slices.Equal(got, tt.want) {
+			t.Errorf("filterOSVersion(%q) = %v, want %v", tt.osVersion, got, tt.want)
+		}
+	}
+}
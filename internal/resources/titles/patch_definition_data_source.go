@@ -0,0 +1,199 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &PatchDefinitionDataSource{}
+
+// NewPatchDefinitionDataSource returns a new instance of the patch definition data source.
+func NewPatchDefinitionDataSource() datasource.DataSource {
+	return &PatchDefinitionDataSource{}
+}
+
+// PatchDefinitionDataSource defines the data source implementation.
+type PatchDefinitionDataSource struct {
+	client *client.Client
+}
+
+func (d *PatchDefinitionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_patch_definition"
+}
+
+// patchCriterionAttributes returns the attributes of a patch definition criterion.
+func patchCriterionAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The name of the criterion, such as `Application Bundle ID` or `Operating System Version`",
+		},
+		"operator": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "How the value is compared, such as `is` or `greater than or equal`",
+		},
+		"value": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "The value the criterion is compared against",
+		},
+		"type": schema.StringAttribute{
+			Computed:            true,
+			MarkdownDescription: "Where the criterion is evaluated, such as `recon` for inventory or `extensionAttribute`",
+		},
+		"and": schema.BoolAttribute{
+			Computed:            true,
+			MarkdownDescription: "Whether the criterion is combined with the next one using `and` rather than `or`",
+		},
+	}
+}
+
+func (d *PatchDefinitionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Exposes the complete patch definition of a Jamf Auto Update title, including its requirements, capabilities, components and the applications to quit before updating, so Jamf Pro patch management resources can be configured from the catalog.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx),
+			"title_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the title whose patch definition is read.",
+			},
+			"requirements": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The criteria identifying devices that have the title installed.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: patchCriterionAttributes(),
+				},
+			},
+			"capabilities": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The criteria a device must meet to install the title. Empty when the catalog does not report any.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: patchCriterionAttributes(),
+				},
+			},
+			"components": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The installed software that makes up the title. Empty when the catalog does not report any.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the component",
+						},
+						"version": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The version of the component",
+						},
+						"criteria": schema.ListNestedAttribute{
+							Computed:            true,
+							MarkdownDescription: "The criteria identifying the component on a device",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: patchCriterionAttributes(),
+							},
+						},
+					},
+				},
+			},
+			"kill_apps": schema.ListNestedAttribute{
+				Computed:            true,
+				MarkdownDescription: "The applications that must be quit before the title is updated. Empty when the catalog does not report any.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"bundle_id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The bundle identifier of the application",
+						},
+						"app_name": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "The name of the application, such as `Google Chrome.app`",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *PatchDefinitionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureClient(req, resp)
+}
+
+func (d *PatchDefinitionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data PatchDefinitionDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	titleName := data.TitleName.ValueString()
+	title, ok := readSingleTitle(ctx, d.client, titleName, data.Timeouts, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	definition := title.PatchDefinition
+	data.Requirements = buildPatchCriteria(definition.Requirements)
+	data.Capabilities = buildPatchCriteria(definition.Capabilities)
+	data.Components = buildPatchComponents(definition.Components)
+	data.KillApps = buildKillApps(definition.KillApps)
+
+	tflog.Debug(ctx, "Fetched patch definition from Jamf Auto Update API", map[string]any{
+		"title_name":   titleName,
+		"requirements": len(data.Requirements),
+		"components":   len(data.Components),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// buildPatchCriteria converts patch definition criteria into state values.
+func buildPatchCriteria(criteria []client.Requirement) []PatchCriterionModel {
+	models := make([]PatchCriterionModel, 0, len(criteria))
+	for _, criterion := range criteria {
+		models = append(models, PatchCriterionModel{
+			Name:     types.StringPointerValue(criterion.Name),
+			Operator: types.StringPointerValue(criterion.Operator),
+			Value:    types.StringPointerValue(criterion.Value),
+			Type:     types.StringPointerValue(criterion.Type),
+			And:      types.BoolPointerValue(criterion.And),
+		})
+	}
+	return models
+}
+
+// buildPatchComponents converts patch definition components into state values.
+func buildPatchComponents(components []client.Component) []PatchComponentModel {
+	models := make([]PatchComponentModel, 0, len(components))
+	for _, component := range components {
+		models = append(models, PatchComponentModel{
+			Name:     types.StringPointerValue(component.Name),
+			Version:  types.StringPointerValue(component.Version),
+			Criteria: buildPatchCriteria(component.Criteria),
+		})
+	}
+	return models
+}
+
+// buildKillApps converts the applications to quit before updating into state values.
+func buildKillApps(killApps []client.KillApp) []KillAppModel {
+	models := make([]KillAppModel, 0, len(killApps))
+	for _, killApp := range killApps {
+		models = append(models, KillAppModel{
+			BundleID: types.StringPointerValue(killApp.BundleID),
+			AppName:  types.StringPointerValue(killApp.AppName),
+		})
+	}
+	return models
+}
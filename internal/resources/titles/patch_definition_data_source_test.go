@@ -0,0 +1,115 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPatchDefinitionDataSource_Metadata(t *testing.T) {
+	ds := &PatchDefinitionDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jamfautoupdate",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "jamfautoupdate_patch_definition" {
+		t.Errorf("expected jamfautoupdate_patch_definition, got %s", resp.TypeName)
+	}
+}
+
+func TestPatchDefinitionDataSource_Schema(t *testing.T) {
+	ds := &PatchDefinitionDataSource{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+
+	for _, name := range []string{"timeouts", "title_name", "requirements", "capabilities", "components", "kill_apps"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected attribute %q in schema", name)
+		}
+	}
+}
+
+func TestBuildPatchCriteria(t *testing.T) {
+	models := buildPatchCriteria([]client.Requirement{
+		{Name: new("Application Bundle ID"), Operator: new("is"), Value: new("com.google.Chrome"), Type: new("recon"), And: new(true)},
+		{Name: new("Application Title")},
+	})
+	if len(models) != 2 {
+		t.Fatalf("expected 2 criteria, got %d", len(models))
+	}
+	if models[0].Operator.ValueString() != "is" || models[0].Type.ValueString() != "recon" || !models[0].And.ValueBool() {
+		t.Errorf("unexpected criterion: %+v", models[0])
+	}
+	if !models[1].Operator.IsNull() || !models[1].And.IsNull() {
+		t.Errorf("expected null operator and and for a bare criterion, got %+v", models[1])
+	}
+
+	if models := buildPatchCriteria(nil); models == nil || len(models) != 0 {
+		t.Errorf("expected an empty list for no criteria, got %v", models)
+	}
+}
+
+func TestPatchDefinitionDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "definitions.json")
+	content := `[{"title_name":"GoogleChrome","patch_definition":{
+		"requirements":[{"name":"Application Bundle ID","operator":"is","value":"com.google.Chrome","type":"recon"}],
+		"components":[{"name":"Google Chrome","version":"1.0","criteria":[{"name":"Application Version","operator":"is","value":"1.0","type":"recon"}]}],
+		"killApps":[{"bundleId":"com.google.Chrome","appName":"Google Chrome.app"}]}}]`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	d := &PatchDefinitionDataSource{client: client.NewClient("", file)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["title_name"] = tftypes.NewValue(tftypes.String, "GoogleChrome")
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state PatchDefinitionDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if len(state.Requirements) != 1 || state.Requirements[0].Value.ValueString() != "com.google.Chrome" {
+		t.Errorf("unexpected requirements: %+v", state.Requirements)
+	}
+	if len(state.Capabilities) != 0 {
+		t.Errorf("expected no capabilities, got %+v", state.Capabilities)
+	}
+	if len(state.Components) != 1 || len(state.Components[0].Criteria) != 1 {
+		t.Errorf("unexpected components: %+v", state.Components)
+	}
+	if len(state.KillApps) != 1 || state.KillApps[0].AppName.ValueString() != "Google Chrome.app" {
+		t.Errorf("unexpected kill apps: %+v", state.KillApps)
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+)
+
+// requiresPrivacyApproval reports whether a title ships a profile pre-approving a privacy permission
+// users would otherwise be prompted for: PPPC, screen recording or notifications.
+func requiresPrivacyApproval(title client.Title) bool {
+	return shipsProfile(title.PPPCPProfile) || shipsProfile(title.ScreenRecordingProfile) || shipsProfile(title.NotificationsProfile)
+}
+
+// filterPrivacyApproval returns the titles for which requiresPrivacyApproval equals want, in their
+// original order.
+func filterPrivacyApproval(titles []client.Title, want bool) []client.Title {
+	filtered := make([]client.Title, 0, len(titles))
+	for _, title := range titles {
+		if requiresPrivacyApproval(title) == want {
+			filtered = append(filtered, title)
+		}
+	}
+	return filtered
+}
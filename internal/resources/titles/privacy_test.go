@@ -0,0 +1,37 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+)
+
+func TestFilterPrivacyApproval(t *testing.T) {
+	titles := []client.Title{
+		{TitleName: new("Zoom"), ScreenRecordingProfile: new("c2NyZWVu")},
+		{TitleName: new("Firefox")},
+		{TitleName: new("Slack"), NotificationsProfile: new("bm90aWZ5")},
+		{TitleName: new("Chrome"), ContentFilterProfile: new("ZmlsdGVy")},
+		{TitleName: new("Dropbox"), PPPCPProfile: new("cHBwYw==")},
+		{TitleName: new("Spotify"), PPPCPProfile: new("")},
+	}
+
+	names := func(titles []client.Title) []string {
+		var names []string
+		for _, title := range titles {
+			names = append(names, *title.TitleName)
+		}
+		return names
+	}
+
+	if got, want := names(filterPrivacyApproval(titles, true)), []string{"Zoom", "Slack", "Dropbox"}; !slices.Equal(got, want) {
+		t.Errorf("filterPrivacyApproval(true) = %v, want %v", got, want)
+	}
+	if got, want := names(filterPrivacyApproval(titles, false)), []string{"Firefox", "Chrome", "Spotify"}; !slices.Equal(got, want) {
+		t.Errorf("filterPrivacyApproval(false) = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/profiles"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Supported profile formats.
+const (
+	profileFormatBase64 = "base64"
+	profileFormatXML    = "xml"
+)
+
+// profileFormats lists the supported profile formats.
+var profileFormats = []string{profileFormatBase64, profileFormatXML}
+
+// defaultProfileFormat is the profile format used when profile_format is not configured.
+const defaultProfileFormat = profileFormatBase64
+
+// profileFormatFromModel validates the configured profile format, returning defaultProfileFormat when unset.
+func profileFormatFromModel(v types.String) (string, error) {
+	if v.IsNull() {
+		return defaultProfileFormat, nil
+	}
+
+	format := v.ValueString()
+	if !slices.Contains(profileFormats, format) {
+		return "", fmt.Errorf("profile format must be one of %s, got: %q", strings.Join(profileFormats, ", "), format)
+	}
+	return format, nil
+}
+
+// decodeProfiles replaces the catalog profiles of each title with their property list XML, in
+// canonical form so that re-exports of the same payload do not produce diffs. When
+// skipErrored is set, a title with a profile that cannot be decoded is left out of the results,
+// with a warning, and returned in the errored titles instead of failing the whole read.
+func decodeProfiles(titles []client.Title, skipErrored bool) ([]client.Title, []ErroredTitleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	decoded := make([]client.Title, 0, len(titles))
+	var errored []ErroredTitleModel
+
+	for _, title := range titles {
+		title, err := decodeTitleProfiles(title)
+		if err == nil {
+			decoded = append(decoded, title)
+			continue
+		}
+
+		if !skipErrored {
+			diags.AddError("Error processing title data", fmt.Sprintf("Title %q: %s", stringValue(title.TitleName), err))
+			return nil, nil, diags
+		}
+		diags.AddWarning(
+			"Skipped errored title",
+			fmt.Sprintf("Title %q was skipped because a profile could not be decoded: %s", stringValue(title.TitleName), err),
+		)
+		errored = append(errored, ErroredTitleModel{
+			TitleName: types.StringPointerValue(title.TitleName),
+			Error:     types.StringValue(err.Error()),
+		})
+	}
+
+	return decoded, errored, diags
+}
+
+// profileField is a profile attribute of a title and its catalog key.
+type profileField struct {
+	key   string
+	value **string
+}
+
+// profileFields returns the profile attributes of title in a stable order.
+func profileFields(title *client.Title) []profileField {
+	return []profileField{
+		{"content_filter_profile", &title.ContentFilterProfile},
+		{"kernel_extension_profile", &title.KernelExtensionProfile},
+		{"managed_login_items_profile", &title.ManagedLoginItemsProfile},
+		{"notifications_profile", &title.NotificationsProfile},
+		{"pppcp_profile", &title.PPPCPProfile},
+		{"screen_recording_profile", &title.ScreenRecordingProfile},
+		{"system_extension_profile", &title.SystemExtensionProfile},
+	}
+}
+
+// shipsProfile reports whether a catalog profile is present. Catalogs publish missing profiles as
+// either a null or an empty string, so both count as absent.
+func shipsProfile(profile *string) bool {
+	return profile != nil && *profile != ""
+}
+
+// decodeTitleProfiles returns a copy of title with each of its profiles, including additional
+// profiles, replaced by its property list XML.
+func decodeTitleProfiles(title client.Title) (client.Title, error) {
+	for _, field := range profileFields(&title) {
+		if *field.value == nil {
+			continue
+		}
+		text, err := profileText(**field.value)
+		if err != nil {
+			return title, fmt.Errorf("%s: %w", field.key, err)
+		}
+		*field.value = &text
+	}
+
+	if title.AdditionalProfiles == nil {
+		return title, nil
+	}
+
+	additional := make(map[string]string, len(title.AdditionalProfiles))
+	for _, key := range slices.Sorted(maps.Keys(title.AdditionalProfiles)) {
+		text, err := profileText(title.AdditionalProfiles[key])
+		if err != nil {
+			return title, fmt.Errorf("%s: %w", key, err)
+		}
+		additional[key] = text
+	}
+	title.AdditionalProfiles = additional
+
+	return title, nil
+}
+
+// validateProfiles adds a warning for each profile of titles, including additional profiles, that
+// is not a well-formed property list, so corrupt catalog payloads are caught before they are
+// deployed.
+func validateProfiles(titles []client.Title) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, title := range titles {
+		contents := map[string]string{}
+		for _, field := range profileFields(&title) {
+			if *field.value != nil {
+				contents[field.key] = **field.value
+			}
+		}
+		maps.Copy(contents, title.AdditionalProfiles)
+
+		for _, key := range slices.Sorted(maps.Keys(contents)) {
+			if _, err := profiles.Decode(contents[key]); err != nil {
+				diags.AddWarning(
+					"Invalid profile in catalog",
+					fmt.Sprintf("The %s of title %q is not a well-formed property list: %s", key, stringValue(title.TitleName), err),
+				)
+			}
+		}
+	}
+	return diags
+}
+
+// profileText returns the canonical property list XML of a catalog profile as text.
+func profileText(content string) (string, error) {
+	data, err := profiles.Canonical(content)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
@@ -0,0 +1,145 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testProfileXML = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE plist PUBLIC "-//Apple//DTD PLIST 1.0//EN" "http://www.apple.com/DTDs/PropertyList-1.0.dtd">
+<plist version="1.0">
+<dict>
+	<key>PayloadIdentifier</key>
+	<string>com.example.profile</string>
+	<key>PayloadType</key>
+	<string>Configuration</string>
+</dict>
+</plist>
+`
+
+func TestProfileFormatFromModel(t *testing.T) {
+	tests := []struct {
+		input types.String
+		want  string
+	}{
+		{types.StringNull(), profileFormatBase64},
+		{types.StringValue("base64"), profileFormatBase64},
+		{types.StringValue("xml"), profileFormatXML},
+	}
+
+	for _, tt := range tests {
+		got, err := profileFormatFromModel(tt.input)
+		if err != nil {
+			t.Errorf("profileFormatFromModel(%s) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("profileFormatFromModel(%s) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := profileFormatFromModel(types.StringValue("plist")); err == nil {
+		t.Error("expected error for unsupported format")
+	}
+}
+
+func TestDecodeProfiles(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(testProfileXML))
+	titles := []client.Title{{
+		TitleName:          new("GoogleChrome"),
+		PPPCPProfile:       new(encoded),
+		AdditionalProfiles: map[string]string{"dns_proxy_profile": encoded},
+	}}
+
+	decoded, errored, diags := decodeProfiles(titles, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if len(errored) != 0 {
+		t.Errorf("expected no errored titles, got %v", errored)
+	}
+	if *decoded[0].PPPCPProfile != testProfileXML {
+		t.Errorf("expected decoded profile, got %q", *decoded[0].PPPCPProfile)
+	}
+	if decoded[0].AdditionalProfiles["dns_proxy_profile"] != testProfileXML {
+		t.Errorf("expected decoded additional profile, got %q", decoded[0].AdditionalProfiles["dns_proxy_profile"])
+	}
+	if decoded[0].NotificationsProfile != nil {
+		t.Error("expected absent profile to stay nil")
+	}
+	if *titles[0].PPPCPProfile != encoded || titles[0].AdditionalProfiles["dns_proxy_profile"] != encoded {
+		t.Error("expected the catalog titles to be left unchanged")
+	}
+}
+
+func TestDecodeProfiles_Canonical(t *testing.T) {
+	reexported := `<?xml version="1.0" encoding="UTF-8"?>
+<plist version="1.0"><dict>
+    <key>PayloadType</key> <string>Configuration</string>
+    <key>PayloadIdentifier</key> <string>com.example.profile</string>
+</dict></plist>`
+	titles := []client.Title{{
+		TitleName:    new("GoogleChrome"),
+		PPPCPProfile: new(base64.StdEncoding.EncodeToString([]byte(reexported))),
+	}}
+
+	decoded, _, diags := decodeProfiles(titles, false)
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if *decoded[0].PPPCPProfile != testProfileXML {
+		t.Errorf("expected canonical profile, got %q", *decoded[0].PPPCPProfile)
+	}
+}
+
+func TestDecodeProfiles_Invalid(t *testing.T) {
+	titles := []client.Title{
+		{TitleName: new("Broken"), NotificationsProfile: new("not a profile!")},
+		{TitleName: new("Firefox")},
+	}
+
+	if _, _, diags := decodeProfiles(titles, false); !diags.HasError() {
+		t.Fatal("expected error for undecodable profile")
+	}
+
+	decoded, errored, diags := decodeProfiles(titles, true)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Fatalf("expected a single warning, got %v", diags)
+	}
+	if len(decoded) != 1 || *decoded[0].TitleName != "Firefox" {
+		t.Errorf("expected the broken title to be skipped, got %d titles", len(decoded))
+	}
+	if len(errored) != 1 || errored[0].TitleName.ValueString() != "Broken" {
+		t.Errorf("expected Broken in errored titles, got %v", errored)
+	}
+}
+
+func TestValidateProfiles(t *testing.T) {
+	encoded := base64.StdEncoding.EncodeToString([]byte(testProfileXML))
+	titles := []client.Title{
+		{
+			TitleName:            new("GoogleChrome"),
+			PPPCPProfile:         new(encoded),
+			NotificationsProfile: new(base64.StdEncoding.EncodeToString([]byte("<plist><dict><key>A</key></dict></plist>"))),
+			AdditionalProfiles:   map[string]string{"dns_proxy_profile": "not a profile!"},
+		},
+		{TitleName: new("Firefox"), SystemExtensionProfile: new(encoded)},
+	}
+
+	diags := validateProfiles(titles)
+	if diags.HasError() || diags.WarningsCount() != 2 {
+		t.Fatalf("expected 2 warnings, got %v", diags)
+	}
+	for i, key := range []string{"dns_proxy_profile", "notifications_profile"} {
+		if detail := diags[i].Detail(); !strings.Contains(detail, key) || !strings.Contains(detail, `"GoogleChrome"`) {
+			t.Errorf("expected warning to name %s of GoogleChrome, got %q", key, detail)
+		}
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+)
+
+// profileKeys lists the profile attributes that can be required with requires.
+func profileKeys() []string {
+	var keys []string
+	for _, field := range profileFields(&client.Title{}) {
+		keys = append(keys, field.key)
+	}
+	return keys
+}
+
+// validateRequiredProfile returns an error if key is not a profile attribute of a title.
+func validateRequiredProfile(key string) error {
+	if keys := profileKeys(); !slices.Contains(keys, key) {
+		return fmt.Errorf("required profile must be one of %s, got: %q", strings.Join(keys, ", "), key)
+	}
+	return nil
+}
+
+// hasProfiles reports whether title ships every profile in keys.
+func hasProfiles(title client.Title, keys []string) bool {
+	for _, field := range profileFields(&title) {
+		if slices.Contains(keys, field.key) && !shipsProfile(*field.value) {
+			return false
+		}
+	}
+	return true
+}
+
+// filterRequiredProfiles returns the titles that ship every profile in keys, in their original order.
+func filterRequiredProfiles(titles []client.Title, keys []string) []client.Title {
+	filtered := make([]client.Title, 0, len(titles))
+	for _, title := range titles {
+		if hasProfiles(title, keys) {
+			filtered = append(filtered, title)
+		}
+	}
+	return filtered
+}
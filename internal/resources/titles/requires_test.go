@@ -0,0 +1,56 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+)
+
+func TestValidateRequiredProfile(t *testing.T) {
+	for _, key := range []string{"pppcp_profile", "system_extension_profile", "content_filter_profile"} {
+		if err := validateRequiredProfile(key); err != nil {
+			t.Errorf("unexpected error for %q: %v", key, err)
+		}
+	}
+	for _, key := range []string{"", "pppc_profile", "extension_attribute", "additional_profiles"} {
+		if err := validateRequiredProfile(key); err == nil {
+			t.Errorf("expected error for %q", key)
+		}
+	}
+}
+
+func TestFilterRequiredProfiles(t *testing.T) {
+	titles := []client.Title{
+		{TitleName: new("Zoom"), PPPCPProfile: new("cHBwYw=="), ScreenRecordingProfile: new("c2NyZWVu")},
+		{TitleName: new("Firefox")},
+		{TitleName: new("CrowdStrike"), PPPCPProfile: new("cHBwYw=="), SystemExtensionProfile: new("c3lzZXh0")},
+		{TitleName: new("LittleSnitch"), SystemExtensionProfile: new("c3lzZXh0"), PPPCPProfile: new("")},
+	}
+
+	names := func(titles []client.Title) []string {
+		var names []string
+		for _, title := range titles {
+			names = append(names, *title.TitleName)
+		}
+		return names
+	}
+
+	tests := []struct {
+		keys []string
+		want []string
+	}{
+		{[]string{"pppcp_profile"}, []string{"Zoom", "CrowdStrike"}},
+		{[]string{"system_extension_profile"}, []string{"CrowdStrike", "LittleSnitch"}},
+		{[]string{"pppcp_profile", "system_extension_profile"}, []string{"CrowdStrike"}},
+		{nil, []string{"Zoom", "Firefox", "CrowdStrike", "LittleSnitch"}},
+	}
+	for _, tt := range tests {
+		if got := names(filterRequiredProfiles(titles, tt.keys)); !slices.Equal(got, tt.want) {
+			t.Errorf("filterRequiredProfiles(%v) = %v, want %v", tt.keys, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// configureClient returns the client passed as provider data to a data source, adding an error to
+// resp when the provider data is of an unexpected type. It returns nil before the provider is configured.
+func configureClient(req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *client.Client {
+	if req.ProviderData == nil {
+		return nil
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return nil
+	}
+
+	return c
+}
+
+// readSingleTitle fetches the title named titleName for the data sources that read one title,
+// within the configured read timeout. Source warnings and errors, including a title the
+// definitions source does not provide, are added to diags, and ok is false when the read failed.
+func readSingleTitle(ctx context.Context, c *client.Client, titleName string, configuredTimeouts timeouts.Value, diags *diag.Diagnostics) (title client.Title, ok bool) {
+	readTimeout := defaultReadTimeout
+	if !configuredTimeouts.IsNull() && !configuredTimeouts.IsUnknown() {
+		configuredTimeout, timeoutDiags := configuredTimeouts.Read(ctx, defaultReadTimeout)
+		diags.Append(timeoutDiags...)
+		if diags.HasError() {
+			return client.Title{}, false
+		}
+		readTimeout = configuredTimeout
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	titles, source, err := c.GetTitlesWithSource(readCtx, titleName)
+	if err != nil {
+		common.AddClientError(diags, "Unable to read Jamf Auto Update title", err)
+		return client.Title{}, false
+	}
+	common.AddSourceWarnings(diags, source)
+
+	// The client only returns the requested title, but guard against sources that return more.
+	titles = filterTitleName(titles, titleName)
+	if len(titles) == 0 {
+		common.AddClientError(diags, "Unable to read Jamf Auto Update title", &client.TitlesNotFoundError{MissingTitles: []string{titleName}})
+		return client.Title{}, false
+	}
+
+	return titles[0], true
+}
+
+// filterTitleName returns the titles named name.
+func filterTitleName(titles []client.Title, name string) []client.Title {
+	var filtered []client.Title
+	for _, title := range titles {
+		if stringValue(title.TitleName) == name {
+			filtered = append(filtered, title)
+		}
+	}
+	return filtered
+}
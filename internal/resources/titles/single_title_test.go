@@ -0,0 +1,61 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestConfigureClient(t *testing.T) {
+	resp := &datasource.ConfigureResponse{}
+	if c := configureClient(datasource.ConfigureRequest{}, resp); c != nil || resp.Diagnostics.HasError() {
+		t.Errorf("expected no client and no errors without provider data, got %v %v", c, resp.Diagnostics)
+	}
+
+	want := client.NewClient("", "")
+	resp = &datasource.ConfigureResponse{}
+	if c := configureClient(datasource.ConfigureRequest{ProviderData: want}, resp); c != want || resp.Diagnostics.HasError() {
+		t.Errorf("expected the provider client, got %v %v", c, resp.Diagnostics)
+	}
+
+	resp = &datasource.ConfigureResponse{}
+	if c := configureClient(datasource.ConfigureRequest{ProviderData: "unexpected"}, resp); c != nil || !resp.Diagnostics.HasError() {
+		t.Errorf("expected an error for unexpected provider data, got %v %v", c, resp.Diagnostics)
+	}
+}
+
+func TestReadSingleTitle(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "definitions.json")
+	content := `[{"title_name":"GoogleChrome","title_version":"1.0"},{"title_name":"Firefox","title_version":"2.0"}]`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	c := client.NewClient("", file)
+
+	var diags diag.Diagnostics
+	title, ok := readSingleTitle(context.Background(), c, "Firefox", timeouts.Value{}, &diags)
+	if !ok || diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	if got := stringValue(title.TitleName); got != "Firefox" {
+		t.Errorf("expected Firefox, got %q", got)
+	}
+
+	diags = nil
+	if _, ok := readSingleTitle(context.Background(), c, "Zoom", timeouts.Value{}, &diags); ok || !diags.HasError() {
+		t.Fatal("expected an error for a missing title")
+	}
+	if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "Zoom") {
+		t.Errorf("expected the missing title in the error, got %q", detail)
+	}
+}
@@ -4,36 +4,156 @@
 package titles
 
 import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"image/color"
+	"slices"
+	"strings"
+	"time"
+
 	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/imaging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/markup"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// iconProgressInterval is the minimum time between progress log entries while processing icons.
+const iconProgressInterval = 5 * time.Second
+
+// Supported icon processing modes.
+const (
+	iconProcessingStrict     = "strict"
+	iconProcessingBestEffort = "best_effort"
+)
+
+// iconProcessingModes lists the supported icon processing modes.
+var iconProcessingModes = []string{iconProcessingStrict, iconProcessingBestEffort}
+
+// defaultIconProcessing is the icon processing mode used when icon_processing is not configured.
+const defaultIconProcessing = iconProcessingStrict
+
+// iconProcessingFromModel validates the configured icon processing mode, returning
+// defaultIconProcessing when unset.
+func iconProcessingFromModel(v types.String) (string, error) {
+	if v.IsNull() {
+		return defaultIconProcessing, nil
+	}
+
+	mode := v.ValueString()
+	if !slices.Contains(iconProcessingModes, mode) {
+		return "", fmt.Errorf("icon processing must be one of %s, got: %q", strings.Join(iconProcessingModes, ", "), mode)
+	}
+	return mode, nil
+}
+
+// iconOptions controls the optional icon variants generated for each title and how icon failures
+// are handled.
+type iconOptions struct {
+	// badge, when set, is drawn onto each icon to produce badged_icon_base64.
+	badge *imaging.Badge
+	// mask is the shape processed icons are clipped to, one of imaging.Masks.
+	mask string
+	// padding is the transparent margin around the artwork, as a percentage of the icon size per side.
+	padding int
+	// background, when set, is the solid color processed icons are flattened onto.
+	background *color.RGBA
+	// overlay, when set, replaces the built-in badge drawn onto uninstall icons.
+	overlay *imaging.Overlay
+	// bestEffort nulls the derived icons of a title whose icon cannot be processed, with a warning,
+	// instead of failing the whole read.
+	bestEffort bool
+	// skipErrored omits a title whose icon cannot be processed from the results, with a warning,
+	// instead of failing the whole read. bestEffort takes precedence.
+	skipErrored bool
+}
+
+// imagingOptions returns the options used to prepare artwork before overlays and badges are drawn.
+func (o iconOptions) imagingOptions() imaging.Options {
+	return imaging.Options{Mask: o.mask, Padding: o.padding, Background: o.background, Overlay: o.overlay}
+}
+
 // buildTitleModelsFromResponse converts a slice of client.Title API responses into TitleModel state values.
-func buildTitleModelsFromResponse(titles []client.Title) ([]TitleModel, error) {
+// Progress is logged periodically, since generating uninstall icons for a full catalog can take minutes.
+// When opts.bestEffort is set, a title whose icon cannot be processed gets null derived icons and a
+// warning diagnostic instead of failing the whole conversion. Otherwise, when opts.skipErrored is
+// set, the title is left out of the models and returned in the errored titles instead.
+func buildTitleModelsFromResponse(ctx context.Context, titles []client.Title, opts iconOptions) ([]TitleModel, []ErroredTitleModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	models := make([]TitleModel, 0, len(titles))
+	errored := []ErroredTitleModel{}
 
-	for _, title := range titles {
-		bundleID := extractBundleID(title.PatchDefinition.Requirements)
+	lastProgress := time.Now()
+	for i, title := range titles {
+		if time.Since(lastProgress) >= iconProgressInterval {
+			logging.StateDebug(ctx, "Processing titles", map[string]any{
+				"titles_processed": i,
+				"titles_total":     len(titles),
+			})
+			lastProgress = time.Now()
+		}
+
+		bundleID := title.PatchDefinition.BundleID()
 
-		var uninstallIcon *string
+		var icons titleIcons
 		if title.IconHiRes != nil {
 			var err error
-			uninstallIcon, err = processUninstallIcon(*title.IconHiRes)
-			if err != nil {
-				return nil, err
+			icons, err = buildTitleIcons(ctx, title, opts)
+			switch {
+			case err == nil:
+			case opts.bestEffort:
+				diags.AddWarning(
+					"Unable to process title icon",
+					fmt.Sprintf("The icon for title %q could not be processed, so its derived icons are null: %s", stringValue(title.TitleName), err),
+				)
+			case opts.skipErrored:
+				diags.AddWarning(
+					"Skipped errored title",
+					fmt.Sprintf("Title %q was skipped because its icon could not be processed: %s", stringValue(title.TitleName), err),
+				)
+				errored = append(errored, ErroredTitleModel{
+					TitleName: types.StringPointerValue(title.TitleName),
+					Error:     types.StringValue(err.Error()),
+				})
+				continue
+			default:
+				diags.AddError("Error processing title data", err.Error())
+				return nil, nil, diags
 			}
 		}
 
+		additionalProfiles := title.AdditionalProfiles
+		if additionalProfiles == nil {
+			additionalProfiles = map[string]string{}
+		}
+		additionalProfilesValue, d := types.MapValueFrom(ctx, types.StringType, additionalProfiles)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, nil, diags
+		}
+
+		descriptionPlain := types.StringNull()
+		if title.TitleDescription != nil {
+			descriptionPlain = types.StringValue(markup.Strip(*title.TitleDescription))
+		}
+
 		model := TitleModel{
 			TitleName:                types.StringPointerValue(title.TitleName),
 			TitleDisplayName:         types.StringPointerValue(title.TitleDisplayName),
 			TitleDescription:         types.StringPointerValue(title.TitleDescription),
+			TitleDescriptionPlain:    descriptionPlain,
 			TitleVersion:             types.StringPointerValue(title.TitleVersion),
 			MinimumOS:                types.StringPointerValue(title.MinimumOS),
 			MaximumOS:                types.StringPointerValue(title.MaximumOS),
 			IconBase64:               types.StringPointerValue(title.IconHiRes),
-			UninstallIconBase64:      types.StringPointerValue(uninstallIcon),
+			UninstallIconBase64:      types.StringPointerValue(icons.uninstall),
+			UninstallIcon2xBase64:    types.StringPointerValue(icons.uninstall2x),
+			BadgedIconBase64:         types.StringPointerValue(icons.badged),
+			SelfServiceIconBase64:    types.StringPointerValue(icons.selfService),
 			ExtensionAttribute:       types.StringPointerValue(title.ExtensionAttribute),
+			UninstallScript:          types.StringPointerValue(title.UninstallScript),
 			ContentFilterProfile:     types.StringPointerValue(title.ContentFilterProfile),
 			KernelExtensionProfile:   types.StringPointerValue(title.KernelExtensionProfile),
 			ManagedLoginItemsProfile: types.StringPointerValue(title.ManagedLoginItemsProfile),
@@ -41,20 +161,115 @@ func buildTitleModelsFromResponse(titles []client.Title) ([]TitleModel, error) {
 			PPPCPProfile:             types.StringPointerValue(title.PPPCPProfile),
 			ScreenRecordingProfile:   types.StringPointerValue(title.ScreenRecordingProfile),
 			SystemExtensionProfile:   types.StringPointerValue(title.SystemExtensionProfile),
+			AdditionalProfiles:       additionalProfilesValue,
 			AppBundleID:              types.StringPointerValue(bundleID),
+			EULAURL:                  types.StringPointerValue(title.EULAURL),
+			LicenseType:              types.StringPointerValue(title.LicenseType),
+			EstimatedDownloadSizeMB:  types.Float64PointerValue(title.EstimatedDownloadSizeMB),
+			SuggestedCategory:        types.StringValue(suggestedCategory(title)),
+			IconPayload:              icons.payload,
 		}
 		models = append(models, model)
 	}
 
-	return models, nil
+	return models, errored, diags
 }
 
-// extractBundleID finds the Application Bundle ID from a title's patch definition requirements.
-func extractBundleID(requirements []client.Requirement) *string {
-	for _, req := range requirements {
-		if req.Name != nil && *req.Name == "Application Bundle ID" {
-			return req.Value
+// titleIcons holds the icons derived from a title's catalog icon.
+type titleIcons struct {
+	uninstall   *string
+	uninstall2x *string
+	badged      *string
+	selfService *string
+	payload     *IconPayloadModel
+}
+
+// buildTitleIcons generates the uninstall, badged, Self Service and payload icons for a title with a catalog icon.
+// The icon is decoded and prepared once and every variant is rendered from the same canvas.
+func buildTitleIcons(ctx context.Context, title client.Title, opts iconOptions) (titleIcons, error) {
+	var icons titleIcons
+
+	iconStart := time.Now()
+	canvas, err := imaging.NewCanvas(*title.IconHiRes, opts.imagingOptions())
+	if err != nil {
+		logging.IconsDebug(ctx, "Failed to decode icon", map[string]any{
+			"title_name": stringValue(title.TitleName),
+			"error":      err.Error(),
+		})
+		return titleIcons{}, err
+	}
+
+	icons.uninstall, icons.uninstall2x, err = imaging.UninstallIcons(canvas)
+	if err != nil {
+		logging.IconsDebug(ctx, "Failed to generate uninstall icon", map[string]any{
+			"title_name": stringValue(title.TitleName),
+			"error":      err.Error(),
+		})
+		return titleIcons{}, err
+	}
+	logging.IconsTrace(ctx, "Generated uninstall icon", map[string]any{
+		"title_name":  stringValue(title.TitleName),
+		"duration_ms": time.Since(iconStart).Milliseconds(),
+	})
+
+	if opts.badge != nil {
+		icons.badged, err = imaging.BadgedIcon(canvas, *opts.badge)
+		if err != nil {
+			logging.IconsDebug(ctx, "Failed to generate badged icon", map[string]any{
+				"title_name": stringValue(title.TitleName),
+				"error":      err.Error(),
+			})
+			return titleIcons{}, err
 		}
 	}
-	return nil
+
+	icons.selfService, err = imaging.SelfServiceIcon(canvas)
+	if err != nil {
+		logging.IconsDebug(ctx, "Failed to generate Self Service icon", map[string]any{
+			"title_name": stringValue(title.TitleName),
+			"error":      err.Error(),
+		})
+		return titleIcons{}, err
+	}
+
+	icons.payload, err = buildIconPayload(title.TitleName, *title.IconHiRes)
+	if err != nil {
+		return titleIcons{}, err
+	}
+
+	return icons, nil
+}
+
+// stringValue dereferences s, returning an empty string for nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// buildIconPayload wraps a base64 encoded icon with the filename and content type expected by
+// Jamf Pro icon uploads. The filename extension and content type both come from the format of the
+// decoded image.
+func buildIconPayload(titleName *string, iconB64 string) (*IconPayloadModel, error) {
+	iconBytes, err := base64.StdEncoding.DecodeString(iconB64)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding icon: %w", err)
+	}
+
+	format, err := imaging.DetectFormat(iconBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	basename := "icon"
+	if titleName != nil && *titleName != "" {
+		basename = *titleName
+	}
+
+	return &IconPayloadModel{
+		Filename:    types.StringValue(basename + format.Extension),
+		Base64:      types.StringValue(iconB64),
+		ContentType: types.StringValue(format.ContentType),
+	}, nil
 }
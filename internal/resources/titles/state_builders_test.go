@@ -4,19 +4,29 @@
 package titles
 
 import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"image"
+	"image/jpeg"
 	"testing"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 func TestBuildTitleModelsFromResponse_SingleTitle(t *testing.T) {
 	titles := []client.Title{
 		{
-			TitleName:        new("GoogleChrome"),
-			TitleDisplayName: new("Google Chrome"),
-			TitleVersion:     new("120.0"),
-			MinimumOS:        new("12.0"),
-			MaximumOS:        new("15.0"),
+			TitleName:               new("GoogleChrome"),
+			TitleDisplayName:        new("Google Chrome"),
+			TitleVersion:            new("120.0"),
+			MinimumOS:               new("12.0"),
+			MaximumOS:               new("15.0"),
+			UninstallScript:         new("#!/bin/sh\nrm -rf \"/Applications/Google Chrome.app\"\n"),
+			EULAURL:                 new("https://www.google.com/chrome/terms/"),
+			LicenseType:             new("freeware"),
+			EstimatedDownloadSizeMB: new(245.5),
 			PatchDefinition: client.PatchDefinition{
 				Requirements: []client.Requirement{
 					{Name: new("Application Bundle ID"), Value: new("com.google.Chrome")},
@@ -25,9 +35,9 @@ func TestBuildTitleModelsFromResponse_SingleTitle(t *testing.T) {
 		},
 	}
 
-	models, err := buildTitleModelsFromResponse(titles)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
 	}
 	if len(models) != 1 {
 		t.Fatalf("expected 1 model, got %d", len(models))
@@ -43,6 +53,18 @@ func TestBuildTitleModelsFromResponse_SingleTitle(t *testing.T) {
 	if m.AppBundleID.ValueString() != "com.google.Chrome" {
 		t.Errorf("expected com.google.Chrome, got %s", m.AppBundleID.ValueString())
 	}
+	if m.UninstallScript.ValueString() != "#!/bin/sh\nrm -rf \"/Applications/Google Chrome.app\"\n" {
+		t.Errorf("unexpected UninstallScript %q", m.UninstallScript.ValueString())
+	}
+	if m.EULAURL.ValueString() != "https://www.google.com/chrome/terms/" {
+		t.Errorf("expected https://www.google.com/chrome/terms/, got %s", m.EULAURL.ValueString())
+	}
+	if m.LicenseType.ValueString() != "freeware" {
+		t.Errorf("expected freeware, got %s", m.LicenseType.ValueString())
+	}
+	if m.EstimatedDownloadSizeMB.ValueFloat64() != 245.5 {
+		t.Errorf("expected 245.5, got %v", m.EstimatedDownloadSizeMB.ValueFloat64())
+	}
 }
 
 func TestBuildTitleModelsFromResponse_NilFields(t *testing.T) {
@@ -53,9 +75,9 @@ func TestBuildTitleModelsFromResponse_NilFields(t *testing.T) {
 		},
 	}
 
-	models, err := buildTitleModelsFromResponse(titles)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
 	}
 	if len(models) != 1 {
 		t.Fatalf("expected 1 model, got %d", len(models))
@@ -71,12 +93,45 @@ func TestBuildTitleModelsFromResponse_NilFields(t *testing.T) {
 	if !m.AppBundleID.IsNull() {
 		t.Error("expected null AppBundleID")
 	}
+	if !m.TitleDescriptionPlain.IsNull() {
+		t.Error("expected null TitleDescriptionPlain")
+	}
+	if !m.UninstallScript.IsNull() {
+		t.Error("expected null UninstallScript")
+	}
+	if !m.EULAURL.IsNull() || !m.LicenseType.IsNull() {
+		t.Error("expected null EULAURL and LicenseType")
+	}
+	if !m.EstimatedDownloadSizeMB.IsNull() {
+		t.Error("expected null EstimatedDownloadSizeMB")
+	}
+}
+
+func TestBuildTitleModelsFromResponse_DescriptionPlain(t *testing.T) {
+	titles := []client.Title{
+		{
+			TitleName:        new("GoogleChrome"),
+			TitleDescription: new("<p>A <b>fast</b> &amp; <a href=\"https://google.com/chrome\">secure</a> browser.</p>"),
+		},
+	}
+
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	if got := models[0].TitleDescriptionPlain.ValueString(); got != "A fast & secure browser." {
+		t.Errorf("expected markup to be stripped, got %q", got)
+	}
+	if got := models[0].TitleDescription.ValueString(); got != *titles[0].TitleDescription {
+		t.Errorf("expected the description to be kept as is, got %q", got)
+	}
 }
 
 func TestBuildTitleModelsFromResponse_EmptySlice(t *testing.T) {
-	models, err := buildTitleModelsFromResponse([]client.Title{})
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), []client.Title{}, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
 	}
 	if len(models) != 0 {
 		t.Errorf("expected 0 models, got %d", len(models))
@@ -97,9 +152,9 @@ func TestBuildTitleModelsFromResponse_BundleIDExtraction(t *testing.T) {
 		},
 	}
 
-	models, err := buildTitleModelsFromResponse(titles)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
 	}
 	if models[0].AppBundleID.ValueString() != "com.test.app" {
 		t.Errorf("expected com.test.app, got %s", models[0].AppBundleID.ValueString())
@@ -118,9 +173,9 @@ func TestBuildTitleModelsFromResponse_NoBundleID(t *testing.T) {
 		},
 	}
 
-	models, err := buildTitleModelsFromResponse(titles)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
 	}
 	if !models[0].AppBundleID.IsNull() {
 		t.Error("expected null AppBundleID when no bundle ID requirement exists")
@@ -135,45 +190,223 @@ func TestBuildTitleModelsFromResponse_NoRequirements(t *testing.T) {
 		},
 	}
 
-	models, err := buildTitleModelsFromResponse(titles)
-	if err != nil {
-		t.Fatalf("unexpected error: %v", err)
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
 	}
 	if !models[0].AppBundleID.IsNull() {
 		t.Error("expected null AppBundleID when no requirements exist")
 	}
 }
 
-func TestExtractBundleID_Found(t *testing.T) {
-	reqs := []client.Requirement{
-		{Name: new("Application Bundle ID"), Value: new("com.example.app")},
+func TestBuildIconPayload_PNG(t *testing.T) {
+	icon := createTestPNG(t, 16, 16)
+	payload, err := buildIconPayload(new("GoogleChrome"), icon)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if payload.Filename.ValueString() != "GoogleChrome.png" {
+		t.Errorf("expected GoogleChrome.png, got %s", payload.Filename.ValueString())
+	}
+	if payload.ContentType.ValueString() != "image/png" {
+		t.Errorf("expected image/png, got %s", payload.ContentType.ValueString())
+	}
+	if payload.Base64.ValueString() != icon {
+		t.Error("expected base64 to match the source icon")
+	}
+}
+
+func TestBuildIconPayload_JPEG(t *testing.T) {
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 16, 16)), nil); err != nil {
+		t.Fatalf("failed to encode test JPEG: %v", err)
+	}
+
+	payload, err := buildIconPayload(new("GoogleChrome"), base64.StdEncoding.EncodeToString(buf.Bytes()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	result := extractBundleID(reqs)
-	if result == nil || *result != "com.example.app" {
-		t.Errorf("expected com.example.app, got %v", result)
+	if payload.Filename.ValueString() != "GoogleChrome.jpg" {
+		t.Errorf("expected GoogleChrome.jpg, got %s", payload.Filename.ValueString())
+	}
+	if payload.ContentType.ValueString() != "image/jpeg" {
+		t.Errorf("expected image/jpeg, got %s", payload.ContentType.ValueString())
 	}
 }
 
-func TestExtractBundleID_NotFound(t *testing.T) {
-	reqs := []client.Requirement{
-		{Name: new("OS Version"), Value: new("12.0")},
+func TestBuildIconPayload_NilTitleName(t *testing.T) {
+	payload, err := buildIconPayload(nil, createTestPNG(t, 16, 16))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
 	}
-	result := extractBundleID(reqs)
-	if result != nil {
-		t.Errorf("expected nil, got %s", *result)
+	if payload.Filename.ValueString() != "icon.png" {
+		t.Errorf("expected icon.png, got %s", payload.Filename.ValueString())
 	}
 }
 
-func TestExtractBundleID_EmptySlice(t *testing.T) {
-	result := extractBundleID([]client.Requirement{})
-	if result != nil {
-		t.Errorf("expected nil, got %s", *result)
+func TestBuildIconPayload_InvalidBase64(t *testing.T) {
+	_, err := buildIconPayload(new("TestApp"), "not-valid-base64!!!")
+	if err == nil {
+		t.Fatal("expected error for invalid base64")
 	}
 }
 
-func TestExtractBundleID_NilSlice(t *testing.T) {
-	result := extractBundleID(nil)
-	if result != nil {
-		t.Errorf("expected nil, got %s", *result)
+func TestBuildTitleModelsFromResponse_IconPayload(t *testing.T) {
+	titles := []client.Title{
+		{
+			TitleName: new("TestApp"),
+			IconHiRes: new(createTestPNG(t, 32, 32)),
+		},
+	}
+
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if models[0].IconPayload == nil {
+		t.Fatal("expected non-nil IconPayload")
+	}
+	if models[0].IconPayload.Filename.ValueString() != "TestApp.png" {
+		t.Errorf("expected TestApp.png, got %s", models[0].IconPayload.Filename.ValueString())
+	}
+}
+
+func TestBuildTitleModelsFromResponse_SelfServiceIcon(t *testing.T) {
+	titles := []client.Title{
+		{
+			TitleName: new("TestApp"),
+			IconHiRes: new(createTestPNG(t, 32, 32)),
+		},
+		{
+			TitleName: new("NoIcon"),
+		},
+	}
+
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if models[0].SelfServiceIconBase64.IsNull() {
+		t.Error("expected SelfServiceIconBase64 for title with an icon")
+	}
+	if !models[1].SelfServiceIconBase64.IsNull() {
+		t.Error("expected null SelfServiceIconBase64 for title without an icon")
+	}
+}
+
+func TestBuildTitleModelsFromResponse_BadgedIcon(t *testing.T) {
+	titles := []client.Title{
+		{
+			TitleName: new("TestApp"),
+			IconHiRes: new(createTestPNG(t, 32, 32)),
+		},
+	}
+
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !models[0].BadgedIconBase64.IsNull() {
+		t.Error("expected null BadgedIconBase64 when no badge is configured")
+	}
+
+	badge, err := badgeOptionsFromModel(&IconBadgeModel{Text: types.StringValue("BETA")})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	models, _, diags = buildTitleModelsFromResponse(context.Background(), titles, iconOptions{badge: badge})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if models[0].BadgedIconBase64.IsNull() {
+		t.Error("expected BadgedIconBase64 when a badge is configured")
+	}
+}
+
+func TestBuildTitleModelsFromResponse_CorruptIconStrict(t *testing.T) {
+	titles := []client.Title{
+		{TitleName: new("BrokenApp"), IconHiRes: new("not-valid-base64!!!")},
+		{TitleName: new("TestApp"), IconHiRes: new(createTestPNG(t, 32, 32))},
+	}
+
+	_, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{})
+	if !diags.HasError() {
+		t.Fatal("expected error for corrupt icon in strict mode")
+	}
+}
+
+func TestBuildTitleModelsFromResponse_CorruptIconBestEffort(t *testing.T) {
+	titles := []client.Title{
+		{TitleName: new("BrokenApp"), IconHiRes: new("not-valid-base64!!!")},
+		{TitleName: new("TestApp"), IconHiRes: new(createTestPNG(t, 32, 32))},
+	}
+
+	models, _, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{bestEffort: true})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Fatalf("expected 1 warning, got %d", diags.WarningsCount())
+	}
+	if len(models) != 2 {
+		t.Fatalf("expected 2 models, got %d", len(models))
+	}
+
+	broken := models[0]
+	if !broken.UninstallIconBase64.IsNull() || !broken.UninstallIcon2xBase64.IsNull() || !broken.SelfServiceIconBase64.IsNull() || broken.IconPayload != nil {
+		t.Error("expected null derived icons for corrupt icon")
+	}
+	if broken.IconBase64.ValueString() != "not-valid-base64!!!" {
+		t.Error("expected catalog icon to be preserved")
+	}
+	if models[1].UninstallIconBase64.IsNull() {
+		t.Error("expected uninstall icon for valid title")
+	}
+}
+
+func TestBuildTitleModelsFromResponse_CorruptIconSkipped(t *testing.T) {
+	titles := []client.Title{
+		{TitleName: new("BrokenApp"), IconHiRes: new("not-valid-base64!!!")},
+		{TitleName: new("TestApp"), IconHiRes: new(createTestPNG(t, 32, 32))},
+	}
+
+	models, errored, diags := buildTitleModelsFromResponse(context.Background(), titles, iconOptions{skipErrored: true})
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags.WarningsCount() != 1 {
+		t.Errorf("expected 1 warning, got %d", diags.WarningsCount())
+	}
+	if len(models) != 1 || models[0].TitleName.ValueString() != "TestApp" {
+		t.Errorf("expected only TestApp, got %v", models)
+	}
+	if len(errored) != 1 || errored[0].TitleName.ValueString() != "BrokenApp" {
+		t.Errorf("expected BrokenApp to be errored, got %v", errored)
+	}
+}
+
+func TestIconProcessingFromModel(t *testing.T) {
+	tests := []struct {
+		input types.String
+		want  string
+	}{
+		{types.StringNull(), iconProcessingStrict},
+		{types.StringValue("strict"), iconProcessingStrict},
+		{types.StringValue("best_effort"), iconProcessingBestEffort},
+	}
+
+	for _, tt := range tests {
+		got, err := iconProcessingFromModel(tt.input)
+		if err != nil {
+			t.Errorf("iconProcessingFromModel(%s) unexpected error: %v", tt.input, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("iconProcessingFromModel(%s) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+
+	if _, err := iconProcessingFromModel(types.StringValue("lenient")); err == nil {
+		t.Error("expected error for unsupported mode")
 	}
 }
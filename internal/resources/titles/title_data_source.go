@@ -0,0 +1,82 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"maps"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &TitleDataSource{}
+
+// NewTitleDataSource returns a new instance of the title data source.
+func NewTitleDataSource() datasource.DataSource {
+	return &TitleDataSource{}
+}
+
+// TitleDataSource defines the data source implementation.
+type TitleDataSource struct {
+	client *client.Client
+}
+
+func (d *TitleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_title"
+}
+
+func (d *TitleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := maps.Clone(titleAttributes())
+	attributes["title_name"] = schema.StringAttribute{
+		Required:            true,
+		MarkdownDescription: "The name of the title to read.",
+	}
+	attributes["timeouts"] = timeouts.Attributes(ctx)
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reads a single Jamf Auto Update title and exposes its details as top-level attributes, for modules that manage one title per instance with `for_each`. Use `jamfautoupdate_titles` to read several titles at once or to customize the generated icons.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *TitleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureClient(req, resp)
+}
+
+func (d *TitleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data TitleDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	titleName := data.TitleName.ValueString()
+	title, ok := readSingleTitle(ctx, d.client, titleName, data.Timeouts, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	resp.Diagnostics.Append(validateProfiles([]client.Title{title})...)
+
+	models, _, diags := buildTitleModelsFromResponse(ctx, []client.Title{title}, iconOptions{mask: defaultIconMask, overlay: d.client.UninstallOverlay()})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.TitleModel = models[0]
+
+	tflog.Debug(ctx, "Fetched title from Jamf Auto Update API", map[string]any{
+		"title_name": titleName,
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,107 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTitleDataSource_Metadata(t *testing.T) {
+	ds := &TitleDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jamfautoupdate",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "jamfautoupdate_title" {
+		t.Errorf("expected jamfautoupdate_title, got %s", resp.TypeName)
+	}
+}
+
+func TestTitleDataSource_Schema(t *testing.T) {
+	ds := &TitleDataSource{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+
+	for name := range titleAttributes() {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected attribute %q in schema", name)
+		}
+	}
+	if _, ok := resp.Schema.Attributes["timeouts"]; !ok {
+		t.Error("expected attribute \"timeouts\" in schema")
+	}
+
+	titleName, ok := resp.Schema.Attributes["title_name"].(schema.StringAttribute)
+	if !ok || !titleName.Required {
+		t.Error("expected title_name to be required")
+	}
+	if nested, ok := titleAttributes()["title_name"].(schema.StringAttribute); !ok || !nested.Computed {
+		t.Error("expected the shared title_name attribute to remain computed")
+	}
+}
+
+func TestTitleDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	file := filepath.Join(t.TempDir(), "definitions.json")
+	content := `[{"title_name":"GoogleChrome","title_display_name":"Google Chrome","title_version":"1.0","patch_definition":{"requirements":[{"name":"Application Bundle ID","value":"com.google.Chrome"}]}},` +
+		`{"title_name":"Firefox","title_version":"2.0","patch_definition":{"requirements":[]}}]`
+	if err := os.WriteFile(file, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	d := &TitleDataSource{client: client.NewClient("", file)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	values["title_name"] = tftypes.NewValue(tftypes.String, "GoogleChrome")
+
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var state TitleDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+	if state.TitleDisplayName.ValueString() != "Google Chrome" {
+		t.Errorf("expected display name Google Chrome, got %s", state.TitleDisplayName)
+	}
+	if state.AppBundleID.ValueString() != "com.google.Chrome" {
+		t.Errorf("expected bundle ID com.google.Chrome, got %s", state.AppBundleID)
+	}
+
+	values["title_name"] = tftypes.NewValue(tftypes.String, "NotATitle")
+	config.Raw = tftypes.NewValue(objectType, values)
+	resp = &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+	d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a title that does not exist")
+	}
+}
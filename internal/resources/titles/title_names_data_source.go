@@ -0,0 +1,182 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/resources/common"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var (
+	_ datasource.DataSource                   = &TitleNamesDataSource{}
+	_ datasource.DataSourceWithValidateConfig = &TitleNamesDataSource{}
+)
+
+// NewTitleNamesDataSource returns a new instance of the title names data source.
+func NewTitleNamesDataSource() datasource.DataSource {
+	return &TitleNamesDataSource{}
+}
+
+// TitleNamesDataSource defines the data source implementation.
+type TitleNamesDataSource struct {
+	client *client.Client
+}
+
+func (d *TitleNamesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_title_names"
+}
+
+func (d *TitleNamesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the names of the titles in the Jamf Auto Update catalog, optionally filtered by prefix or regular expression. " +
+			"Only the names are stored in state, so the listing is cheap enough to drive `for_each` over hundreds of titles, " +
+			"with the details of each title read separately through `jamfautoupdate_titles`.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx),
+			"prefix": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list titles whose name starts with this prefix. The match is case-sensitive.",
+			},
+			"pattern": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only list titles whose name matches this [RE2 regular expression](https://github.com/google/re2/wiki/Syntax). The expression is unanchored, so use `^` and `$` to match the whole name. When combined with `prefix`, titles must match both.",
+			},
+			"names": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "The names of the matching titles, sorted alphabetically.",
+			},
+		},
+	}
+}
+
+func (d *TitleNamesDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var pattern types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("pattern"), &pattern)...)
+	if resp.Diagnostics.HasError() || pattern.IsUnknown() {
+		return
+	}
+
+	if _, err := titleNamePatternFromModel(pattern); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pattern"),
+			"Invalid title name pattern",
+			err.Error(),
+		)
+	}
+}
+
+func (d *TitleNamesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	c, ok := req.ProviderData.(*client.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = c
+}
+
+func (d *TitleNamesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data TitleNamesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pattern, err := titleNamePatternFromModel(data.Pattern)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("pattern"), "Invalid title name pattern", err.Error())
+		return
+	}
+
+	readTimeout := defaultReadTimeout
+	if !data.Timeouts.IsNull() && !data.Timeouts.IsUnknown() {
+		configuredTimeout, timeoutDiags := data.Timeouts.Read(ctx, defaultReadTimeout)
+		resp.Diagnostics.Append(timeoutDiags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		readTimeout = configuredTimeout
+	}
+
+	readCtx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	titles, source, err := d.client.GetTitlesWithSource(readCtx)
+	if err != nil {
+		common.AddClientError(&resp.Diagnostics, "Unable to read Jamf Auto Update titles", err)
+		return
+	}
+	common.AddSourceWarnings(&resp.Diagnostics, source)
+
+	names := filterTitleNames(titles, data.Prefix.ValueString(), pattern)
+	data.Names = make([]types.String, 0, len(names))
+	for _, name := range names {
+		data.Names = append(data.Names, types.StringValue(name))
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("Listed %d of %d title names", len(names), len(titles)))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// titleNamePatternFromModel compiles the configured title name pattern, returning nil when unset.
+func titleNamePatternFromModel(v types.String) (*regexp.Regexp, error) {
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	pattern, err := regexp.Compile(v.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("pattern must be a valid regular expression: %w", err)
+	}
+	return pattern, nil
+}
+
+// filterTitleNames returns the distinct names of titles that start with prefix and, when pattern is
+// set, match it, sorted alphabetically. Titles without a name are ignored.
+func filterTitleNames(titles []client.Title, prefix string, pattern *regexp.Regexp) []string {
+	names := []string{}
+	for _, title := range titles {
+		if title.TitleName == nil {
+			continue
+		}
+		name := *title.TitleName
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if pattern != nil && !pattern.MatchString(name) {
+			continue
+		}
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+	return slices.Compact(names)
+}
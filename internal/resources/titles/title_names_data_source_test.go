@@ -0,0 +1,90 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestTitleNamesDataSource_Metadata(t *testing.T) {
+	ds := &TitleNamesDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jamfautoupdate",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "jamfautoupdate_title_names" {
+		t.Errorf("expected jamfautoupdate_title_names, got %s", resp.TypeName)
+	}
+}
+
+func TestTitleNamesDataSource_Schema(t *testing.T) {
+	ds := &TitleNamesDataSource{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+
+	for _, name := range []string{"timeouts", "prefix", "pattern", "names"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected attribute %q in schema", name)
+		}
+	}
+}
+
+func TestFilterTitleNames(t *testing.T) {
+	titles := []client.Title{
+		{TitleName: new("MicrosoftWord")},
+		{TitleName: new("GoogleChrome")},
+		{TitleName: new("MicrosoftExcel")},
+		{},
+		{TitleName: new("MicrosoftTeams")},
+		{TitleName: new("GoogleDrive")},
+		{TitleName: new("GoogleChrome")},
+	}
+
+	tests := map[string]struct {
+		prefix  string
+		pattern *regexp.Regexp
+		want    []string
+	}{
+		"all":     {"", nil, []string{"GoogleChrome", "GoogleDrive", "MicrosoftExcel", "MicrosoftTeams", "MicrosoftWord"}},
+		"prefix":  {"Microsoft", nil, []string{"MicrosoftExcel", "MicrosoftTeams", "MicrosoftWord"}},
+		"pattern": {"", regexp.MustCompile(`(Chrome|Teams)$`), []string{"GoogleChrome", "MicrosoftTeams"}},
+		"both":    {"Google", regexp.MustCompile(`Drive`), []string{"GoogleDrive"}},
+		"none":    {"Adobe", nil, []string{}},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := filterTitleNames(titles, tt.prefix, tt.pattern); !slices.Equal(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestTitleNamePatternFromModel(t *testing.T) {
+	if pattern, err := titleNamePatternFromModel(types.StringNull()); err != nil || pattern != nil {
+		t.Errorf("expected nil pattern for unset value, got %v, %v", pattern, err)
+	}
+	if _, err := titleNamePatternFromModel(types.StringValue("^Google")); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := titleNamePatternFromModel(types.StringValue("(unclosed")); err == nil {
+		t.Error("expected error for invalid pattern")
+	}
+}
@@ -0,0 +1,122 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"fmt"
+	"maps"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/logging"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/datasource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+var _ datasource.DataSource = &TitleProfilesDataSource{}
+
+// NewTitleProfilesDataSource returns a new instance of the title profiles data source.
+func NewTitleProfilesDataSource() datasource.DataSource {
+	return &TitleProfilesDataSource{}
+}
+
+// TitleProfilesDataSource defines the data source implementation.
+type TitleProfilesDataSource struct {
+	client *client.Client
+}
+
+func (d *TitleProfilesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_title_profiles"
+}
+
+func (d *TitleProfilesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Lists the profiles a Jamf Auto Update title ships, keyed by profile type, so configurations can `for_each` over the profiles a title actually provides instead of checking each profile attribute for null.",
+		Attributes: map[string]schema.Attribute{
+			"timeouts": timeouts.Attributes(ctx),
+			"title_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "The name of the title whose profiles are listed.",
+			},
+			"profile_format": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: fmt.Sprintf("The format of the profiles: `base64` exposes them as published in the catalog, `xml` decodes them to normalized property list XML text, as with the `profile_format` of `jamfautoupdate_titles`. Defaults to `%s`.", defaultProfileFormat),
+			},
+			"profiles": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "The profiles the title ships keyed by their catalog key, such as `pppcp_profile` or `system_extension_profile`, including profiles of types this provider version has no dedicated attribute for. Profiles the title does not ship are left out, so the map is empty for a title without profiles.",
+			},
+		},
+	}
+}
+
+func (d *TitleProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	d.client = configureClient(req, resp)
+}
+
+func (d *TitleProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx = logging.WithSubsystems(ctx)
+
+	var data TitleProfilesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	profileFormat, err := profileFormatFromModel(data.ProfileFormat)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("profile_format"), "Invalid profile format", err.Error())
+		return
+	}
+
+	titleName := data.TitleName.ValueString()
+	title, ok := readSingleTitle(ctx, d.client, titleName, data.Timeouts, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if profileFormat == profileFormatXML {
+		title, err = decodeTitleProfiles(title)
+		if err != nil {
+			resp.Diagnostics.AddError("Error processing title data", fmt.Sprintf("Title %q: %s", titleName, err))
+			return
+		}
+	} else {
+		resp.Diagnostics.Append(validateProfiles([]client.Title{title})...)
+	}
+
+	profiles, diags := types.MapValueFrom(ctx, types.StringType, titleProfiles(title))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Profiles = profiles
+
+	tflog.Debug(ctx, "Fetched title profiles from Jamf Auto Update API", map[string]any{
+		"title_name": titleName,
+		"profiles":   len(profiles.Elements()),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// titleProfiles returns the profiles title ships keyed by their catalog key, including additional
+// profiles. Empty profiles are left out.
+func titleProfiles(title client.Title) map[string]string {
+	profiles := make(map[string]string)
+	for _, field := range profileFields(&title) {
+		if *field.value != nil && **field.value != "" {
+			profiles[field.key] = **field.value
+		}
+	}
+	maps.Copy(profiles, title.AdditionalProfiles)
+	maps.DeleteFunc(profiles, func(_, content string) bool { return content == "" })
+	return profiles
+}
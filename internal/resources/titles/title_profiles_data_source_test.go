@@ -0,0 +1,130 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package titles
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTitleProfilesDataSource_Metadata(t *testing.T) {
+	ds := &TitleProfilesDataSource{}
+	req := datasource.MetadataRequest{
+		ProviderTypeName: "jamfautoupdate",
+	}
+	resp := &datasource.MetadataResponse{}
+
+	ds.Metadata(context.Background(), req, resp)
+
+	if resp.TypeName != "jamfautoupdate_title_profiles" {
+		t.Errorf("expected jamfautoupdate_title_profiles, got %s", resp.TypeName)
+	}
+}
+
+func TestTitleProfilesDataSource_Schema(t *testing.T) {
+	ds := &TitleProfilesDataSource{}
+	resp := &datasource.SchemaResponse{}
+
+	ds.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", resp.Diagnostics)
+	}
+
+	for _, name := range []string{"timeouts", "title_name", "profile_format", "profiles"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected attribute %q in schema", name)
+		}
+	}
+}
+
+func TestTitleProfiles(t *testing.T) {
+	got := titleProfiles(client.Title{
+		PPPCPProfile:           new("cHBwYw=="),
+		SystemExtensionProfile: new("c3lzZXh0"),
+		NotificationsProfile:   new(""),
+		AdditionalProfiles:     map[string]string{"dns_proxy_profile": "ZG5z", "empty_profile": ""},
+	})
+
+	if keys, want := slices.Sorted(maps.Keys(got)), []string{"dns_proxy_profile", "pppcp_profile", "system_extension_profile"}; !slices.Equal(keys, want) {
+		t.Errorf("expected profiles %v, got %v", want, keys)
+	}
+	if got["pppcp_profile"] != "cHBwYw==" {
+		t.Errorf("unexpected pppcp_profile: %q", got["pppcp_profile"])
+	}
+
+	if got := titleProfiles(client.Title{}); len(got) != 0 {
+		t.Errorf("expected no profiles, got %v", got)
+	}
+}
+
+func TestTitleProfilesDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	profile := base64.StdEncoding.EncodeToString([]byte(testProfileXML))
+	catalog, err := json.Marshal([]map[string]any{
+		{"title_name": "Zoom", "pppcp_profile": profile, "screen_recording_profile": profile},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "definitions.json")
+	if err := os.WriteFile(file, catalog, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	d := &TitleProfilesDataSource{client: client.NewClient("", file)}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+
+	read := func(format string) map[string]string {
+		t.Helper()
+		values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+		for name, attrType := range objectType.AttributeTypes {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["title_name"] = tftypes.NewValue(tftypes.String, "Zoom")
+		values["profile_format"] = tftypes.NewValue(tftypes.String, format)
+
+		config := tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+		d.Read(ctx, datasource.ReadRequest{Config: config}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+
+		var state TitleProfilesDataSourceModel
+		resp.Diagnostics.Append(resp.State.Get(ctx, &state)...)
+		profiles := map[string]string{}
+		resp.Diagnostics.Append(state.Profiles.ElementsAs(ctx, &profiles, false)...)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		return profiles
+	}
+
+	profiles := read("base64")
+	if keys, want := slices.Sorted(maps.Keys(profiles)), []string{"pppcp_profile", "screen_recording_profile"}; !slices.Equal(keys, want) {
+		t.Errorf("expected profiles %v, got %v", want, keys)
+	}
+	if profiles["pppcp_profile"] != profile {
+		t.Errorf("expected the catalog profile, got %q", profiles["pppcp_profile"])
+	}
+
+	if got := read("xml")["pppcp_profile"]; !strings.Contains(got, "<string>com.example.profile</string>") {
+		t.Errorf("expected profile XML, got %q", got)
+	}
+}
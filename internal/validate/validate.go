@@ -0,0 +1,116 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package validate checks a definitions catalog without running Terraform, so curated catalog files
+// can be verified in pre-commit hooks.
+package validate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/client"
+)
+
+// Report describes a validated catalog and the problems found in it.
+type Report struct {
+	// Source is the URL, with credentials redacted, or the path of the catalog.
+	Source string
+	// SchemaVersion is the schema version the catalog declares.
+	SchemaVersion int
+	// Titles is the number of titles that could be decoded.
+	Titles int
+	// Malformed lists the catalog entries with fields of an unexpected type.
+	Malformed []client.MalformedTitle
+	// Duplicates lists the titles that appear in more than one catalog entry.
+	Duplicates []client.DuplicateTitle
+	// UnknownProfiles lists the profile keys the provider has no dedicated attribute for.
+	UnknownProfiles []client.UnknownProfile
+}
+
+// Valid reports whether the provider can read the catalog with its default settings. Unknown
+// profiles do not make a catalog invalid, since the provider only warns about them.
+func (r Report) Valid() bool {
+	return len(r.Malformed) == 0 && len(r.Duplicates) == 0
+}
+
+// Catalog reads the catalog at source, a Definitions API URL or the path of a definitions file, and
+// reports the problems found. An error is returned when the catalog cannot be read at all, such as
+// when it is not JSON or declares an unsupported schema version.
+func Catalog(ctx context.Context, source string) (Report, error) {
+	c := client.NewClient("", source)
+	if strings.Contains(source, "://") {
+		c = client.NewClient(source, "")
+	}
+	if err := c.SetDuplicateTitlesPolicy(client.DuplicateTitlesPolicyWarn); err != nil {
+		return Report{}, err
+	}
+
+	version, err := c.CatalogSchemaVersion(ctx)
+	if err != nil {
+		return Report{}, err
+	}
+
+	titles, src, err := c.GetTitlesWithSource(ctx)
+	malformedErr, partial := errors.AsType[*client.MalformedTitlesError](err)
+	if err != nil && !partial {
+		return Report{}, err
+	}
+
+	report := Report{
+		Source:          src.Location,
+		SchemaVersion:   version,
+		Titles:          len(titles),
+		Duplicates:      src.Duplicates,
+		UnknownProfiles: src.UnknownProfiles,
+	}
+	if partial {
+		report.Malformed = malformedErr.Titles
+	}
+	return report, nil
+}
+
+// Write prints the report to w in a human readable form.
+func (r Report) Write(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Catalog: %s\n", r.Source)
+	fmt.Fprintf(&b, "Schema version: %d\n", r.SchemaVersion)
+	fmt.Fprintf(&b, "Titles: %d\n", r.Titles)
+
+	if len(r.Malformed) > 0 {
+		fmt.Fprintf(&b, "\nMalformed titles (%d):\n", len(r.Malformed))
+		for _, title := range r.Malformed {
+			name := title.TitleName
+			if name == "" {
+				name = "unnamed"
+			}
+			fmt.Fprintf(&b, "  - entry %d (%s): %s\n", title.Index, name, title.Err)
+		}
+	}
+
+	if len(r.Duplicates) > 0 {
+		fmt.Fprintf(&b, "\nDuplicate titles (%d):\n", len(r.Duplicates))
+		for _, duplicate := range r.Duplicates {
+			fmt.Fprintf(&b, "  - %s\n", duplicate)
+		}
+	}
+
+	if len(r.UnknownProfiles) > 0 {
+		fmt.Fprintf(&b, "\nUnknown profile types (%d):\n", len(r.UnknownProfiles))
+		for _, profile := range r.UnknownProfiles {
+			fmt.Fprintf(&b, "  - %s: %s\n", profile.Key, strings.Join(profile.TitleNames, ", "))
+		}
+	}
+
+	result := "valid"
+	if !r.Valid() {
+		result = "invalid"
+	}
+	fmt.Fprintf(&b, "\nResult: %s\n", result)
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
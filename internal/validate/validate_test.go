@@ -0,0 +1,104 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeCatalog(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "definitions.json")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write catalog: %v", err)
+	}
+	return path
+}
+
+func TestCatalog_Valid(t *testing.T) {
+	path := writeCatalog(t, `{"schema_version":2,"titles":[{"title_name":"GoogleChrome","title_version":"1.0"},{"title_name":"Firefox","title_version":"2.0"}]}`)
+
+	report, err := Catalog(context.Background(), path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !report.Valid() {
+		t.Errorf("expected a valid report, got %+v", report)
+	}
+	if report.Titles != 2 || report.SchemaVersion != 2 || report.Source != path {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	var out strings.Builder
+	if err := report.Write(&out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := "Catalog: " + path + "\nSchema version: 2\nTitles: 2\n\nResult: valid\n"
+	if out.String() != want {
+		t.Errorf("unexpected report output:\n%s", out.String())
+	}
+}
+
+func TestCatalog_Problems(t *testing.T) {
+	path := writeCatalog(t, `[
+		{"title_name":"GoogleChrome","title_version":"1.0"},
+		{"title_name":"GoogleChrome","title_version":"2.0"},
+		{"title_name":"Firefox","title_version":3},
+		{"title_name":"Zoom","dns_proxy_profile":"PHBsaXN0Lz4="}
+	]`)
+
+	report, err := Catalog(context.Background(), path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Valid() {
+		t.Error("expected an invalid report")
+	}
+
+	var out strings.Builder
+	if err := report.Write(&out); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"Malformed titles (1):\n  - entry 2 (Firefox): ",
+		"Duplicate titles (1):\n  - GoogleChrome (1.0, 2.0)\n",
+		"Unknown profile types (1):\n  - dns_proxy_profile: Zoom\n",
+		"Result: invalid\n",
+	} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("expected report to contain %q, got:\n%s", want, out.String())
+		}
+	}
+}
+
+func TestCatalog_URL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`[{"title_name":"GoogleChrome","title_version":"1.0"}]`))
+	}))
+	defer server.Close()
+
+	report, err := Catalog(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if report.Titles != 1 || !report.Valid() {
+		t.Errorf("unexpected report %+v", report)
+	}
+}
+
+func TestCatalog_Unreadable(t *testing.T) {
+	if _, err := Catalog(context.Background(), writeCatalog(t, `{"schema_version":99,"titles":[]}`)); err == nil {
+		t.Error("expected error for unsupported schema version")
+	}
+	if _, err := Catalog(context.Background(), writeCatalog(t, `not json`)); err == nil {
+		t.Error("expected error for invalid JSON")
+	}
+}
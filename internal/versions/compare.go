@@ -0,0 +1,116 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package versions implements the version comparison rules used for Jamf Auto Update catalog titles.
+package versions
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+)
+
+// Compare compares two catalog version strings, returning -1 if a is older than b, 1 if a is newer
+// and 0 if they are equivalent.
+//
+// Versions are split into runs of digits and runs of letters; any other characters separate runs.
+// Numeric runs are compared numerically and letter runs case-insensitively, with a numeric run
+// newer than a letter run. When one version runs out of segments, a remaining numeric segment makes
+// the longer version newer (1.0 < 1.0.1) while a remaining letter segment marks a pre-release and
+// makes it older (1.0b1 < 1.0).
+func Compare(a, b string) int {
+	as, bs := segments(a), segments(b)
+
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		switch {
+		case i >= len(as):
+			return -extraSign(bs[i])
+		case i >= len(bs):
+			return extraSign(as[i])
+		}
+
+		if c := compareSegments(as[i], bs[i]); c != 0 {
+			return c
+		}
+	}
+
+	return 0
+}
+
+// Sort sorts versions in place from oldest to newest according to Compare. Equivalent versions keep
+// their original order.
+func Sort(versions []string) {
+	slices.SortStableFunc(versions, Compare)
+}
+
+// segments splits v into runs of digits and runs of letters.
+func segments(v string) []string {
+	var parts []string
+	start := -1
+	var digits bool
+
+	for i, r := range v {
+		isDigit := unicode.IsDigit(r)
+		isLetter := unicode.IsLetter(r)
+
+		if start >= 0 && (!(isDigit || isLetter) || isDigit != digits) {
+			parts = append(parts, v[start:i])
+			start = -1
+		}
+		if start < 0 && (isDigit || isLetter) {
+			start = i
+			digits = isDigit
+		}
+	}
+	if start >= 0 {
+		parts = append(parts, v[start:])
+	}
+
+	return parts
+}
+
+// isNumeric reports whether a segment is a run of digits.
+func isNumeric(segment string) bool {
+	return segment != "" && unicode.IsDigit([]rune(segment)[0])
+}
+
+// extraSign returns how a version with the unmatched segment compares to one without it.
+func extraSign(segment string) int {
+	if isNumeric(segment) {
+		return 1
+	}
+	return -1
+}
+
+// compareSegments compares two segments of a version.
+func compareSegments(a, b string) int {
+	aNum, bNum := isNumeric(a), isNumeric(b)
+
+	switch {
+	case aNum && bNum:
+		// Compare without parsing so arbitrarily long numbers cannot overflow.
+		a, b = strings.TrimLeft(a, "0"), strings.TrimLeft(b, "0")
+		if len(a) != len(b) {
+			return compareInts(len(a), len(b))
+		}
+		return strings.Compare(a, b)
+	case aNum:
+		return 1
+	case bNum:
+		return -1
+	default:
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}
+}
+
+// compareInts compares two integers.
+func compareInts(a, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright Jamf Software LLC 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package versions
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"1.0", "1.0", 0},
+		{"1.0", "1.0.1", -1},
+		{"1.10", "1.9", 1},
+		{"2.0", "10.0", -1},
+		{"1.0b1", "1.0", -1},
+		{"1.0 beta 2", "1.0", -1},
+		{"1.0b1", "1.0b2", -1},
+		{"1.0a1", "1.0b1", -1},
+		{"1.0B1", "1.0b1", 0},
+		{"1.0.1", "1.0b1", 1},
+		{"126.0.6478.127", "126.0.6478.61", 1},
+		{"8.10.40 (80040009)", "8.10.40 (80040008)", 1},
+		{"1.02", "1.2", 0},
+		{"99999999999999999999999", "99999999999999999999998", 1},
+		{"", "1.0", -1},
+		{"", "", 0},
+	}
+
+	for _, tt := range tests {
+		if got := Compare(tt.a, tt.b); got != tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+		if got := Compare(tt.b, tt.a); got != -tt.want {
+			t.Errorf("Compare(%q, %q) = %d, want %d", tt.b, tt.a, got, -tt.want)
+		}
+	}
+}
+
+func TestSort(t *testing.T) {
+	versions := []string{"1.10", "1.0", "1.0b1", "1.9.1", "1.9", "2.0"}
+	Sort(versions)
+
+	want := []string{"1.0b1", "1.0", "1.9", "1.9.1", "1.10", "2.0"}
+	if !slices.Equal(versions, want) {
+		t.Errorf("expected %v, got %v", want, versions)
+	}
+}
@@ -7,8 +7,10 @@ import (
 	"context"
 	"flag"
 	"log"
+	"os"
 
 	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/provider"
+	"github.com/Jamf-Concepts/terraform-provider-jamfautoupdate/internal/validate"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 )
 
@@ -18,10 +20,16 @@ var (
 
 func main() {
 	var debug bool
+	var validateDefinitions string
 
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
+	flag.StringVar(&validateDefinitions, "validate-definitions", "", "validate the definitions file or Definitions API URL, print a report and exit instead of running the provider")
 	flag.Parse()
 
+	if validateDefinitions != "" {
+		os.Exit(runValidateDefinitions(validateDefinitions))
+	}
+
 	opts := providerserver.ServeOpts{
 		Address: "registry.terraform.io/Jamf-Concepts/jamfautoupdate",
 		Debug:   debug,
@@ -33,3 +41,23 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// runValidateDefinitions validates the catalog at source and prints a report, returning the exit
+// status: 0 for a valid catalog, 1 for one with problems and 2 for one that cannot be read.
+func runValidateDefinitions(source string) int {
+	report, err := validate.Catalog(context.Background(), source)
+	if err != nil {
+		log.Printf("Unable to validate definitions: %s", err)
+		return 2
+	}
+
+	if err := report.Write(os.Stdout); err != nil {
+		log.Printf("Unable to write report: %s", err)
+		return 2
+	}
+
+	if !report.Valid() {
+		return 1
+	}
+	return 0
+}